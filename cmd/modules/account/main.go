@@ -55,6 +55,7 @@ func main() {
 	app.Start(ctx, func() error {
 		accountAPI, err := account_service.NewAccountAPI(ctx, &account_service.Options{
 			SQLDB:      app.GormDB(),
+			RedisDB:    app.RedisClient(),
 			Logger:     app.Logger(),
 			SigningKey: os.Getenv("JWT_SIGNING_KEY"),
 		})
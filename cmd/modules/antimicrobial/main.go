@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"github.com/gidyon/antibug/internal/modules"
 	antimicrobial_service "github.com/gidyon/antibug/internal/modules/antimicrobial"
 	"github.com/gidyon/antibug/pkg/api/antimicrobial"
 	app_grpc_middleware "github.com/gidyon/micros/pkg/grpc/middleware"
@@ -52,11 +53,17 @@ func main() {
 
 	// Start service
 	app.Start(ctx, func() error {
+		// Page sizes are capped at the service default unless configured
+		maxPageSize, err := modules.ParseMaxPageSize(os.Getenv("MAX_PAGE_SIZE"))
+		handleErr(err)
+
 		// Create antimicrobial tracing instance
 		antimicrobialAPI, err := antimicrobial_service.NewAntimicrobialAPI(ctx, &antimicrobial_service.Options{
-			SQLDB:      app.GormDB(),
-			Logger:     app.Logger(),
-			SigningKey: os.Getenv("JWT_SIGNING_KEY"),
+			SQLDB:       app.GormDB(),
+			RedisDB:     app.RedisClient(),
+			Logger:      app.Logger(),
+			SigningKey:  os.Getenv("JWT_SIGNING_KEY"),
+			MaxPageSize: maxPageSize,
 		})
 		handleErr(err)
 
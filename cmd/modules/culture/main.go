@@ -2,11 +2,15 @@ package main
 
 import (
 	"context"
+	"github.com/gidyon/antibug/internal/modules"
 	culture_service "github.com/gidyon/antibug/internal/modules/culture"
+	"github.com/gidyon/antibug/internal/pkg/breakpoints"
+	"github.com/gidyon/antibug/internal/pkg/expertrules"
 	"github.com/gidyon/antibug/pkg/api/culture"
 	app_grpc_middleware "github.com/gidyon/micros/pkg/grpc/middleware"
 	"github.com/gidyon/micros/utils/healthcheck"
 	"google.golang.org/grpc"
+	"net"
 	"os"
 
 	"github.com/gidyon/micros"
@@ -15,6 +19,8 @@ import (
 	"github.com/Sirupsen/logrus"
 )
 
+const defaultBreakpointsVersion = "CLSI M100-ED30"
+
 func main() {
 	cfg, err := config.New()
 	handleErr(err)
@@ -50,19 +56,85 @@ func main() {
 		AutoMigrator: func() error { return nil },
 	}))
 
+	// Breakpoint tables used to interpret results
+	breakpointsVersion := os.Getenv("BREAKPOINTS_VERSION")
+	if breakpointsVersion == "" {
+		breakpointsVersion = defaultBreakpointsVersion
+	}
+	interpreter, err := breakpoints.LoadDir(os.Getenv("BREAKPOINTS_DIR"), breakpointsVersion)
+	handleErr(err)
+
+	// HL7 ingestion is enabled by code tables mapping codes of laboratory information systems
+	var codeTables *culture_service.HL7CodeTables
+	if file := os.Getenv("HL7_CODE_TABLES"); file != "" {
+		codeTables, err = culture_service.LoadHL7CodeTables(file)
+		handleErr(err)
+	}
+
+	// Results are checked against expert rules when configured
+	var expertRules *expertrules.Engine
+	if file := os.Getenv("EXPERT_RULES_FILE"); file != "" {
+		expertRules, err = expertrules.Load(file)
+		handleErr(err)
+	}
+
 	// Start module
 	app.Start(ctx, func() error {
+		// Pathogens and antimicrobials of cultures are checked against their catalogues
+		catalogue, err := culture_service.NewSQLCatalogue(app.GormDB())
+		handleErr(err)
+
+		// Page sizes are capped at the service default unless configured
+		maxPageSize, err := modules.ParseMaxPageSize(os.Getenv("MAX_PAGE_SIZE"))
+		handleErr(err)
+
 		// Create culture tracing instance
 		cultureAPI, err := culture_service.NewCultureAPI(ctx, &culture_service.Options{
-			SQLDB:      app.GormDB(),
-			Logger:     app.Logger(),
-			SigningKey: os.Getenv("JWT_SIGNING_KEY"),
+			SQLDB:        app.GormDB(),
+			RedisDB:      app.RedisClient(),
+			Logger:       app.Logger(),
+			SigningKey:   os.Getenv("JWT_SIGNING_KEY"),
+			Breakpoints:  interpreter,
+			Catalogue:    catalogue,
+			ExpertRules:  expertRules,
+			PseudonymKey: os.Getenv("PATIENT_PSEUDONYM_KEY"),
+			MaxPageSize:  maxPageSize,
 		})
 		handleErr(err)
 
 		culture.RegisterCultureAPIServer(app.GRPCServer(), cultureAPI)
 		handleErr(culture.RegisterCultureAPIHandlerServer(ctx, app.RuntimeMux(), cultureAPI))
 
+		// FHIR facade
+		fhirHandler, err := culture_service.NewFHIRHandler(cultureAPI)
+		handleErr(err)
+
+		app.AddEndpoint("/fhir/DiagnosticReport", fhirHandler)
+
+		// Exports are streamed, which the gateway does not support
+		exportHandler, err := culture_service.NewExportHandler(cultureAPI)
+		handleErr(err)
+
+		app.AddEndpoint("/api/antibug/cultures/action/export", exportHandler)
+
+		if codeTables != nil {
+			ingester, err := culture_service.NewHL7Ingester(cultureAPI, codeTables)
+			handleErr(err)
+
+			app.AddEndpoint("/api/antibug/cultures/hl7", ingester)
+
+			// MLLP senders are not authenticated so the address must be a loopback address
+			// reached through an authenticating proxy
+			if address := os.Getenv("HL7_MLLP_ADDRESS"); address != "" {
+				listener, err := net.Listen("tcp", address)
+				handleErr(err)
+
+				go func() {
+					handleErr(ingester.ServeMLLP(ctx, listener))
+				}()
+			}
+		}
+
 		return nil
 	})
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"github.com/gidyon/antibug/internal/modules"
 	pathogen_service "github.com/gidyon/antibug/internal/modules/pathogen"
 	"github.com/gidyon/antibug/pkg/api/pathogen"
 	app_grpc_middleware "github.com/gidyon/micros/pkg/grpc/middleware"
@@ -52,11 +53,17 @@ func main() {
 
 	// Start service
 	app.Start(ctx, func() error {
+		// Page sizes are capped at the service default unless configured
+		maxPageSize, err := modules.ParseMaxPageSize(os.Getenv("MAX_PAGE_SIZE"))
+		handleErr(err)
+
 		// Create pathogen tracing instance
 		pathogenAPI, err := pathogen_service.NewPathogenAPI(ctx, &pathogen_service.Options{
 			SQLDB:         app.GormDB(),
+			RedisDB:       app.RedisClient(),
 			Logger:        app.Logger(),
 			JWTSigningKey: os.Getenv("JWT_SIGNING_KEY"),
+			MaxPageSize:   maxPageSize,
 		})
 		handleErr(err)
 
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gidyon/antibug/internal/pkg/hl7"
+
+	"github.com/Sirupsen/logrus"
+)
+
+// Acknowledgments are small messages
+const maxACKSize = 1 << 16
+
+// Sends recorded HL7 messages over MLLP and prints their acknowledgments
+func main() {
+	var (
+		address = flag.String("address", "localhost:2575", "Address of the MLLP listener")
+		file    = flag.String("file", "", "Path of the message to send")
+		timeout = flag.Duration("timeout", 30*time.Second, "Time to wait for the acknowledgment")
+	)
+	flag.Parse()
+
+	if *file == "" {
+		logrus.Fatalln("missing file")
+	}
+
+	data, err := ioutil.ReadFile(*file)
+	handleErr(err)
+
+	conn, err := net.DialTimeout("tcp", *address, *timeout)
+	handleErr(err)
+	defer conn.Close()
+
+	handleErr(conn.SetDeadline(time.Now().Add(*timeout)))
+	handleErr(hl7.WriteMLLP(conn, data))
+
+	ack, err := hl7.ReadMLLP(bufio.NewReader(conn), maxACKSize)
+	handleErr(err)
+
+	logrus.Infoln(strings.Replace(string(ack), "\r", "\n", -1))
+}
+
+func handleErr(err error) {
+	if err != nil {
+		logrus.Fatalln(err)
+	}
+}
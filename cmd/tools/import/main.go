@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/golang/protobuf/jsonpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Sirupsen/logrus"
+)
+
+const chunkSize = 64 * 1024
+
+// Imports cultures from a CSV or WHONET file through the culture service
+func main() {
+	var (
+		address   = flag.String("address", "localhost:7070", "Address of the culture service")
+		token     = flag.String("token", os.Getenv("ANTIBUG_TOKEN"), "Bearer token of a lab technician or admin")
+		file      = flag.String("file", "", "Path of the file to import")
+		format    = flag.String("format", "csv", "Format of the file. One of csv or whonet")
+		profile   = flag.String("mapping", "", "Path of the json column mapping profile")
+		batchSize = flag.Int("batch-size", 100, "Number of cultures inserted per transaction")
+	)
+	flag.Parse()
+
+	switch {
+	case *file == "":
+		logrus.Fatalln("missing file")
+	case *token == "":
+		logrus.Fatalln("missing token")
+	}
+
+	importFormat, ok := culture.ImportFormat_value[strings.ToUpper(*format)]
+	if !ok {
+		logrus.Fatalf("unknown format %q", *format)
+	}
+
+	mapping := &culture.ImportMapping{}
+	if *profile != "" {
+		profileFile, err := os.Open(*profile)
+		handleErr(err)
+		handleErr(jsonpb.Unmarshal(profileFile, mapping))
+		profileFile.Close()
+	}
+
+	importFile, err := os.Open(*file)
+	handleErr(err)
+	defer importFile.Close()
+
+	cc, err := grpc.Dial(*address, grpc.WithInsecure())
+	handleErr(err)
+	defer cc.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+*token)
+
+	stream, err := culture.NewCultureAPIClient(cc).ImportCultures(ctx)
+	handleErr(err)
+
+	err = stream.Send(&culture.ImportCulturesRequest{
+		Payload: &culture.ImportCulturesRequest_Options{
+			Options: &culture.ImportOptions{
+				Format:    culture.ImportFormat(importFormat),
+				Mapping:   mapping,
+				BatchSize: int32(*batchSize),
+			},
+		},
+	})
+	handleErr(err)
+
+	buffer := make([]byte, chunkSize)
+	for {
+		n, err := importFile.Read(buffer)
+		if n > 0 {
+			err := stream.Send(&culture.ImportCulturesRequest{
+				Payload: &culture.ImportCulturesRequest_Chunk{Chunk: append([]byte{}, buffer[:n]...)},
+			})
+			handleErr(err)
+		}
+		if err == io.EOF {
+			break
+		}
+		handleErr(err)
+	}
+
+	importRes, err := stream.CloseAndRecv()
+	handleErr(err)
+
+	for _, rowErr := range importRes.Errors {
+		logrus.Warnf("row %d: %s", rowErr.Row, rowErr.Message)
+	}
+
+	logrus.Infof(
+		"read %d rows, imported %d and failed %d", importRes.RowsRead, importRes.RowsImported, importRes.RowsFailed,
+	)
+}
+
+func handleErr(err error) {
+	if err != nil {
+		logrus.Fatalln(err)
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	culture_service "github.com/gidyon/antibug/internal/modules/culture"
+	"github.com/jinzhu/gorm"
+
+	"github.com/Sirupsen/logrus"
+
+	// Imports mysql driver
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// Rebuilds susceptibility rollups from the cultures table
+func main() {
+	var (
+		dsn       = flag.String("dsn", "", "MySQL data source name e.g user:password@tcp(localhost:3306)/antibug")
+		batchSize = flag.Int("batch-size", 500, "Number of cultures read per batch")
+	)
+	flag.Parse()
+
+	if *dsn == "" {
+		logrus.Fatalln("missing dsn")
+	}
+
+	db, err := gorm.Open("mysql", *dsn)
+	handleErr(err)
+	defer db.Close()
+
+	count, err := culture_service.RebuildRollups(context.Background(), db, *batchSize)
+	handleErr(err)
+
+	logrus.Infof("rebuilt rollups from %d cultures", count)
+}
+
+func handleErr(err error) {
+	if err != nil {
+		logrus.Fatalln(err)
+	}
+}
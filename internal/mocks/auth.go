@@ -14,17 +14,36 @@ type AuthAPIMock interface {
 // AuthAPI is a fake authentication API
 var AuthAPI = &mocks.AuthAPIMock{}
 
+// AdminPayload is the token payload of actors authorized by AuthAPI
+var AdminPayload = &auth.Payload{ID: "admin", Group: auth.Admin}
+
+// AdminTokens are the tokens of sessions started by AuthAPI
+var AdminTokens = &auth.Tokens{
+	SessionID:    "session",
+	AccessToken:  "token",
+	RefreshToken: "session.refresh",
+	Payload:      AdminPayload,
+}
+
 func init() {
 	AuthAPI.On("AuthenticateRequest", mock.Anything, mock.Anything).
 		Return(nil)
 	AuthAPI.On("AuthorizeActor", mock.Anything, mock.Anything).
-		Return(&auth.Payload{}, nil)
+		Return(AdminPayload, nil)
 	AuthAPI.On("AuthorizeGroup",
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(&auth.Payload{}, nil)
+		Return(AdminPayload, nil)
 	AuthAPI.On("AuthorizeStrict",
 		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(&auth.Payload{}, nil)
+		Return(AdminPayload, nil)
 	AuthAPI.On("GenToken", mock.Anything, mock.Anything, mock.Anything).
 		Return("token", nil)
+	AuthAPI.On("StartSession", mock.Anything, mock.Anything).
+		Return(AdminTokens, nil)
+	AuthAPI.On("RefreshSession", mock.Anything, mock.Anything, mock.Anything).
+		Return(AdminTokens, nil)
+	AuthAPI.On("EndSession", mock.Anything, mock.Anything).
+		Return(nil)
+	AuthAPI.On("RevokeSessions", mock.Anything, mock.Anything).
+		Return(nil)
 }
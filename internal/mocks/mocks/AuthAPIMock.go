@@ -112,6 +112,20 @@ func (_m *AuthAPIMock) AuthorizeStrict(ctx context.Context, actorID string, allo
 	return r0, r1
 }
 
+// EndSession provides a mock function with given fields: ctx, sessionID
+func (_m *AuthAPIMock) EndSession(ctx context.Context, sessionID string) error {
+	ret := _m.Called(ctx, sessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GenToken provides a mock function with given fields: _a0, _a1, _a2
 func (_m *AuthAPIMock) GenToken(_a0 context.Context, _a1 *auth.Payload, _a2 int64) (string, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -132,3 +146,63 @@ func (_m *AuthAPIMock) GenToken(_a0 context.Context, _a1 *auth.Payload, _a2 int6
 
 	return r0, r1
 }
+
+// RefreshSession provides a mock function with given fields: ctx, refreshToken, getPayload
+func (_m *AuthAPIMock) RefreshSession(ctx context.Context, refreshToken string, getPayload auth.PayloadFunc) (*auth.Tokens, error) {
+	ret := _m.Called(ctx, refreshToken, getPayload)
+
+	var r0 *auth.Tokens
+	if rf, ok := ret.Get(0).(func(context.Context, string, auth.PayloadFunc) *auth.Tokens); ok {
+		r0 = rf(ctx, refreshToken, getPayload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Tokens)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, auth.PayloadFunc) error); ok {
+		r1 = rf(ctx, refreshToken, getPayload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeSessions provides a mock function with given fields: ctx, actorID
+func (_m *AuthAPIMock) RevokeSessions(ctx context.Context, actorID string) error {
+	ret := _m.Called(ctx, actorID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, actorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartSession provides a mock function with given fields: ctx, payload
+func (_m *AuthAPIMock) StartSession(ctx context.Context, payload *auth.Payload) (*auth.Tokens, error) {
+	ret := _m.Called(ctx, payload)
+
+	var r0 *auth.Tokens
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.Payload) *auth.Tokens); ok {
+		r0 = rf(ctx, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.Tokens)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *auth.Payload) error); ok {
+		r1 = rf(ctx, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gidyon/antibug/internal/pkg/auth"
 	"github.com/gidyon/antibug/internal/pkg/errs"
 	"github.com/gidyon/antibug/pkg/api/account"
+	"github.com/go-redis/redis"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
@@ -25,6 +26,7 @@ type accountAPIServer struct {
 // Options contains parameters for passing to NewAccountAPI
 type Options struct {
 	SQLDB      *gorm.DB
+	RedisDB    *redis.Client
 	Logger     grpclog.LoggerV2
 	SigningKey string
 }
@@ -37,6 +39,8 @@ func NewAccountAPI(ctx context.Context, opt *Options) (account.AccountAPIServer,
 		err = errs.NilObject("Context")
 	case opt.SQLDB == nil:
 		err = errs.NilObject("SqlDB")
+	case opt.RedisDB == nil:
+		err = errs.NilObject("RedisClient")
 	case opt.Logger == nil:
 		err = errs.NilObject("Logger")
 	case opt.SigningKey == "":
@@ -46,7 +50,7 @@ func NewAccountAPI(ctx context.Context, opt *Options) (account.AccountAPIServer,
 		return nil, err
 	}
 
-	authAPI, err := auth.NewAPI(opt.SigningKey)
+	authAPI, err := auth.NewAPI(opt.SigningKey, opt.RedisDB)
 	if err != nil {
 		return nil, err
 	}
@@ -123,28 +127,174 @@ func (api *accountAPIServer) Login(
 		)
 	}
 
-	accountID := fmt.Sprint(accountDB.ID)
+	payload, err := getPayload(accountDB)
+	if err != nil {
+		return nil, err
+	}
 
-	// Generate token
-	token, err := api.authAPI.GenToken(ctx, &auth.Payload{
-		ID:        accountID,
-		FirstName: accountDB.FirstName,
-		LastName:  accountDB.LastName,
-		Group:     accountDB.Group,
-	}, 0)
+	// Start session
+	tokens, err := api.authAPI.StartSession(ctx, payload)
 	if err != nil {
-		return nil, errs.FailedToGenToken(err)
+		return nil, err
 	}
 
-	// Populate response
-	return &account.LoginResponse{
-		Token:        token,
-		AccountId:    accountID,
-		AccountState: accountDB.Active,
-		AccountGroup: accountDB.Group,
+	return getLoginResponse(tokens, accountDB), nil
+}
+
+// getPayload returns the token payload of an account
+func getPayload(accountDB *Account) (*auth.Payload, error) {
+	// Facilities of jobs scope what the account may change
+	jobs, err := getJobsPB(accountDB.Jobs)
+	if err != nil {
+		return nil, err
+	}
+	facilityIDs := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		facilityIDs = append(facilityIDs, job.FacilityId)
+	}
+
+	return &auth.Payload{
+		ID:          fmt.Sprint(accountDB.ID),
+		FirstName:   accountDB.FirstName,
+		LastName:    accountDB.LastName,
+		Group:       accountDB.Group,
+		FacilityIDs: facilityIDs,
 	}, nil
 }
 
+func getLoginResponse(tokens *auth.Tokens, accountDB *Account) *account.LoginResponse {
+	return &account.LoginResponse{
+		Token:                    tokens.AccessToken,
+		AccountId:                tokens.Payload.ID,
+		AccountState:             accountDB.Active,
+		AccountGroup:             tokens.Payload.Group,
+		RefreshToken:             tokens.RefreshToken,
+		TokenExpiresTimestampSec: tokens.ExpiresAt,
+	}
+}
+
+// getActiveAccount returns the account when it is active
+func (api *accountAPIServer) getActiveAccount(accountID string) (*Account, error) {
+	accountDB := &Account{}
+	err := api.sqlDB.First(accountDB, "id=?", accountID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.AccountNotFound(accountID)
+	default:
+		return nil, errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	if !accountDB.Active {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "account is not active")
+	}
+
+	return accountDB, nil
+}
+
+func (api *accountAPIServer) RefreshToken(
+	ctx context.Context, refreshReq *account.RefreshTokenRequest,
+) (*account.LoginResponse, error) {
+	// Request must not be nil
+	if refreshReq == nil {
+		return nil, errs.NilObject("RefreshTokenRequest")
+	}
+
+	// Validation
+	if refreshReq.RefreshToken == "" {
+		return nil, errs.MissingField("refresh token")
+	}
+
+	// Replace refresh token. Payload is read from the account which must still be active.
+	var accountDB *Account
+	activePayload := func(ctx context.Context, accountID string) (*auth.Payload, error) {
+		var err error
+		accountDB, err = api.getActiveAccount(accountID)
+		if err != nil {
+			return nil, err
+		}
+		return getPayload(accountDB)
+	}
+
+	tokens, err := api.authAPI.RefreshSession(ctx, refreshReq.RefreshToken, activePayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return getLoginResponse(tokens, accountDB), nil
+}
+
+func (api *accountAPIServer) Logout(
+	ctx context.Context, logoutReq *account.LogoutRequest,
+) (*empty.Empty, error) {
+	// Request must not be nil
+	if logoutReq == nil {
+		return nil, errs.NilObject("LogoutRequest")
+	}
+
+	// Authorize request
+	payload, err := api.authAPI.AuthorizeActor(ctx, logoutReq.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case logoutReq.AccountId == "":
+		err = errs.MissingField("account id")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// End session of the token. Tokens without a session have nothing to end.
+	if payload.SessionID == "" {
+		return &empty.Empty{}, nil
+	}
+
+	err = api.authAPI.EndSession(ctx, payload.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (api *accountAPIServer) RevokeSessions(
+	ctx context.Context, revokeReq *account.RevokeSessionsRequest,
+) (*empty.Empty, error) {
+	// Request must not be nil
+	if revokeReq == nil {
+		return nil, errs.NilObject("RevokeSessionsRequest")
+	}
+
+	// Authorize request. Admins may revoke sessions of other accounts e.g of departing staff.
+	_, err := api.authAPI.AuthorizeActor(ctx, revokeReq.AccountId)
+	if err != nil {
+		_, err = api.authAPI.AuthorizeGroup(ctx, auth.Admin)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Validation
+	switch {
+	case revokeReq.AccountId == "":
+		err = errs.MissingField("account id")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// End all sessions of the account
+	err = api.authAPI.RevokeSessions(ctx, revokeReq.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
 // generates hashed version of password
 func genHash(password string) (string, error) {
 	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -276,10 +426,13 @@ func (api *accountAPIServer) UpdateAccount(
 		return nil, errs.NilObject("UpdateAccountRequest")
 	}
 
-	// Authorize request
-	_, err := api.authAPI.AuthorizeActor(ctx, updateReq.GetAccountId())
+	// Authorize request. Admins may update other accounts.
+	payload, err := api.authAPI.AuthorizeActor(ctx, updateReq.GetAccountId())
 	if err != nil {
-		return nil, err
+		payload, err = api.authAPI.AuthorizeGroup(ctx, auth.Admin)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Validation
@@ -299,6 +452,12 @@ func (api *accountAPIServer) UpdateAccount(
 		return nil, err
 	}
 
+	// Groups and states of accounts are only changed by admins
+	if payload.Group != auth.Admin {
+		accountDB.Group = ""
+		accountDB.Active = false
+	}
+
 	// Save in model
 	err = api.sqlDB.Table(accountsTable).Where("id=?", updateReq.AccountId).
 		Updates(accountDB).Error
@@ -479,8 +638,8 @@ func (api *accountAPIServer) UpdateJobs(
 		return nil, errs.NilObject("UpdateJobsRequest")
 	}
 
-	// Authorize request
-	_, err := api.authAPI.AuthorizeActor(ctx, updateReq.GetAccountId())
+	// Authorize request. Jobs scope facilities in tokens so only admins may change them.
+	_, err := api.authAPI.AuthorizeGroup(ctx, auth.Admin)
 	if err != nil {
 		return nil, err
 	}
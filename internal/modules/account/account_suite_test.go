@@ -7,6 +7,7 @@ import (
 	"github.com/gidyon/antibug/internal/mocks"
 	"github.com/gidyon/antibug/pkg/api/account"
 	"github.com/gidyon/micros"
+	"github.com/go-redis/redis"
 
 	// Imports mysql driver
 	_ "github.com/go-sql-driver/mysql"
@@ -28,8 +29,9 @@ var (
 )
 
 const (
-	dbName    = "antibug"
-	dbAddress = "localhost:3306"
+	dbName       = "antibug"
+	dbAddress    = "localhost:3306"
+	redisAddress = "localhost:6379"
 )
 
 func initDB() (*gorm.DB, error) {
@@ -44,8 +46,13 @@ var _ = BeforeSuite(func() {
 	db, err := initDB()
 	Expect(err).ShouldNot(HaveOccurred())
 
+	redisDB := redis.NewClient(&redis.Options{
+		Addr: redisAddress,
+	})
+
 	opt := &Options{
 		SQLDB:      db,
+		RedisDB:    redisDB,
 		Logger:     micros.NewLogger("account_app"),
 		SigningKey: randomdata.RandStringRunes(32),
 	}
@@ -65,6 +72,11 @@ var _ = BeforeSuite(func() {
 	Expect(err).Should(HaveOccurred())
 
 	opt.SQLDB = db
+	opt.RedisDB = nil
+	_, err = NewAccountAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.RedisDB = redisDB
 	opt.Logger = nil
 	_, err = NewAccountAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
@@ -0,0 +1,80 @@
+package account
+
+import (
+	"context"
+	authmocks "github.com/gidyon/antibug/internal/mocks/mocks"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/account"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Authorizing changes to accounts #authorization", func() {
+	var (
+		labTechAPI *accountAPIServer
+		accountID  string
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		createReq := &account.CreateAccountRequest{
+			Account: fakeAccount(),
+		}
+		createReq.Account.Group = auth.LabTechnician
+		createRes, err := AccountAPI.CreateAccount(ctx, createReq)
+		Expect(err).ShouldNot(HaveOccurred())
+		accountID = createRes.AccountId
+
+		// The lab technician is only authorized for their own account
+		payload := &auth.Payload{ID: accountID, Group: auth.LabTechnician}
+
+		authAPI := &authmocks.AuthAPIMock{}
+		authAPI.On("AuthenticateRequest", mock.Anything).Return(nil)
+		authAPI.On("AuthorizeActor", mock.Anything, accountID).Return(payload, nil)
+		authAPI.On("AuthorizeActor", mock.Anything, mock.Anything).Return(nil, errs.PermissionDenied("AuthorizeActor"))
+		authAPI.On("AuthorizeGroup", mock.Anything, mock.Anything).Return(nil, errs.PermissionDenied("AuthorizeGroup"))
+
+		server := *AccountServer
+		server.authAPI = authAPI
+		labTechAPI = &server
+	})
+
+	It("should fail to update jobs of own account", func() {
+		updateRes, err := labTechAPI.UpdateJobs(ctx, &account.UpdateJobsRequest{
+			AccountId: accountID,
+			Jobs:      fakeJobs().Jobs,
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		Expect(updateRes).To(BeNil())
+	})
+
+	It("should fail to update another account", func() {
+		updateRes, err := labTechAPI.UpdateAccount(ctx, &account.UpdateAccountRequest{
+			AccountId: "another-account",
+			Account:   fakeAccount(),
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+		Expect(updateRes).To(BeNil())
+	})
+
+	It("should ignore group of own account in updates", func() {
+		accountPB := fakeAccount()
+		accountPB.Group = auth.Admin
+		_, err := labTechAPI.UpdateAccount(ctx, &account.UpdateAccountRequest{
+			AccountId: accountID,
+			Account:   accountPB,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		getRes, err := AccountAPI.GetAccount(ctx, &account.GetRequest{AccountId: accountID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(getRes.FirstName).Should(Equal(accountPB.FirstName))
+		Expect(getRes.Group).Should(Equal(auth.LabTechnician))
+	})
+})
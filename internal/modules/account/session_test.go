@@ -0,0 +1,186 @@
+package account
+
+import (
+	"context"
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/pkg/api/account"
+	"github.com/go-redis/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var _ = Describe("Sessions of accounts #session", func() {
+	var (
+		sessionServer *accountAPIServer
+		ctx           context.Context
+	)
+
+	tokenCtx := func(token string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		if sessionServer != nil {
+			return
+		}
+		// Sessions use the real auth API
+		authAPI, err := auth.NewAPI(randomdata.RandStringRunes(32), redis.NewClient(&redis.Options{
+			Addr: redisAddress,
+		}))
+		Expect(err).ShouldNot(HaveOccurred())
+		server := *AccountServer
+		server.authAPI = authAPI
+		sessionServer = &server
+	})
+
+	Describe("Refreshing tokens with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			refreshRes, err := sessionServer.RefreshToken(ctx, nil)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(refreshRes).Should(BeNil())
+		})
+		It("should fail when the refresh token is missing", func() {
+			refreshRes, err := sessionServer.RefreshToken(ctx, &account.RefreshTokenRequest{})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(refreshRes).Should(BeNil())
+		})
+		It("should fail when the refresh token is malformed", func() {
+			refreshRes, err := sessionServer.RefreshToken(ctx, &account.RefreshTokenRequest{RefreshToken: "token"})
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+			Expect(refreshRes).Should(BeNil())
+		})
+	})
+
+	Describe("Sessions of an active account", func() {
+		var (
+			loginReq  *account.LoginRequest
+			accountID string
+		)
+
+		login := func() *account.LoginResponse {
+			loginRes, err := sessionServer.Login(ctx, loginReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			return loginRes
+		}
+
+		It("should create and activate the account", func() {
+			createReq := &account.CreateAccountRequest{
+				Account:         fakeAccount(),
+				Password:        "hakty11",
+				ConfirmPassword: "hakty11",
+			}
+			createRes, err := AccountAPI.CreateAccount(ctx, createReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			accountID = createRes.AccountId
+
+			_, err = AccountAPI.ActivateAccount(ctx, &account.ActivateAccountRequest{AccountId: accountID})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			loginReq = &account.LoginRequest{Username: createReq.Account.Email, Password: "hakty11"}
+		})
+
+		It("should login with short-lived tokens", func() {
+			loginRes := login()
+			Expect(loginRes.AccountState).Should(BeTrue())
+			Expect(loginRes.RefreshToken).ShouldNot(BeZero())
+			Expect(loginRes.TokenExpiresTimestampSec).Should(BeNumerically("<=", time.Now().Add(auth.AccessTokenTTL).Unix()))
+			Expect(loginRes.TokenExpiresTimestampSec).Should(BeNumerically(">", time.Now().Unix()))
+
+			payload, err := sessionServer.authAPI.AuthorizeActor(tokenCtx(loginRes.Token), accountID)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(payload.SessionID).ShouldNot(BeZero())
+		})
+
+		It("should rotate refresh tokens and end sessions whose refresh token is reused", func() {
+			loginRes := login()
+
+			refreshRes, err := sessionServer.RefreshToken(ctx, &account.RefreshTokenRequest{
+				RefreshToken: loginRes.RefreshToken,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(refreshRes.AccountId).Should(Equal(accountID))
+			Expect(refreshRes.RefreshToken).ShouldNot(Equal(loginRes.RefreshToken))
+
+			_, err = sessionServer.RefreshToken(ctx, &account.RefreshTokenRequest{RefreshToken: loginRes.RefreshToken})
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+
+			_, err = sessionServer.RefreshToken(ctx, &account.RefreshTokenRequest{RefreshToken: refreshRes.RefreshToken})
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+
+			_, err = sessionServer.authAPI.AuthorizeActor(tokenCtx(refreshRes.Token), accountID)
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+		})
+
+		It("should revoke tokens of the session on logout", func() {
+			loginRes := login()
+
+			_, err := sessionServer.Logout(tokenCtx(loginRes.Token), &account.LogoutRequest{AccountId: accountID})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = sessionServer.authAPI.AuthorizeActor(tokenCtx(loginRes.Token), accountID)
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+
+			_, err = sessionServer.RefreshToken(ctx, &account.RefreshTokenRequest{RefreshToken: loginRes.RefreshToken})
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+		})
+
+		It("should logout with tokens that are not of a session", func() {
+			token, err := sessionServer.authAPI.GenToken(ctx, &auth.Payload{ID: accountID, Group: auth.Admin}, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = sessionServer.Logout(tokenCtx(token), &account.LogoutRequest{AccountId: accountID})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should revoke tokens issued in the second of the revocation", func() {
+			token, err := sessionServer.authAPI.GenToken(ctx, &auth.Payload{ID: accountID, Group: auth.Admin}, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = sessionServer.RevokeSessions(tokenCtx(token), &account.RevokeSessionsRequest{AccountId: accountID})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = sessionServer.authAPI.AuthorizeActor(tokenCtx(token), accountID)
+			Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+		})
+
+		It("should revoke tokens of all sessions of the account", func() {
+			phoneRes := login()
+			laptopRes := login()
+
+			_, err := sessionServer.RevokeSessions(tokenCtx(laptopRes.Token), &account.RevokeSessionsRequest{
+				AccountId: accountID,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			for _, loginRes := range []*account.LoginResponse{phoneRes, laptopRes} {
+				_, err = sessionServer.authAPI.AuthorizeActor(tokenCtx(loginRes.Token), accountID)
+				Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+
+				_, err = sessionServer.RefreshToken(ctx, &account.RefreshTokenRequest{RefreshToken: loginRes.RefreshToken})
+				Expect(status.Code(err)).Should(Equal(codes.Unauthenticated))
+			}
+
+			// New sessions can be started
+			loginRes := login()
+			_, err = sessionServer.authAPI.AuthorizeActor(tokenCtx(loginRes.Token), accountID)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should not refresh sessions of inactive accounts", func() {
+			loginRes := login()
+
+			err := sessionServer.sqlDB.Table(accountsTable).Where("id=?", accountID).Update("active", false).Error
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = sessionServer.RefreshToken(ctx, &account.RefreshTokenRequest{RefreshToken: loginRes.RefreshToken})
+			Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+		})
+	})
+})
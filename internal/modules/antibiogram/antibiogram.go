@@ -3,6 +3,7 @@ package antibiogram
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"github.com/gidyon/antibug/internal/modules/culture"
@@ -57,7 +58,7 @@ func NewAntibiogramAPIServer(
 		return nil, err
 	}
 
-	authAPI, err := auth.NewAPI(opt.JWTSigningKey)
+	authAPI, err := auth.NewAPI(opt.JWTSigningKey, opt.RedisDB)
 	if err != nil {
 		return nil, err
 	}
@@ -70,11 +71,14 @@ func NewAntibiogramAPIServer(
 	}
 
 	// Auto migration
-	err = api.sqlDB.AutoMigrate(&culture.Culture{}).Error
+	err = api.sqlDB.AutoMigrate(&culture.Culture{}, &culture.Rollup{}).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to auto migrate cultures table: %v", err)
+		return nil, fmt.Errorf("failed to auto migrate cultures and rollups tables: %v", err)
 	}
 
+	// Evict cached antibiograms when cultures change
+	go api.subscribeCultureChanges(ctx)
+
 	return api, nil
 }
 
@@ -88,31 +92,80 @@ const (
 	thirtytwoYears = time.Hour * 24 * 30 * 384
 )
 
-func buildQuery(sqlDB *gorm.DB, filter *antibiogram.Filter) *gorm.DB {
-	// Duration
-	now := time.Now().Unix()
-	switch filter.PastDuration {
+// queryColumns are the columns filters are applied to
+type queryColumns struct {
+	timestamp string
+	age       string
+	// bound converts a timestamp to a value of the timestamp column
+	bound func(timestampSec int64) int64
+	// statuses of cultures with final results. Rollups only have final results.
+	statuses []string
+}
+
+var (
+	cultureColumns = queryColumns{
+		timestamp: "results_timestamp_sec",
+		age:       "patient_age",
+		bound:     func(timestampSec int64) int64 { return timestampSec },
+		statuses:  culture.FinalStatuses,
+	}
+	// Rollups are aggregated by day, so periods are matched to whole days
+	rollupColumns = queryColumns{
+		timestamp: "day",
+		age:       "age_band",
+		bound:     culture.RollupDay,
+	}
+)
+
+func pastDurationQuery(sqlDB *gorm.DB, pastDuration antibiogram.Duration, columns queryColumns) *gorm.DB {
+	var dur time.Duration
+	switch pastDuration {
 	case antibiogram.Duration_PAST_SIX_MONTHS:
-		dur := now - int64(sixMonths)
-		sqlDB = sqlDB.Where("results_timestamp_sec>=?", dur)
+		dur = sixMonths
 	case antibiogram.Duration_PAST_ONE_YEARS:
-		dur := now - int64(twelveMonths)
-		sqlDB = sqlDB.Where("results_timestamp_sec>=?", dur)
+		dur = twelveMonths
 	case antibiogram.Duration_PAST_TWO_YEARS:
-		dur := now - int64(twoYears)
-		sqlDB = sqlDB.Where("results_timestamp_sec>=?", dur)
+		dur = twoYears
 	case antibiogram.Duration_PAST_FOUR_YEARS:
-		dur := now - int64(fourYears)
-		sqlDB = sqlDB.Where("results_timestamp_sec>=?", dur)
+		dur = fourYears
 	case antibiogram.Duration_PAST_EIGHT_YEARS:
-		dur := now - int64(eightYears)
-		sqlDB = sqlDB.Where("results_timestamp_sec>=?", dur)
+		dur = eightYears
 	case antibiogram.Duration_PAST_SIXTEEN_YEARS:
-		dur := now - int64(sixteenYears)
-		sqlDB = sqlDB.Where("results_timestamp_sec>=?", dur)
+		dur = sixteenYears
 	case antibiogram.Duration_PAST_THIRTY_TWO_YEARS:
-		dur := now - int64(thirtytwoYears)
-		sqlDB = sqlDB.Where("results_timestamp_sec>=?", dur)
+		dur = thirtytwoYears
+	default:
+		return sqlDB
+	}
+	return sqlDB.Where(columns.timestamp+">=?", columns.bound(time.Now().Unix()-int64(dur)))
+}
+
+func buildQuery(sqlDB *gorm.DB, filter *antibiogram.Filter, columns queryColumns) *gorm.DB {
+	if len(columns.statuses) > 0 {
+		sqlDB = sqlDB.Where("status IN(?)", columns.statuses)
+	}
+
+	// Calendar year and date range take precedence over duration
+	switch {
+	case filter.GetCalendarYear() > 0:
+		start := time.Date(int(filter.GetCalendarYear()), time.January, 1, 0, 0, 0, 0, time.UTC)
+		sqlDB = sqlDB.Where(
+			columns.timestamp+">=? AND "+columns.timestamp+"<?",
+			columns.bound(start.Unix()), columns.bound(start.AddDate(1, 0, 0).Unix()),
+		)
+	case filter.GetDateFilter().GetFilter():
+		startTimestamp := filter.DateFilter.GetStartTimestampSec()
+		endTimestamp := filter.DateFilter.GetEndTimestampSec()
+		switch {
+		case startTimestamp < endTimestamp:
+			sqlDB = sqlDB.Where(
+				columns.timestamp+" BETWEEN ? AND ?", columns.bound(startTimestamp), columns.bound(endTimestamp),
+			)
+		case startTimestamp > endTimestamp:
+			sqlDB = sqlDB.Where(columns.timestamp+">=?", columns.bound(startTimestamp+1))
+		}
+	default:
+		sqlDB = pastDurationQuery(sqlDB, filter.PastDuration, columns)
 	}
 
 	// RegionScope
@@ -132,7 +185,7 @@ func buildQuery(sqlDB *gorm.DB, filter *antibiogram.Filter) *gorm.DB {
 		}
 	}
 
-	// Advanced => Gender, Age
+	// Advanced => Gender, Age, Ward, Specimen
 	if filter.GetAdvanced() {
 		advancedFilter := filter.GetAdvance()
 		if advancedFilter != nil {
@@ -149,75 +202,114 @@ func buildQuery(sqlDB *gorm.DB, filter *antibiogram.Filter) *gorm.DB {
 			// Age
 			if advancedFilter.GetAgeMinDays() < advancedFilter.GetAgeMaxDays() {
 				sqlDB = sqlDB.Where(
-					"patient_age BETWEEN ? AND ?",
+					columns.age+" BETWEEN ? AND ?",
 					advancedFilter.GetAgeMinDays()/(365), advancedFilter.GetAgeMaxDays()/(365),
 				)
 			}
+
+			// Ward and specimen
+			if len(advancedFilter.GetWards()) > 0 {
+				sqlDB = sqlDB.Where("ward IN(?)", advancedFilter.GetWards())
+			}
+			departments, settings, specimenTypes := specimenValues(advancedFilter)
+			if len(departments) > 0 {
+				sqlDB = sqlDB.Where("department IN(?)", departments)
+			}
+			if len(settings) > 0 {
+				sqlDB = sqlDB.Where("patient_setting IN(?)", settings)
+			}
+			if len(specimenTypes) > 0 {
+				sqlDB = sqlDB.Where("specimen_type IN(?)", specimenTypes)
+			}
 		}
 	}
 
 	return sqlDB
 }
 
-func maxLabel(labels map[culture_pb.Label]int32) culture_pb.Label {
-	var (
-		label culture_pb.Label
-		score int32
-	)
-	for key, value := range labels {
-		if value > score {
-			label = key
-		}
+// specimenValues returns sorted names of departments, patient settings and specimen types of the filter
+func specimenValues(advancedFilter *antibiogram.AdvancedFilter) (departments, settings, specimenTypes []string) {
+	for _, department := range advancedFilter.GetDepartments() {
+		departments = append(departments, department.String())
+	}
+	for _, setting := range advancedFilter.GetPatientSettings() {
+		settings = append(settings, setting.String())
 	}
-	return label
+	for _, specimenType := range advancedFilter.GetSpecimenTypes() {
+		specimenTypes = append(specimenTypes, specimenType.String())
+	}
+	sort.Strings(departments)
+	sort.Strings(settings)
+	sort.Strings(specimenTypes)
+	return departments, settings, specimenTypes
 }
 
+// genFilterHash hashes the criteria used to select cultures. Input values are excluded
+// since cache keys identify the antibiogram item separately.
 func genFilterHash(filter *antibiogram.Filter) string {
+	scopeValues := append([]string{}, filter.GetScopeValues()...)
+	sort.Strings(scopeValues)
+
 	// Filter criteria
 	str := fmt.Sprintf(
-		"%d%d%s%t",
+		"%d|%d|%s|%t",
 		filter.GetPastDuration(),
 		filter.GetRegionScope(),
-		strings.Join(filter.GetScopeValues(), ","),
+		strings.Join(scopeValues, ","),
 		filter.GetAdvanced(),
 	)
-	// Input values
-	if len(filter.GetInputValues()) > 0 {
-		inputValues := make([]string, 0, len(filter.InputValues))
-		for _, inputValue := range filter.GetInputValues() {
-			inputValues = append(inputValues, inputValue.GetId())
-		}
-		sort.Strings(inputValues)
-		str += strings.Join(inputValues, ",")
-	}
+	// Mode
+	str += fmt.Sprintf("|%d|%d|%t", filter.GetMode(), filter.GetMinIsolates(), filter.GetHideInsufficient())
+	// Period
+	str += fmt.Sprintf(
+		"|%d|%d|%t|%d",
+		filter.GetDateFilter().GetStartTimestampSec(),
+		filter.GetDateFilter().GetEndTimestampSec(),
+		filter.GetDateFilter().GetFilter(),
+		filter.GetCalendarYear(),
+	)
 	// Advance
 	if filter.GetAdvance() != nil {
 		str += fmt.Sprintf(
-			"%d%d%d",
+			"|%d|%d|%d",
 			filter.Advance.GetGender(),
 			filter.Advance.GetAgeMaxDays(),
 			filter.Advance.GetAgeMinDays(),
 		)
+		// Ward and specimen values are sorted so that their order does not change the hash
+		wards := append([]string{}, filter.Advance.GetWards()...)
+		sort.Strings(wards)
+		departments, settings, specimenTypes := specimenValues(filter.Advance)
+		str += fmt.Sprintf(
+			"|%s|%s|%s|%s",
+			strings.Join(wards, ","),
+			strings.Join(departments, ","),
+			strings.Join(settings, ","),
+			strings.Join(specimenTypes, ","),
+		)
 	}
 
 	// Apply hash
 	sum := sha256.Sum224([]byte(str))
 
-	return string(sum[:])
+	return hex.EncodeToString(sum[:])
 }
 
 func (api *apiServer) getPathogenAntibiogramFromCache(
 	ctx context.Context, filter *antibiogram.Filter, index int,
 ) (*antibiogram.PathogenAntibiogram, error) {
-	// Get hash of filter query
-	filterHash := genFilterHash(filter)
+	// Cache key of the antibiogram
+	pathogenPB := filter.GetInputValues()[index]
+	entry := newCacheEntry(
+		cacheKey(viewPathogen, filter, pathogenPB.GetId()), filter, pathogenTag(pathogenPB.GetId()),
+	)
 
 	// Check cache if it exists
-	data, err := api.redisClient.Get(ctx, filterHash).Result()
+	data, err := api.getCache(ctx, viewPathogen, entry, filter.GetMaxStalenessSec())
 	switch {
 	case err == nil:
 	case errors.Is(err, redis.Nil):
-		return api.getPathogenAntibiogram(ctx, filterHash, filter, index)
+		return api.getPathogenAntibiogram(ctx, entry, filter, index)
 	default:
 		return nil, errs.RedisCmdFailed(err, "GET")
 	}
@@ -233,18 +325,14 @@ func (api *apiServer) getPathogenAntibiogramFromCache(
 }
 
 func (api *apiServer) getPathogenAntibiogram(
-	ctx context.Context, queryHash string, filter *antibiogram.Filter, index int,
+	ctx context.Context, entry *cacheEntry, filter *antibiogram.Filter, index int,
 ) (*antibiogram.PathogenAntibiogram, error) {
 
-	culturesDB := make([]*culture.Culture, 0, 500)
-
 	pathogenPB := filter.GetInputValues()[index]
 
-	// Parse filter
-	sqlDB := buildQuery(api.sqlDB, filter).Where("? MEMBER OF(pathogens_found)", pathogenPB.GetId())
-	err := sqlDB.Find(&culturesDB).Error
+	stats, err := api.getPairStats(filter, []string{pathogenPB.GetId()}, nil, false)
 	if err != nil {
-		return nil, errs.SQLQueryFailed(err, "SELECT")
+		return nil, err
 	}
 
 	pathogenAntibiogram := &antibiogram.PathogenAntibiogram{
@@ -255,44 +343,48 @@ func (api *apiServer) getPathogenAntibiogram(
 
 	pathogenSusceptibilities := make(map[string]*antibiogram.PathogenSusceptibility, 0)
 
-	averageLabel := make(map[culture_pb.Label]int32, 0)
+	labelCounts := make(labelCounter)
 
-	// Range over results and populate response slice
-	for _, cultureDB := range culturesDB {
-		culturePB, err := culture.GetCulturePB(cultureDB)
-		if err != nil {
-			return nil, err
+	scoreSums := make(map[string]float64)
+
+	// Range over stats and populate response slice
+	for _, stat := range stats {
+		if stat.isolate() {
+			continue
 		}
 
-		for _, cultureResult := range culturePB.GetCultureResults() {
-			if cultureResult.GetPathogenId() == pathogenPB.GetId() {
-				pathogenSusceptibility, ok := pathogenSusceptibilities[pathogenPB.GetId()]
-				if !ok {
-					pathogenSusceptibilities[pathogenPB.GetId()] = &antibiogram.PathogenSusceptibility{
-						AntimicrobialName:   cultureResult.GetAntimicrobialName(),
-						AntimicrobialId:     cultureResult.GetAntimicrobialId(),
-						Isolates:            1,
-						SusceptibilityScore: cultureResult.GetSusceptibilityScore(),
-						Label:               cultureResult.Label,
-					}
-					averageLabel[cultureResult.Label]++
-					continue
-				}
-
-				pathogenSusceptibility.Isolates++
-
-				// Average susceptibility score
-				pathogenSusceptibility.SusceptibilityScore += cultureResult.GetSusceptibilityScore()
-				pathogenSusceptibility.SusceptibilityScore = pathogenSusceptibility.SusceptibilityScore / 2
-				// Average label score
-				averageLabel[cultureResult.Label]++
-				pathogenSusceptibility.Label = maxLabel(averageLabel)
+		pathogenSusceptibility, ok := pathogenSusceptibilities[stat.AntimicrobialID]
+		if !ok {
+			pathogenSusceptibility = &antibiogram.PathogenSusceptibility{
+				AntimicrobialName: stat.AntimicrobialName,
+				AntimicrobialId:   stat.AntimicrobialID,
 			}
+			pathogenSusceptibilities[stat.AntimicrobialID] = pathogenSusceptibility
+		}
+
+		isolates := int32(stat.Isolates)
+		pathogenSusceptibility.Isolates += isolates
+		if stat.label() == culture_pb.Label_SUSCEPTIBLE {
+			pathogenSusceptibility.IsolatesSusceptible += isolates
 		}
+
+		scoreSums[stat.AntimicrobialID] += stat.ScoreSum
+		labelCounts.add(stat.AntimicrobialID, stat.label(), isolates)
 	}
 
 	// Add individual susceptibility to list of susceptibilities
 	for _, val := range pathogenSusceptibilities {
+		// Average susceptibility score
+		val.SusceptibilityScore = float32(scoreSums[val.AntimicrobialId] / float64(val.Isolates))
+		val.PercentSusceptible = percentOf(val.IsolatesSusceptible, val.Isolates)
+		val.LabelStats = labelCounts.stats(val.AntimicrobialId, val.Isolates)
+		val.Label = labelCounts.max(val.AntimicrobialId)
+		if val.Isolates < minIsolates(filter) {
+			if filter.GetHideInsufficient() {
+				continue
+			}
+			val.InsufficientIsolates = true
+		}
 		pathogenAntibiogram.Susceptibilities = append(pathogenAntibiogram.Susceptibilities, val)
 	}
 
@@ -303,7 +395,7 @@ func (api *apiServer) getPathogenAntibiogram(
 	}
 
 	// Save to cache
-	err = api.redisClient.Set(ctx, queryHash, bs, time.Hour*24*7).Err()
+	err = api.setCache(ctx, entry, bs)
 	if err != nil {
 		return nil, errs.WrapErrWithMessage(codes.Internal, err, "fail to save antibiogram from cache")
 	}
@@ -312,12 +404,22 @@ func (api *apiServer) getPathogenAntibiogram(
 }
 
 func validateFilter(filter *antibiogram.Filter) error {
+	if len(filter.GetInputValues()) == 0 {
+		return errs.MissingField("InputValues")
+	}
+	return validateFilterScope(filter)
+}
+
+// validateFilterScope validates the region and period of the filter
+func validateFilterScope(filter *antibiogram.Filter) error {
 	var err error
 	switch {
-	case len(filter.GetInputValues()) == 0:
-		err = errs.MissingField("InputValues")
 	case filter.RegionScope != antibiogram.RegionScope_COUNTRY && len(filter.GetScopeValues()) == 0:
 		err = errs.MissingField("ScopeValues")
+	case filter.GetCalendarYear() < 0 || int(filter.GetCalendarYear()) > time.Now().Year():
+		err = errs.WrapMessage(codes.InvalidArgument, "calendar year must not be in the future")
+	case filter.GetDateFilter().GetStartTimestampSec() < 0 || filter.GetDateFilter().GetEndTimestampSec() < 0:
+		err = errs.WrapMessage(codes.InvalidArgument, "date filter timestamps must not be negative")
 	}
 	return err
 }
@@ -387,15 +489,18 @@ func (api *apiServer) GenPathogenAntibiogram(
 func (api *apiServer) getAntimicrobialAntibiogramFromCache(
 	ctx context.Context, filter *antibiogram.Filter, index int,
 ) (*antibiogram.AntimicrobialAntibiogram, error) {
-	// Get hash of filter query
-	filterHash := genFilterHash(filter)
+	// Cache key of the antibiogram
+	antimicrobialPB := filter.GetInputValues()[index]
+	entry := newCacheEntry(
+		cacheKey(viewAntimicrobial, filter, antimicrobialPB.GetId()), filter, antimicrobialTag(antimicrobialPB.GetId()),
+	)
 
 	// Check cache if it exists
-	data, err := api.redisClient.Get(ctx, filterHash).Result()
+	data, err := api.getCache(ctx, viewAntimicrobial, entry, filter.GetMaxStalenessSec())
 	switch {
 	case err == nil:
 	case errors.Is(err, redis.Nil):
-		return api.getAntimicrobialAntibiogram(ctx, filterHash, filter, index)
+		return api.getAntimicrobialAntibiogram(ctx, entry, filter, index)
 	default:
 		return nil, errs.RedisCmdFailed(err, "GET")
 	}
@@ -411,18 +516,14 @@ func (api *apiServer) getAntimicrobialAntibiogramFromCache(
 }
 
 func (api *apiServer) getAntimicrobialAntibiogram(
-	ctx context.Context, queryHash string, filter *antibiogram.Filter, index int,
+	ctx context.Context, entry *cacheEntry, filter *antibiogram.Filter, index int,
 ) (*antibiogram.AntimicrobialAntibiogram, error) {
 
-	culturesDB := make([]*culture.Culture, 0, 500)
-
 	antimicrobialPB := filter.GetInputValues()[index]
 
-	// Parse filter
-	sqlDB := buildQuery(api.sqlDB, filter).Where("? MEMBER OF(antimicrobials_used)", antimicrobialPB.GetId())
-	err := sqlDB.Find(&culturesDB).Error
+	stats, err := api.getPairStats(filter, nil, []string{antimicrobialPB.GetId()}, false)
 	if err != nil {
-		return nil, errs.SQLQueryFailed(err, "SELECT")
+		return nil, err
 	}
 
 	antimicrobialAntibiogram := &antibiogram.AntimicrobialAntibiogram{
@@ -433,45 +534,48 @@ func (api *apiServer) getAntimicrobialAntibiogram(
 
 	antimicrobialSusceptibilities := make(map[string]*antibiogram.AntimicrobialSusceptibility, 0)
 
-	averageLabel := make(map[culture_pb.Label]int32, 0)
+	labelCounts := make(labelCounter)
 
-	// Range over results and populate response slice
-	for _, cultureDB := range culturesDB {
-		culturePB, err := culture.GetCulturePB(cultureDB)
-		if err != nil {
-			return nil, err
+	scoreSums := make(map[string]float64)
+
+	// Range over stats and populate response slice
+	for _, stat := range stats {
+		if stat.isolate() {
+			continue
 		}
 
-		for _, cultureResult := range culturePB.GetCultureResults() {
-			if cultureResult.GetAntimicrobialId() == antimicrobialPB.GetId() {
-				antimicrobialSusceptibility, ok := antimicrobialSusceptibilities[antimicrobialPB.GetId()]
-				if !ok {
-					antimicrobialSusceptibilities[antimicrobialPB.GetId()] = &antibiogram.AntimicrobialSusceptibility{
-						PathogenName:        cultureResult.GetPathogenName(),
-						PathogenId:          cultureResult.GetPathogenId(),
-						Isolates:            1,
-						SusceptibilityScore: cultureResult.GetSusceptibilityScore(),
-						Label:               cultureResult.Label,
-					}
-					averageLabel[cultureResult.Label]++
-					continue
-				}
-
-				antimicrobialSusceptibility.Isolates++
-
-				// Average susceptibility score
-				antimicrobialSusceptibility.SusceptibilityScore += cultureResult.GetSusceptibilityScore()
-				antimicrobialSusceptibility.SusceptibilityScore = antimicrobialSusceptibility.SusceptibilityScore / 2
-
-				// Average label score
-				averageLabel[cultureResult.Label]++
-				antimicrobialSusceptibility.Label = maxLabel(averageLabel)
+		antimicrobialSusceptibility, ok := antimicrobialSusceptibilities[stat.PathogenID]
+		if !ok {
+			antimicrobialSusceptibility = &antibiogram.AntimicrobialSusceptibility{
+				PathogenName: stat.PathogenName,
+				PathogenId:   stat.PathogenID,
 			}
+			antimicrobialSusceptibilities[stat.PathogenID] = antimicrobialSusceptibility
+		}
+
+		isolates := int32(stat.Isolates)
+		antimicrobialSusceptibility.Isolates += isolates
+		if stat.label() == culture_pb.Label_SUSCEPTIBLE {
+			antimicrobialSusceptibility.IsolatesSusceptible += isolates
 		}
+
+		scoreSums[stat.PathogenID] += stat.ScoreSum
+		labelCounts.add(stat.PathogenID, stat.label(), isolates)
 	}
 
 	// Add individual susceptibility to list of susceptibilities
 	for _, val := range antimicrobialSusceptibilities {
+		// Average susceptibility score
+		val.SusceptibilityScore = float32(scoreSums[val.PathogenId] / float64(val.Isolates))
+		val.PercentSusceptible = percentOf(val.IsolatesSusceptible, val.Isolates)
+		val.LabelStats = labelCounts.stats(val.PathogenId, val.Isolates)
+		val.Label = labelCounts.max(val.PathogenId)
+		if val.Isolates < minIsolates(filter) {
+			if filter.GetHideInsufficient() {
+				continue
+			}
+			val.InsufficientIsolates = true
+		}
 		antimicrobialAntibiogram.Susceptibilities = append(antimicrobialAntibiogram.Susceptibilities, val)
 	}
 
@@ -482,7 +586,7 @@ func (api *apiServer) getAntimicrobialAntibiogram(
 	}
 
 	// Save to cache
-	err = api.redisClient.Set(ctx, queryHash, bs, time.Hour*24*7).Err()
+	err = api.setCache(ctx, entry, bs)
 	if err != nil {
 		return nil, errs.WrapErrWithMessage(codes.Internal, err, "fail to save antibiogram from cache")
 	}
@@ -0,0 +1,387 @@
+package antibiogram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/gidyon/antibug/internal/modules/culture"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/ptypes/empty"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cache keys are namespaced and versioned. Bump cacheVersion whenever cached messages change
+// in a way that older entries should not be decoded.
+const (
+	cacheNamespace = "antibiogram"
+	cacheVersion   = "v1"
+	cacheTTL       = time.Hour * 24 * 7
+	cacheTagPrefix = cacheNamespace + ":" + cacheVersion + ":tags:"
+	cacheGenPrefix = cacheNamespace + ":" + cacheVersion + ":generations:"
+	cacheStatsKey  = cacheNamespace + ":" + cacheVersion + ":stats"
+	allPathogens   = "pathogen:*"
+)
+
+// Antibiogram views that are cached
+const (
+	viewPathogen      = "pathogen"
+	viewAntimicrobial = "antimicrobial"
+	viewMatrix        = "matrix"
+	viewTrend         = "trend"
+	viewPrevalence    = "prevalence"
+)
+
+var cachedViews = []string{viewPathogen, viewAntimicrobial, viewMatrix, viewTrend, viewPrevalence}
+
+// cacheKey returns key of an antibiogram view for the filter and items of the view
+func cacheKey(view string, filter *antibiogram.Filter, items ...string) string {
+	key := strings.Join([]string{cacheNamespace, cacheVersion, view, genFilterHash(filter)}, ":")
+	if len(items) > 0 {
+		key += ":" + strings.Join(items, ",")
+	}
+	return key
+}
+
+func pathogenTag(pathogenID string) string {
+	return "pathogen:" + pathogenID
+}
+
+func antimicrobialTag(antimicrobialID string) string {
+	return "antimicrobial:" + antimicrobialID
+}
+
+// regionTags returns tags for the regions whose cultures are included by the filter
+func regionTags(filter *antibiogram.Filter) []string {
+	var prefix string
+	switch filter.GetRegionScope() {
+	case antibiogram.RegionScope_COUNTY:
+		prefix = "county:"
+	case antibiogram.RegionScope_SUB_COUNTY:
+		prefix = "sub_county:"
+	case antibiogram.RegionScope_FACILITY:
+		prefix = "facility:"
+	}
+	if prefix == "" || len(filter.GetScopeValues()) == 0 {
+		return []string{"country"}
+	}
+	tags := make([]string, 0, len(filter.GetScopeValues()))
+	for _, scopeValue := range filter.GetScopeValues() {
+		tags = append(tags, prefix+scopeValue)
+	}
+	return tags
+}
+
+// cacheEntry is a cached antibiogram with the tags it is evicted by. Generations of the tags are
+// read when the entry is missing so that an antibiogram computed from cultures that change
+// meanwhile is not cached.
+type cacheEntry struct {
+	key               string
+	regionTags        []string
+	itemTags          []string
+	regionGenerations []int64
+	itemGenerations   []int64
+}
+
+func newCacheEntry(key string, filter *antibiogram.Filter, itemTags ...string) *cacheEntry {
+	entry := &cacheEntry{
+		key:        key,
+		regionTags: make([]string, 0, len(filter.GetScopeValues())+1),
+		itemTags:   make([]string, 0, len(itemTags)),
+	}
+	for _, tag := range regionTags(filter) {
+		entry.regionTags = append(entry.regionTags, "region:"+tag)
+	}
+	for _, tag := range itemTags {
+		entry.itemTags = append(entry.itemTags, "item:"+tag)
+	}
+	return entry
+}
+
+func generationKeys(tags []string) []string {
+	keys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		keys = append(keys, cacheGenPrefix+tag)
+	}
+	return keys
+}
+
+// getGenerations gets generations of the tags. Tags that were never evicted are at generation 0.
+func getGenerations(ctx context.Context, cmd redis.Cmdable, tags []string) ([]int64, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	values, err := cmd.MGet(ctx, generationKeys(tags)...).Result()
+	if err != nil {
+		return nil, err
+	}
+	generations := make([]int64, 0, len(values))
+	for _, value := range values {
+		var generation int64
+		if s, ok := value.(string); ok {
+			generation, err = strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+		}
+		generations = append(generations, generation)
+	}
+	return generations, nil
+}
+
+func generationChanged(before, after []int64) bool {
+	for i := range before {
+		if before[i] != after[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// readGenerations records generations of tags of the entry before its antibiogram is computed
+func (api *apiServer) readGenerations(ctx context.Context, entry *cacheEntry) error {
+	var err error
+	entry.regionGenerations, err = getGenerations(ctx, api.redisClient, entry.regionTags)
+	if err != nil {
+		return err
+	}
+	entry.itemGenerations, err = getGenerations(ctx, api.redisClient, entry.itemTags)
+	return err
+}
+
+// getCache gets a cached antibiogram. Entries older than maxStalenessSec are treated as missing.
+func (api *apiServer) getCache(
+	ctx context.Context, view string, entry *cacheEntry, maxStalenessSec int64,
+) (string, error) {
+	data, err := api.getFreshCache(ctx, entry.key, maxStalenessSec)
+	switch {
+	case err == nil:
+		api.recordCacheLookup(ctx, view, "hits")
+	case errors.Is(err, redis.Nil):
+		api.recordCacheLookup(ctx, view, "misses")
+		if err := api.readGenerations(ctx, entry); err != nil {
+			return "", err
+		}
+	}
+	return data, err
+}
+
+func (api *apiServer) getFreshCache(ctx context.Context, key string, maxStalenessSec int64) (string, error) {
+	data, err := api.redisClient.Get(ctx, key).Result()
+	if err != nil || maxStalenessSec <= 0 {
+		return data, err
+	}
+
+	// Age of an entry is worked out from its remaining time to live
+	ttl, err := api.redisClient.TTL(ctx, key).Result()
+	if err != nil {
+		return "", err
+	}
+	if cacheTTL-ttl > time.Duration(maxStalenessSec)*time.Second {
+		return "", redis.Nil
+	}
+
+	return data, nil
+}
+
+// recordCacheLookup counts cache hits and misses. Statistics are best effort.
+func (api *apiServer) recordCacheLookup(ctx context.Context, view, result string) {
+	err := api.redisClient.HIncrBy(ctx, cacheStatsKey, view+":"+result, 1).Err()
+	if err != nil {
+		api.logger.Warningf("failed to record antibiogram cache %s: %v", result, err)
+	}
+}
+
+func hitRatio(hits, misses int64) float32 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return float32(hits) / float32(hits+misses)
+}
+
+func (api *apiServer) GetCacheStats(
+	ctx context.Context, _ *empty.Empty,
+) (*antibiogram.CacheStats, error) {
+	// Authorization
+	_, err := api.authAPI.AuthorizeGroup(ctx, auth.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	counters, err := api.redisClient.HGetAll(ctx, cacheStatsKey).Result()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "HGETALL")
+	}
+
+	cacheStats := &antibiogram.CacheStats{
+		Views: make([]*antibiogram.ViewCacheStats, 0, len(cachedViews)),
+	}
+
+	for _, view := range cachedViews {
+		hits, _ := strconv.ParseInt(counters[view+":hits"], 10, 64)
+		misses, _ := strconv.ParseInt(counters[view+":misses"], 10, 64)
+		cacheStats.Views = append(cacheStats.Views, &antibiogram.ViewCacheStats{
+			View:     view,
+			Hits:     hits,
+			Misses:   misses,
+			HitRatio: hitRatio(hits, misses),
+		})
+		cacheStats.Hits += hits
+		cacheStats.Misses += misses
+	}
+	cacheStats.HitRatio = hitRatio(cacheStats.Hits, cacheStats.Misses)
+
+	return cacheStats, nil
+}
+
+// setCache caches an antibiogram and indexes it by region and subject so that it can be
+// evicted when cultures it was computed from change. The antibiogram is not cached when a
+// culture of its region and subject changed since the entry was found missing.
+func (api *apiServer) setCache(ctx context.Context, entry *cacheEntry, data []byte) error {
+	keys := append(generationKeys(entry.regionTags), generationKeys(entry.itemTags)...)
+
+	err := api.redisClient.Watch(ctx, func(tx *redis.Tx) error {
+		regionGenerations, err := getGenerations(ctx, tx, entry.regionTags)
+		if err != nil {
+			return err
+		}
+		itemGenerations, err := getGenerations(ctx, tx, entry.itemTags)
+		if err != nil {
+			return err
+		}
+		if generationChanged(entry.regionGenerations, regionGenerations) && generationChanged(entry.itemGenerations, itemGenerations) {
+			return nil
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipeliner redis.Pipeliner) error {
+			pipeliner.Set(ctx, entry.key, data, cacheTTL)
+			for _, tag := range entry.regionTags {
+				pipeliner.SAdd(ctx, cacheTagPrefix+tag, entry.key)
+				pipeliner.Expire(ctx, cacheTagPrefix+tag, cacheTTL)
+			}
+			for _, tag := range entry.itemTags {
+				pipeliner.SAdd(ctx, cacheTagPrefix+tag, entry.key)
+				pipeliner.Expire(ctx, cacheTagPrefix+tag, cacheTTL)
+			}
+			return nil
+		})
+		return err
+	}, keys...)
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.TxFailedErr):
+		// A culture changed while the antibiogram was being cached
+	default:
+		return errs.RedisCmdFailed(err, "SET")
+	}
+	return nil
+}
+
+// evictCultureChange deletes cached antibiograms whose region and subject match the changed culture
+func (api *apiServer) evictCultureChange(ctx context.Context, event *culture.ChangeEvent) error {
+	changedRegions := []string{"region:country"}
+	for _, countyCode := range event.CountyCodes {
+		changedRegions = append(changedRegions, "region:county:"+countyCode)
+	}
+	for _, subCountyCode := range event.SubCountyCodes {
+		changedRegions = append(changedRegions, "region:sub_county:"+subCountyCode)
+	}
+	for _, hospitalID := range event.HospitalIDs {
+		changedRegions = append(changedRegions, "region:facility:"+hospitalID)
+	}
+
+	changedItems := []string{"item:" + allPathogens}
+	for _, pathogenID := range event.Pathogens {
+		changedItems = append(changedItems, "item:"+pathogenTag(pathogenID))
+	}
+	for _, antimicrobialID := range event.Antimicrobials {
+		changedItems = append(changedItems, "item:"+antimicrobialTag(antimicrobialID))
+	}
+
+	// Bump generations first so that antibiograms being computed meanwhile are not cached
+	_, err := api.redisClient.TxPipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		for _, key := range generationKeys(append(changedRegions, changedItems...)) {
+			pipeliner.Incr(ctx, key)
+			pipeliner.Expire(ctx, key, cacheTTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.RedisCmdFailed(err, "INCR")
+	}
+
+	regionKeys := make([]string, 0, len(changedRegions))
+	for _, tag := range changedRegions {
+		regionKeys = append(regionKeys, cacheTagPrefix+tag)
+	}
+	itemKeys := make([]string, 0, len(changedItems))
+	for _, tag := range changedItems {
+		itemKeys = append(itemKeys, cacheTagPrefix+tag)
+	}
+
+	regionMatches, err := api.redisClient.SUnion(ctx, regionKeys...).Result()
+	if err != nil {
+		return errs.RedisCmdFailed(err, "SUNION")
+	}
+	itemMatches, err := api.redisClient.SUnion(ctx, itemKeys...).Result()
+	if err != nil {
+		return errs.RedisCmdFailed(err, "SUNION")
+	}
+
+	inRegion := make(map[string]struct{}, len(regionMatches))
+	for _, key := range regionMatches {
+		inRegion[key] = struct{}{}
+	}
+
+	keys := make([]string, 0)
+	for _, key := range itemMatches {
+		if _, ok := inRegion[key]; ok {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	err = api.redisClient.Del(ctx, keys...).Err()
+	if err != nil {
+		return errs.RedisCmdFailed(err, "DEL")
+	}
+
+	return nil
+}
+
+// subscribeCultureChanges evicts cached antibiograms as cultures are created, updated or deleted
+func (api *apiServer) subscribeCultureChanges(ctx context.Context) {
+	pubsub := api.redisClient.Subscribe(ctx, culture.ChangesChannel)
+	defer pubsub.Close()
+
+	changes := pubsub.Channel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-changes:
+			if !ok {
+				return
+			}
+
+			event := &culture.ChangeEvent{}
+			err := json.Unmarshal([]byte(msg.Payload), event)
+			if err != nil {
+				api.logger.Errorf("failed to json unmarshal culture change event: %v", err)
+				continue
+			}
+
+			err = api.evictCultureChange(ctx, event)
+			if err != nil {
+				api.logger.Errorf("failed to evict antibiograms for culture %s: %v", event.CultureID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package antibiogram
+
+import (
+	"context"
+	"errors"
+	"github.com/gidyon/antibug/internal/modules/culture"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Caching antibiograms #cache", func() {
+	var (
+		filter *antibiogram.Filter
+		ctx    context.Context
+		key    string
+		entry  *cacheEntry
+	)
+
+	BeforeEach(func() {
+		filter = fakeFilter(subjectPathogen)
+		filter.RegionScope = antibiogram.RegionScope_COUNTY
+		filter.ScopeValues = []string{culture.CountyCode()}
+		ctx = context.Background()
+		key = cacheKey("test", filter, filter.InputValues[0].Id)
+
+		entry = newCacheEntry(key, filter, pathogenTag(filter.InputValues[0].Id))
+
+		err := AntibiogramServer.readGenerations(ctx, entry)
+		Expect(err).ShouldNot(HaveOccurred())
+		err = AntibiogramServer.setCache(ctx, entry, []byte("data"))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should use distinct keys for each view and item", func() {
+		pathogenKey := cacheKey(viewPathogen, filter, filter.InputValues[0].Id)
+		Expect(pathogenKey).ShouldNot(Equal(cacheKey(viewAntimicrobial, filter, filter.InputValues[0].Id)))
+		Expect(pathogenKey).ShouldNot(Equal(cacheKey(viewPathogen, filter, filter.InputValues[1].Id+"x")))
+		Expect(pathogenKey).Should(HavePrefix(cacheNamespace + ":" + cacheVersion + ":" + viewPathogen + ":"))
+	})
+
+	It("should report cache hits and misses for every view", func() {
+		_, err := AntibiogramServer.getCache(ctx, viewMatrix, entry, 0)
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = AntibiogramServer.getCache(ctx, viewMatrix, newCacheEntry(key+"missing", filter), 0)
+		Expect(errors.Is(err, redis.Nil)).Should(BeTrue())
+
+		cacheStats, err := AntibiogramAPI.GetCacheStats(ctx, &empty.Empty{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(status.Code(err)).Should(Equal(codes.OK))
+		Expect(cacheStats.Views).Should(HaveLen(len(cachedViews)))
+		Expect(cacheStats.Hits).Should(BeNumerically(">=", 1))
+		Expect(cacheStats.Misses).Should(BeNumerically(">=", 1))
+		Expect(cacheStats.HitRatio).Should(BeNumerically(">", 0))
+		Expect(cacheStats.HitRatio).Should(BeNumerically("<", 1))
+	})
+
+	It("should tag antibiograms by region", func() {
+		Expect(regionTags(filter)).Should(ConsistOf("county:" + filter.ScopeValues[0]))
+		filter.RegionScope = antibiogram.RegionScope_COUNTRY
+		Expect(regionTags(filter)).Should(ConsistOf("country"))
+	})
+
+	It("should treat stale entries as missing", func() {
+		data, err := AntibiogramServer.getCache(ctx, "test", entry, 0)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(data).Should(Equal("data"))
+
+		Expect(AntibiogramServer.redisClient.Expire(ctx, key, cacheTTL/2).Err()).ShouldNot(HaveOccurred())
+		_, err = AntibiogramServer.getCache(ctx, "test", entry, 60)
+		Expect(errors.Is(err, redis.Nil)).Should(BeTrue())
+	})
+
+	It("should not evict antibiograms when changed culture does not match", func() {
+		err := AntibiogramServer.evictCultureChange(ctx, &culture.ChangeEvent{
+			CountyCodes: filter.ScopeValues,
+			Pathogens:   []string{"unknown pathogen"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = AntibiogramServer.getCache(ctx, "test", entry, 0)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("should evict antibiograms when changed culture matches", func() {
+		err := AntibiogramServer.evictCultureChange(ctx, &culture.ChangeEvent{
+			CountyCodes: filter.ScopeValues,
+			Pathogens:   []string{filter.InputValues[0].Id},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		_, err = AntibiogramServer.getCache(ctx, "test", entry, 0)
+		Expect(errors.Is(err, redis.Nil)).Should(BeTrue())
+	})
+
+	Describe("Caching antibiograms computed while cultures change", func() {
+		var pendingEntry *cacheEntry
+
+		BeforeEach(func() {
+			pendingEntry = newCacheEntry(key+"pending", filter, pathogenTag(filter.InputValues[0].Id))
+
+			// The antibiogram is missing and starts being computed
+			_, err := AntibiogramServer.getCache(ctx, "test", pendingEntry, 0)
+			Expect(errors.Is(err, redis.Nil)).Should(BeTrue())
+		})
+
+		It("should not cache the antibiogram when a culture it was computed from changed", func() {
+			err := AntibiogramServer.evictCultureChange(ctx, &culture.ChangeEvent{
+				CountyCodes: filter.ScopeValues,
+				Pathogens:   []string{filter.InputValues[0].Id},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = AntibiogramServer.setCache(ctx, pendingEntry, []byte("stale data"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = AntibiogramServer.getCache(ctx, "test", pendingEntry, 0)
+			Expect(errors.Is(err, redis.Nil)).Should(BeTrue())
+		})
+
+		It("should cache the antibiogram when changed culture does not match", func() {
+			err := AntibiogramServer.evictCultureChange(ctx, &culture.ChangeEvent{
+				CountyCodes: filter.ScopeValues,
+				Pathogens:   []string{"unknown pathogen"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = AntibiogramServer.setCache(ctx, pendingEntry, []byte("data"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			data, err := AntibiogramServer.getCache(ctx, "test", pendingEntry, 0)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(data).Should(Equal("data"))
+		})
+	})
+})
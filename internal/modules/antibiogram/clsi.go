@@ -0,0 +1,44 @@
+package antibiogram
+
+import (
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+)
+
+// CLSI M39 recommends not reporting %S for pathogen-antimicrobial pairs with fewer isolates
+const clsiMinIsolates = 30
+
+// isolateTracker remembers the culture holding the first isolate of a pathogen for a patient.
+// Cultures must be visited in chronological order.
+type isolateTracker map[string]uint
+
+// first reports whether the culture holds the first isolate of the pathogen for the patient
+func (tracker isolateTracker) first(patientID, pathogenID string, cultureID uint) bool {
+	key := patientID + "/" + pathogenID
+	firstID, ok := tracker[key]
+	if !ok {
+		tracker[key] = cultureID
+		return true
+	}
+	return firstID == cultureID
+}
+
+func firstIsolateOnly(filter *antibiogram.Filter) bool {
+	return filter.GetMode() == antibiogram.AntibiogramMode_CLSI_M39
+}
+
+func minIsolates(filter *antibiogram.Filter) int32 {
+	switch {
+	case filter.GetMinIsolates() > 0:
+		return filter.GetMinIsolates()
+	case filter.GetMode() == antibiogram.AntibiogramMode_CLSI_M39:
+		return clsiMinIsolates
+	}
+	return 0
+}
+
+func percentOf(part, total int32) float32 {
+	if total == 0 {
+		return 0
+	}
+	return float32(part) * 100 / float32(total)
+}
@@ -0,0 +1,155 @@
+package antibiogram
+
+import (
+	"context"
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/antibug/internal/modules/culture"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	culture_pb "github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// seedCulture saves a culture of the patient with a result of the pathogen against each antimicrobial
+func seedCulture(
+	patientID, pathogenID string, cultureStatus culture_pb.CultureStatus, resultsTime time.Time, antimicrobialIDs ...string,
+) {
+	culturePB := culture.FakeCulture()
+	culturePB.PatientId = patientID
+	culturePB.Status = cultureStatus
+	culturePB.ResultsTimestampSec = resultsTime.Unix()
+	culturePB.PathogensFound = []string{pathogenID}
+	culturePB.AntimicrobialsUsed = antimicrobialIDs
+	culturePB.CultureResults = make([]*culture_pb.LabTestResult, 0, len(antimicrobialIDs))
+	for _, antimicrobialID := range antimicrobialIDs {
+		culturePB.CultureResults = append(culturePB.CultureResults, &culture_pb.LabTestResult{
+			PathogenId:      pathogenID,
+			AntimicrobialId: antimicrobialID,
+			Label:           culture_pb.Label_SUSCEPTIBLE,
+		})
+	}
+	culturePB.IsolateClassifications = []*culture_pb.IsolateClassification{
+		{PathogenId: pathogenID, ResistanceClass: culture_pb.ResistanceClass_NON_MDR},
+	}
+
+	cultureDB, err := culture.GetCultureDB(culturePB)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(AntibiogramServer.sqlDB.Create(cultureDB).Error).ShouldNot(HaveOccurred())
+}
+
+// isolatesOf returns the number of isolates tested against the antimicrobial. An empty antimicrobial counts all isolates.
+func isolatesOf(stats []*pairStat, antimicrobialID string) int64 {
+	var isolates int64
+	for _, stat := range stats {
+		if stat.AntimicrobialID == antimicrobialID {
+			isolates += stat.Isolates
+		}
+	}
+	return isolates
+}
+
+var _ = Describe("Generating CLSI M39 antibiogram #clsi", func() {
+	var (
+		filter *antibiogram.Filter
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		filter = fakeFilter(subjectPathogen)
+		filter.Mode = antibiogram.AntibiogramMode_CLSI_M39
+		ctx = context.Background()
+	})
+
+	Describe("Tracking first isolates", func() {
+		It("should count only the first culture of a pathogen per patient", func() {
+			tracker := make(isolateTracker)
+			Expect(tracker.first("patient", "pathogen", 1)).Should(BeTrue())
+			Expect(tracker.first("patient", "pathogen", 1)).Should(BeTrue())
+			Expect(tracker.first("patient", "pathogen", 2)).Should(BeFalse())
+			Expect(tracker.first("patient", "pathogen2", 2)).Should(BeTrue())
+			Expect(tracker.first("patient2", "pathogen", 2)).Should(BeTrue())
+		})
+		It("should default minimum isolates to 30 in CLSI mode", func() {
+			Expect(minIsolates(filter)).Should(BeEquivalentTo(clsiMinIsolates))
+			filter.MinIsolates = 10
+			Expect(minIsolates(filter)).Should(BeEquivalentTo(10))
+			filter.MinIsolates = 0
+			filter.Mode = antibiogram.AntibiogramMode_STANDARD
+			Expect(minIsolates(filter)).Should(BeZero())
+		})
+		It("should compute percent susceptible", func() {
+			Expect(percentOf(0, 0)).Should(BeZero())
+			Expect(percentOf(3, 4)).Should(BeEquivalentTo(75))
+		})
+	})
+
+	Describe("Counting only final results", func() {
+		var pathogenID string
+
+		BeforeEach(func() {
+			filter = &antibiogram.Filter{Mode: antibiogram.AntibiogramMode_CLSI_M39}
+			pathogenID = "status-" + randomdata.RandStringRunes(10)
+			start := time.Now().Add(-time.Hour)
+			seedCulture(randomdata.RandStringRunes(10), pathogenID, culture_pb.CultureStatus_PRELIMINARY, start, "AMP")
+			seedCulture(randomdata.RandStringRunes(10), pathogenID, culture_pb.CultureStatus_RECEIVED, start, "AMP")
+			seedCulture(randomdata.RandStringRunes(10), pathogenID, culture_pb.CultureStatus_FINAL, start, "AMP")
+			seedCulture(randomdata.RandStringRunes(10), pathogenID, culture_pb.CultureStatus_AMENDED, start, "AMP")
+		})
+
+		It("should skip cultures without final results in pair stats", func() {
+			stats, err := AntibiogramServer.getCulturePairStats(filter, []string{pathogenID}, nil, false)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(isolatesOf(stats, "")).Should(BeEquivalentTo(2))
+			Expect(isolatesOf(stats, "AMP")).Should(BeEquivalentTo(2))
+		})
+		It("should skip cultures without final results in prevalence stats", func() {
+			stats, err := AntibiogramServer.getCulturePrevalenceStats(filter, []string{pathogenID})
+			Expect(err).ShouldNot(HaveOccurred())
+			var isolates int64
+			for _, stat := range stats {
+				isolates += stat.Isolates
+			}
+			Expect(isolates).Should(BeEquivalentTo(2))
+		})
+	})
+
+	Describe("Picking first isolates", func() {
+		It("should pick first isolates whichever antimicrobials were tested", func() {
+			filter = &antibiogram.Filter{Mode: antibiogram.AntibiogramMode_CLSI_M39}
+			pathogenID := "first-" + randomdata.RandStringRunes(10)
+			patientID := randomdata.RandStringRunes(10)
+			start := time.Now().Add(-time.Hour)
+			seedCulture(patientID, pathogenID, culture_pb.CultureStatus_FINAL, start, "GEN")
+			seedCulture(patientID, pathogenID, culture_pb.CultureStatus_FINAL, start.Add(time.Minute), "AMP")
+
+			stats, err := AntibiogramServer.getCulturePairStats(filter, []string{pathogenID}, []string{"AMP"}, false)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(isolatesOf(stats, "")).Should(BeEquivalentTo(1))
+			Expect(isolatesOf(stats, "AMP")).Should(BeZero())
+		})
+	})
+
+	Describe("Getting pathogen antibiogram in CLSI mode", func() {
+		It("should flag pairs with insufficient isolates", func() {
+			pathogenAntibiogram, err := AntibiogramAPI.GenPathogenAntibiogram(ctx, filter)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.OK))
+			Expect(pathogenAntibiogram).ShouldNot(BeNil())
+			for _, susceptibility := range pathogenAntibiogram.Susceptibilities {
+				Expect(susceptibility.IsolatesSusceptible).Should(BeNumerically("<=", susceptibility.Isolates))
+				Expect(susceptibility.InsufficientIsolates).Should(Equal(susceptibility.Isolates < clsiMinIsolates))
+			}
+		})
+		It("should hide pairs with insufficient isolates", func() {
+			filter.HideInsufficient = true
+			pathogenAntibiogram, err := AntibiogramAPI.GenPathogenAntibiogram(ctx, filter)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.OK))
+			Expect(pathogenAntibiogram).ShouldNot(BeNil())
+			for _, susceptibility := range pathogenAntibiogram.Susceptibilities {
+				Expect(susceptibility.Isolates).Should(BeNumerically(">=", clsiMinIsolates))
+			}
+		})
+	})
+})
@@ -0,0 +1,61 @@
+package antibiogram
+
+import (
+	"context"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Getting antibiogram matrix #getmatrix", func() {
+	var (
+		filter *antibiogram.Filter
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		filter = fakeFilter(subjectPathogen)
+		ctx = context.Background()
+	})
+
+	Describe("Getting antibiogram matrix with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			filter = nil
+			antibiogramMatrix, err := AntibiogramAPI.GenAntibiogramMatrix(ctx, filter)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(antibiogramMatrix).Should(BeNil())
+		})
+		It("should fail scope values is missing and region scope is not country", func() {
+			filter.ScopeValues = nil
+			filter.RegionScope = antibiogram.RegionScope_COUNTY
+			antibiogramMatrix, err := AntibiogramAPI.GenAntibiogramMatrix(ctx, filter)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(antibiogramMatrix).Should(BeNil())
+		})
+	})
+
+	Describe("Getting antibiogram matrix with well-formed request", func() {
+		It("should succeed and every row should have a cell for each antimicrobial", func() {
+			antibiogramMatrix, err := AntibiogramAPI.GenAntibiogramMatrix(ctx, filter)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.OK))
+			Expect(antibiogramMatrix).ShouldNot(BeNil())
+			for _, row := range antibiogramMatrix.Rows {
+				Expect(row.Cells).Should(HaveLen(len(antibiogramMatrix.Antimicrobials)))
+				for index, cell := range row.Cells {
+					Expect(cell.AntimicrobialId).Should(Equal(antibiogramMatrix.Antimicrobials[index].Id))
+					Expect(cell.IsolatesSusceptible).Should(BeNumerically("<=", cell.Isolates))
+				}
+			}
+		})
+		It("should succeed when input values are missing", func() {
+			filter.InputValues = nil
+			antibiogramMatrix, err := AntibiogramAPI.GenAntibiogramMatrix(ctx, filter)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.OK))
+			Expect(antibiogramMatrix).ShouldNot(BeNil())
+		})
+	})
+})
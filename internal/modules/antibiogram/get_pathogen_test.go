@@ -3,8 +3,11 @@ package antibiogram
 import (
 	"context"
 	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	culture_pb "github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"time"
 )
 
 var _ = Describe("Getting pathogen antibiogram #getpathogen", func() {
@@ -41,6 +44,66 @@ var _ = Describe("Getting pathogen antibiogram #getpathogen", func() {
 			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
 			Expect(pathogenAntibiogram).Should(BeNil())
 		})
+		It("should fail when calendar year is in the future", func() {
+			filter.CalendarYear = int32(time.Now().Year() + 1)
+			pathogenAntibiogram, err := AntibiogramAPI.GenPathogenAntibiogram(ctx, filter)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(pathogenAntibiogram).Should(BeNil())
+		})
+	})
+
+	Describe("Getting antibiogram for a period", func() {
+		It("should succeed for a past calendar year", func() {
+			filter.CalendarYear = int32(time.Now().Year() - 1)
+			pathogenAntibiogram, err := AntibiogramAPI.GenPathogenAntibiogram(ctx, filter)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.OK))
+			Expect(pathogenAntibiogram).ShouldNot(BeNil())
+		})
+		It("should succeed for an explicit date range", func() {
+			filter.DateFilter = &culture_pb.DateFilter{
+				StartTimestampSec: time.Now().AddDate(-2, 0, 0).Unix(),
+				EndTimestampSec:   time.Now().AddDate(-1, 0, 0).Unix(),
+				Filter:            true,
+			}
+			pathogenAntibiogram, err := AntibiogramAPI.GenPathogenAntibiogram(ctx, filter)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.OK))
+			Expect(pathogenAntibiogram).ShouldNot(BeNil())
+		})
+		It("should not share cache entries between periods", func() {
+			otherFilter := proto.Clone(filter).(*antibiogram.Filter)
+			otherFilter.CalendarYear = int32(time.Now().Year() - 1)
+			Expect(genFilterHash(otherFilter)).ShouldNot(Equal(genFilterHash(filter)))
+		})
+	})
+
+	Describe("Getting antibiogram of wards and specimens", func() {
+		BeforeEach(func() {
+			filter.Advance.Departments = []culture_pb.Department{
+				culture_pb.Department_INTENSIVE_CARE, culture_pb.Department_NEONATAL,
+			}
+			filter.Advance.PatientSettings = []culture_pb.PatientSetting{culture_pb.PatientSetting_INPATIENT}
+			filter.Advance.SpecimenTypes = []culture_pb.SpecimenType{culture_pb.SpecimenType_BLOOD}
+		})
+		It("should succeed for inpatient blood cultures of intensive care", func() {
+			pathogenAntibiogram, err := AntibiogramAPI.GenPathogenAntibiogram(ctx, filter)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(pathogenAntibiogram).ShouldNot(BeNil())
+		})
+		It("should not share cache entries between departments", func() {
+			otherFilter := proto.Clone(filter).(*antibiogram.Filter)
+			otherFilter.Advance.Departments = []culture_pb.Department{culture_pb.Department_SURGICAL}
+			Expect(genFilterHash(otherFilter)).ShouldNot(Equal(genFilterHash(filter)))
+		})
+		It("should share cache entries between orders of the same departments", func() {
+			otherFilter := proto.Clone(filter).(*antibiogram.Filter)
+			otherFilter.Advance.Departments = []culture_pb.Department{
+				culture_pb.Department_NEONATAL, culture_pb.Department_INTENSIVE_CARE,
+			}
+			Expect(genFilterHash(otherFilter)).Should(Equal(genFilterHash(filter)))
+		})
 	})
 
 	Describe("Getting antibiogram with well-formed request", func() {
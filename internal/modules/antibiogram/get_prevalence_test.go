@@ -0,0 +1,62 @@
+package antibiogram
+
+import (
+	"context"
+	"github.com/gidyon/antibug/internal/modules/culture"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Getting resistance prevalence #getprevalence", func() {
+	var (
+		prevalenceReq *antibiogram.ResistancePrevalenceRequest
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		prevalenceReq = &antibiogram.ResistancePrevalenceRequest{
+			Filter:      fakeFilter(subjectPathogen),
+			PathogenIds: []string{culture.Pathogen()},
+			Interval:    antibiogram.TrendInterval_MONTHLY,
+		}
+		ctx = context.Background()
+	})
+
+	Describe("Getting resistance prevalence with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			prevalenceReq = nil
+			resistancePrevalence, err := AntibiogramAPI.GenResistancePrevalence(ctx, prevalenceReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(resistancePrevalence).Should(BeNil())
+		})
+		It("should fail when filter is nil", func() {
+			prevalenceReq.Filter = nil
+			resistancePrevalence, err := AntibiogramAPI.GenResistancePrevalence(ctx, prevalenceReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(resistancePrevalence).Should(BeNil())
+		})
+	})
+
+	Describe("Getting resistance prevalence with well-formed request", func() {
+		It("should succeed and classified isolates should not exceed isolates", func() {
+			resistancePrevalence, err := AntibiogramAPI.GenResistancePrevalence(ctx, prevalenceReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.OK))
+			Expect(resistancePrevalence).ShouldNot(BeNil())
+			for _, bucket := range resistancePrevalence.Buckets {
+				Expect(bucket.IsolatesClassified).Should(BeNumerically("<=", bucket.Isolates))
+				Expect(bucket.IsolatesMdr).Should(BeNumerically(">=", bucket.IsolatesXdr))
+				Expect(bucket.IsolatesXdr).Should(BeNumerically(">=", bucket.IsolatesPdr))
+			}
+		})
+		It("should succeed when counting first isolates only", func() {
+			prevalenceReq.Filter.Mode = antibiogram.AntibiogramMode_CLSI_M39
+			resistancePrevalence, err := AntibiogramAPI.GenResistancePrevalence(ctx, prevalenceReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resistancePrevalence).ShouldNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,116 @@
+package antibiogram
+
+import (
+	"context"
+	"github.com/gidyon/antibug/internal/modules/culture"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var _ = Describe("Getting resistance trend #gettrend", func() {
+	var (
+		trendReq *antibiogram.ResistanceTrendRequest
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		trendReq = &antibiogram.ResistanceTrendRequest{
+			Filter:          fakeFilter(subjectPathogen),
+			PathogenId:      culture.Pathogen(),
+			AntimicrobialId: culture.Antimicrobial(),
+			Interval:        antibiogram.TrendInterval_QUARTERLY,
+		}
+		ctx = context.Background()
+	})
+
+	Describe("Bucketing and testing for trend", func() {
+		It("should bucket timestamps into calendar periods", func() {
+			timestamp := time.Date(2019, time.May, 17, 10, 0, 0, 0, time.UTC).Unix()
+
+			start := bucketStart(timestamp, antibiogram.TrendInterval_MONTHLY)
+			Expect(bucketPeriod(start, antibiogram.TrendInterval_MONTHLY)).Should(Equal("2019-05"))
+
+			start = bucketStart(timestamp, antibiogram.TrendInterval_QUARTERLY)
+			Expect(bucketPeriod(start, antibiogram.TrendInterval_QUARTERLY)).Should(Equal("2019-Q2"))
+			Expect(nextBucket(start, antibiogram.TrendInterval_QUARTERLY).Month()).Should(Equal(time.July))
+
+			start = bucketStart(timestamp, antibiogram.TrendInterval_YEARLY)
+			Expect(bucketPeriod(start, antibiogram.TrendInterval_YEARLY)).Should(Equal("2019"))
+		})
+		It("should detect a significant decline in susceptibility", func() {
+			buckets := []*antibiogram.TrendBucket{
+				{Isolates: 100, IsolatesSusceptible: 90},
+				{Isolates: 100, IsolatesSusceptible: 75},
+				{Isolates: 100, IsolatesSusceptible: 60},
+			}
+			chiSquare, pValue, slope := chiSquareForTrend(buckets)
+			Expect(chiSquare).Should(BeNumerically(">", 3.84))
+			Expect(pValue).Should(BeNumerically("<", defaultSignificanceLevel))
+			Expect(slope).Should(BeNumerically("<", 0))
+		})
+		It("should not detect a trend when susceptibility is stable", func() {
+			buckets := []*antibiogram.TrendBucket{
+				{Isolates: 100, IsolatesSusceptible: 80},
+				{Isolates: 0},
+				{Isolates: 100, IsolatesSusceptible: 80},
+			}
+			chiSquare, pValue, _ := chiSquareForTrend(buckets)
+			Expect(chiSquare).Should(BeZero())
+			Expect(pValue).Should(BeEquivalentTo(1))
+		})
+	})
+
+	Describe("Getting resistance trend with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			trendReq = nil
+			resistanceTrend, err := AntibiogramAPI.GenResistanceTrend(ctx, trendReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(resistanceTrend).Should(BeNil())
+		})
+		It("should fail when filter is nil", func() {
+			trendReq.Filter = nil
+			resistanceTrend, err := AntibiogramAPI.GenResistanceTrend(ctx, trendReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(resistanceTrend).Should(BeNil())
+		})
+		It("should fail when pathogen id is missing", func() {
+			trendReq.PathogenId = ""
+			resistanceTrend, err := AntibiogramAPI.GenResistanceTrend(ctx, trendReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(resistanceTrend).Should(BeNil())
+		})
+		It("should fail when antimicrobial id is missing", func() {
+			trendReq.AntimicrobialId = ""
+			resistanceTrend, err := AntibiogramAPI.GenResistanceTrend(ctx, trendReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(resistanceTrend).Should(BeNil())
+		})
+		It("should fail when significance level is out of range", func() {
+			trendReq.SignificanceLevel = 2
+			resistanceTrend, err := AntibiogramAPI.GenResistanceTrend(ctx, trendReq)
+			Expect(err).Should(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(resistanceTrend).Should(BeNil())
+		})
+	})
+
+	Describe("Getting resistance trend with well-formed request", func() {
+		It("should succeed and buckets should be contiguous", func() {
+			resistanceTrend, err := AntibiogramAPI.GenResistanceTrend(ctx, trendReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(status.Code(err)).Should(Equal(codes.OK))
+			Expect(resistanceTrend).ShouldNot(BeNil())
+			for index := 1; index < len(resistanceTrend.Buckets); index++ {
+				Expect(resistanceTrend.Buckets[index].StartTimestampSec).Should(
+					Equal(resistanceTrend.Buckets[index-1].EndTimestampSec),
+				)
+			}
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package antibiogram
+
+import (
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	culture_pb "github.com/gidyon/antibug/pkg/api/culture"
+)
+
+// susceptibilityLabels are the reported labels ordered from most to least susceptible
+var susceptibilityLabels = []culture_pb.Label{
+	culture_pb.Label_SUSCEPTIBLE,
+	culture_pb.Label_DOSE_SUSCEPTIBLE,
+	culture_pb.Label_INTERMEDIATE,
+	culture_pb.Label_RESISTANT,
+}
+
+// labelCounter counts isolates per label for each pathogen-antimicrobial pair in an antibiogram
+type labelCounter map[string]map[culture_pb.Label]int32
+
+func (counter labelCounter) add(key string, label culture_pb.Label, isolates int32) {
+	if counter[key] == nil {
+		counter[key] = make(map[culture_pb.Label]int32, len(susceptibilityLabels))
+	}
+	counter[key][label] += isolates
+}
+
+// stats returns the count and percentage of isolates for every label of the pair
+func (counter labelCounter) stats(key string, isolates int32) []*antibiogram.LabelStat {
+	labelStats := make([]*antibiogram.LabelStat, 0, len(susceptibilityLabels))
+	for _, label := range susceptibilityLabels {
+		labelStats = append(labelStats, &antibiogram.LabelStat{
+			Label:    label,
+			Isolates: counter[key][label],
+			Percent:  percentOf(counter[key][label], isolates),
+		})
+	}
+	return labelStats
+}
+
+// max returns the most frequent label of the pair. Ties go to the less susceptible label.
+func (counter labelCounter) max(key string) culture_pb.Label {
+	var (
+		label culture_pb.Label
+		score int32
+	)
+	for _, candidate := range susceptibilityLabels {
+		if value := counter[key][candidate]; value > 0 && value >= score {
+			label = candidate
+			score = value
+		}
+	}
+	return label
+}
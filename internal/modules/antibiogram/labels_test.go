@@ -0,0 +1,45 @@
+package antibiogram
+
+import (
+	culture_pb "github.com/gidyon/antibug/pkg/api/culture"
+)
+
+var _ = Describe("Counting susceptibility labels #labels", func() {
+	var counter labelCounter
+
+	BeforeEach(func() {
+		counter = make(labelCounter)
+	})
+
+	It("should count labels separately for each pair", func() {
+		counter.add("amoxicillin", culture_pb.Label_SUSCEPTIBLE, 1)
+		counter.add("amoxicillin", culture_pb.Label_SUSCEPTIBLE, 2)
+		counter.add("amoxicillin", culture_pb.Label_RESISTANT, 1)
+		counter.add("ceftriaxone", culture_pb.Label_RESISTANT, 1)
+
+		labelStats := counter.stats("amoxicillin", 4)
+		Expect(labelStats).Should(HaveLen(len(susceptibilityLabels)))
+		for _, labelStat := range labelStats {
+			switch labelStat.Label {
+			case culture_pb.Label_SUSCEPTIBLE:
+				Expect(labelStat.Isolates).Should(BeEquivalentTo(3))
+				Expect(labelStat.Percent).Should(BeEquivalentTo(75))
+			case culture_pb.Label_RESISTANT:
+				Expect(labelStat.Isolates).Should(BeEquivalentTo(1))
+				Expect(labelStat.Percent).Should(BeEquivalentTo(25))
+			default:
+				Expect(labelStat.Isolates).Should(BeZero())
+				Expect(labelStat.Percent).Should(BeZero())
+			}
+		}
+
+		Expect(counter.max("amoxicillin")).Should(Equal(culture_pb.Label_SUSCEPTIBLE))
+		Expect(counter.max("ceftriaxone")).Should(Equal(culture_pb.Label_RESISTANT))
+	})
+
+	It("should break ties towards the less susceptible label", func() {
+		counter.add("amoxicillin", culture_pb.Label_SUSCEPTIBLE, 1)
+		counter.add("amoxicillin", culture_pb.Label_INTERMEDIATE, 1)
+		Expect(counter.max("amoxicillin")).Should(Equal(culture_pb.Label_INTERMEDIATE))
+	})
+})
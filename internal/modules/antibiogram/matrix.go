@@ -0,0 +1,199 @@
+package antibiogram
+
+import (
+	"context"
+	"errors"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	culture_pb "github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"sort"
+)
+
+func (api *apiServer) getAntibiogramMatrixFromCache(
+	ctx context.Context, filter *antibiogram.Filter,
+) (*antibiogram.AntibiogramMatrix, error) {
+	// Cache key of the matrix is keyed by the pathogens in the rows
+	pathogenIDs := make([]string, 0, len(filter.GetInputValues()))
+	for _, pathogenPB := range filter.GetInputValues() {
+		pathogenIDs = append(pathogenIDs, pathogenPB.GetId())
+	}
+	sort.Strings(pathogenIDs)
+	key := cacheKey(viewMatrix, filter, pathogenIDs...)
+
+	// A matrix of all pathogens is affected by changes to any pathogen
+	itemTags := make([]string, 0, len(pathogenIDs))
+	for _, pathogenID := range pathogenIDs {
+		itemTags = append(itemTags, pathogenTag(pathogenID))
+	}
+	if len(itemTags) == 0 {
+		itemTags = append(itemTags, allPathogens)
+	}
+	entry := newCacheEntry(key, filter, itemTags...)
+
+	// Check cache if it exists
+	data, err := api.getCache(ctx, viewMatrix, entry, filter.GetMaxStalenessSec())
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return api.getAntibiogramMatrix(ctx, entry, filter)
+	default:
+		return nil, errs.RedisCmdFailed(err, "GET")
+	}
+
+	// Unmarshal data
+	antibiogramMatrix := &antibiogram.AntibiogramMatrix{}
+	err = proto.Unmarshal([]byte(data), antibiogramMatrix)
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.Internal, err, "failed to proto unmarshal")
+	}
+
+	return antibiogramMatrix, nil
+}
+
+func (api *apiServer) getAntibiogramMatrix(
+	ctx context.Context, entry *cacheEntry, filter *antibiogram.Filter,
+) (*antibiogram.AntibiogramMatrix, error) {
+
+	// Input values restrict the pathogens in the rows
+	pathogenNames := make(map[string]string, len(filter.GetInputValues()))
+	pathogenIDs := make([]string, 0, len(filter.GetInputValues()))
+	for _, pathogenPB := range filter.GetInputValues() {
+		pathogenNames[pathogenPB.GetId()] = pathogenPB.GetName()
+		pathogenIDs = append(pathogenIDs, pathogenPB.GetId())
+	}
+
+	stats, err := api.getPairStats(filter, pathogenIDs, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rows               = make(map[string]*antibiogram.AntibiogramRow)
+		cells              = make(map[string]map[string]*antibiogram.AntibiogramCell)
+		antimicrobialNames = make(map[string]string)
+	)
+
+	// Range over stats and populate the table
+	for _, stat := range stats {
+		pathogenID := stat.PathogenID
+
+		row, ok := rows[pathogenID]
+		if !ok {
+			name := pathogenNames[pathogenID]
+			if name == "" {
+				name = stat.PathogenName
+			}
+			row = &antibiogram.AntibiogramRow{
+				PathogenName: name,
+				PathogenId:   pathogenID,
+			}
+			rows[pathogenID] = row
+			cells[pathogenID] = make(map[string]*antibiogram.AntibiogramCell)
+		}
+
+		// A culture is one isolate of the pathogen regardless of antimicrobials tested
+		if stat.isolate() {
+			row.Isolates += int32(stat.Isolates)
+			continue
+		}
+
+		antimicrobialID := stat.AntimicrobialID
+		antimicrobialNames[antimicrobialID] = stat.AntimicrobialName
+
+		cell, ok := cells[pathogenID][antimicrobialID]
+		if !ok {
+			cell = &antibiogram.AntibiogramCell{
+				AntimicrobialId: antimicrobialID,
+			}
+			cells[pathogenID][antimicrobialID] = cell
+		}
+
+		cell.Isolates += int32(stat.Isolates)
+		if stat.label() == culture_pb.Label_SUSCEPTIBLE {
+			cell.IsolatesSusceptible += int32(stat.Isolates)
+		}
+	}
+
+	antibiogramMatrix := &antibiogram.AntibiogramMatrix{
+		Antimicrobials: make([]*antibiogram.Value, 0, len(antimicrobialNames)),
+		Rows:           make([]*antibiogram.AntibiogramRow, 0, len(rows)),
+	}
+
+	// Columns are ordered by antimicrobial name
+	for antimicrobialID, antimicrobialName := range antimicrobialNames {
+		antibiogramMatrix.Antimicrobials = append(antibiogramMatrix.Antimicrobials, &antibiogram.Value{
+			Name: antimicrobialName,
+			Id:   antimicrobialID,
+		})
+	}
+	sort.Slice(antibiogramMatrix.Antimicrobials, func(i, j int) bool {
+		return antibiogramMatrix.Antimicrobials[i].Name < antibiogramMatrix.Antimicrobials[j].Name
+	})
+
+	// Rows are ordered by pathogen name and each row has a cell for every column
+	for pathogenID, row := range rows {
+		row.Cells = make([]*antibiogram.AntibiogramCell, 0, len(antibiogramMatrix.Antimicrobials))
+		for _, antimicrobialPB := range antibiogramMatrix.Antimicrobials {
+			cell, ok := cells[pathogenID][antimicrobialPB.Id]
+			if !ok {
+				cell = &antibiogram.AntibiogramCell{
+					AntimicrobialId: antimicrobialPB.Id,
+				}
+			}
+			cell.PercentSusceptible = percentOf(cell.IsolatesSusceptible, cell.Isolates)
+			if cell.Isolates > 0 && cell.Isolates < minIsolates(filter) {
+				cell.InsufficientIsolates = true
+				if filter.GetHideInsufficient() {
+					cell.IsolatesSusceptible = 0
+					cell.PercentSusceptible = 0
+				}
+			}
+			row.Cells = append(row.Cells, cell)
+		}
+		antibiogramMatrix.Rows = append(antibiogramMatrix.Rows, row)
+	}
+	sort.Slice(antibiogramMatrix.Rows, func(i, j int) bool {
+		return antibiogramMatrix.Rows[i].PathogenName < antibiogramMatrix.Rows[j].PathogenName
+	})
+
+	// Marshal data
+	bs, err := proto.Marshal(antibiogramMatrix)
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.Internal, err, "failed to proto marshal")
+	}
+
+	// Save to cache
+	err = api.setCache(ctx, entry, bs)
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.Internal, err, "fail to save antibiogram from cache")
+	}
+
+	return antibiogramMatrix, nil
+}
+
+func (api *apiServer) GenAntibiogramMatrix(
+	ctx context.Context, filter *antibiogram.Filter,
+) (*antibiogram.AntibiogramMatrix, error) {
+	// Request must not be nil
+	if filter == nil {
+		return nil, errs.NilObject("Filter")
+	}
+
+	// Authentication
+	err := api.authAPI.AuthenticateRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation; input values are optional since the matrix defaults to all pathogens
+	err = validateFilterScope(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get antibiogram from filter
+	return api.getAntibiogramMatrixFromCache(ctx, filter)
+}
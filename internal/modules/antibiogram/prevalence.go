@@ -0,0 +1,262 @@
+package antibiogram
+
+import (
+	"context"
+	"errors"
+	"github.com/gidyon/antibug/internal/modules/culture"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	culture_pb "github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"sort"
+)
+
+// prevalenceStat is the number of isolates of a resistance class in a facility on a day
+type prevalenceStat struct {
+	HospitalID string
+	Day        int64
+	Label      string
+	Isolates   int64
+}
+
+func (api *apiServer) getPrevalenceStats(filter *antibiogram.Filter, pathogenIDs []string) ([]*prevalenceStat, error) {
+	// Rollups cannot tell isolates of the same patient apart
+	if firstIsolateOnly(filter) {
+		return api.getCulturePrevalenceStats(filter, pathogenIDs)
+	}
+
+	// Isolate rollups are labeled by resistance class
+	sqlDB := buildQuery(api.sqlDB.Table(culture.RollupsTable), filter, rollupColumns).Where("antimicrobial_id=''")
+	if len(pathogenIDs) > 0 {
+		sqlDB = sqlDB.Where("pathogen_id IN(?)", pathogenIDs)
+	}
+
+	stats := make([]*prevalenceStat, 0, 100)
+	err := sqlDB.Select("hospital_id, day, label, SUM(isolates) AS isolates").
+		Group("hospital_id, day, label").Having("SUM(isolates)>0").Scan(&stats).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	return stats, nil
+}
+
+// getCulturePrevalenceStats aggregates stats from classifications of cultures
+func (api *apiServer) getCulturePrevalenceStats(
+	filter *antibiogram.Filter, pathogenIDs []string,
+) ([]*prevalenceStat, error) {
+	culturesDB := make([]*culture.Culture, 0, 500)
+
+	sqlDB := whereMemberOf(buildQuery(api.sqlDB, filter, cultureColumns), "pathogens_found", pathogenIDs)
+	err := sqlDB.Order("results_timestamp_sec, id").Find(&culturesDB).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	var (
+		stats         = make([]*prevalenceStat, 0, 100)
+		statsMap      = make(map[prevalenceStat]*prevalenceStat, 100)
+		pathogens     = toSet(pathogenIDs)
+		firstIsolates = make(isolateTracker)
+	)
+
+	// Range over classifications and aggregate stats
+	for _, cultureDB := range culturesDB {
+		culturePB, err := culture.GetCulturePB(cultureDB)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, classification := range culturePB.GetIsolateClassifications() {
+			pathogenID := classification.GetPathogenId()
+			if len(pathogens) > 0 && !pathogens[pathogenID] {
+				continue
+			}
+
+			// CLSI M39 counts only the first isolate per patient per pathogen
+			if firstIsolateOnly(filter) && !firstIsolates.first(cultureDB.PatientID, pathogenID, cultureDB.ID) {
+				continue
+			}
+
+			key := prevalenceStat{
+				HospitalID: cultureDB.HospitalID,
+				Day:        culture.RollupDay(cultureDB.ResultsTimestampSec),
+				Label:      classification.GetResistanceClass().String(),
+			}
+			stat, ok := statsMap[key]
+			if !ok {
+				stat = &prevalenceStat{HospitalID: key.HospitalID, Day: key.Day, Label: key.Label}
+				statsMap[key] = stat
+				stats = append(stats, stat)
+			}
+			stat.Isolates++
+		}
+	}
+
+	return stats, nil
+}
+
+func prevalenceCacheKey(prevalenceReq *antibiogram.ResistancePrevalenceRequest) string {
+	pathogenIDs := append([]string{}, prevalenceReq.GetPathogenIds()...)
+	sort.Strings(pathogenIDs)
+	return cacheKey(
+		viewPrevalence,
+		prevalenceReq.GetFilter(),
+		append([]string{prevalenceReq.GetInterval().String()}, pathogenIDs...)...,
+	)
+}
+
+func (api *apiServer) getResistancePrevalenceFromCache(
+	ctx context.Context, prevalenceReq *antibiogram.ResistancePrevalenceRequest,
+) (*antibiogram.ResistancePrevalence, error) {
+	// Cache key of the prevalence
+	key := prevalenceCacheKey(prevalenceReq)
+
+	itemTags := make([]string, 0, len(prevalenceReq.GetPathogenIds()))
+	for _, pathogenID := range prevalenceReq.GetPathogenIds() {
+		itemTags = append(itemTags, pathogenTag(pathogenID))
+	}
+	if len(itemTags) == 0 {
+		itemTags = append(itemTags, allPathogens)
+	}
+	entry := newCacheEntry(key, prevalenceReq.GetFilter(), itemTags...)
+
+	// Check cache if it exists
+	data, err := api.getCache(ctx, viewPrevalence, entry, prevalenceReq.GetFilter().GetMaxStalenessSec())
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return api.getResistancePrevalence(ctx, entry, prevalenceReq)
+	default:
+		return nil, errs.RedisCmdFailed(err, "GET")
+	}
+
+	// Unmarshal data
+	resistancePrevalence := &antibiogram.ResistancePrevalence{}
+	err = proto.Unmarshal([]byte(data), resistancePrevalence)
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.Internal, err, "failed to proto unmarshal")
+	}
+
+	return resistancePrevalence, nil
+}
+
+func (api *apiServer) getResistancePrevalence(
+	ctx context.Context, entry *cacheEntry, prevalenceReq *antibiogram.ResistancePrevalenceRequest,
+) (*antibiogram.ResistancePrevalence, error) {
+
+	filter := prevalenceReq.GetFilter()
+	interval := prevalenceReq.GetInterval()
+
+	stats, err := api.getPrevalenceStats(filter, prevalenceReq.GetPathogenIds())
+	if err != nil {
+		return nil, err
+	}
+
+	resistancePrevalence := &antibiogram.ResistancePrevalence{
+		Interval: interval,
+		Buckets:  make([]*antibiogram.PrevalenceBucket, 0),
+	}
+
+	type bucketKey struct {
+		hospitalID string
+		start      int64
+	}
+
+	buckets := make(map[bucketKey]*antibiogram.PrevalenceBucket)
+
+	// Range over daily stats and populate facility buckets
+	for _, stat := range stats {
+		start := bucketStart(stat.Day, interval)
+		bucket, ok := buckets[bucketKey{stat.HospitalID, start.Unix()}]
+		if !ok {
+			bucket = &antibiogram.PrevalenceBucket{
+				HospitalId:        stat.HospitalID,
+				Period:            bucketPeriod(start, interval),
+				StartTimestampSec: start.Unix(),
+				EndTimestampSec:   nextBucket(start, interval).Unix(),
+			}
+			buckets[bucketKey{stat.HospitalID, start.Unix()}] = bucket
+			resistancePrevalence.Buckets = append(resistancePrevalence.Buckets, bucket)
+		}
+
+		isolates := int32(stat.Isolates)
+		bucket.Isolates += isolates
+
+		// Each class includes isolates of the more resistant classes
+		switch culture_pb.ResistanceClass(culture_pb.ResistanceClass_value[stat.Label]) {
+		case culture_pb.ResistanceClass_PDR:
+			bucket.IsolatesPdr += isolates
+			fallthrough
+		case culture_pb.ResistanceClass_XDR:
+			bucket.IsolatesXdr += isolates
+			fallthrough
+		case culture_pb.ResistanceClass_MDR:
+			bucket.IsolatesMdr += isolates
+			fallthrough
+		case culture_pb.ResistanceClass_NON_MDR:
+			bucket.IsolatesClassified += isolates
+		}
+	}
+
+	for _, bucket := range resistancePrevalence.Buckets {
+		bucket.PercentMdr = percentOf(bucket.IsolatesMdr, bucket.IsolatesClassified)
+		bucket.PercentXdr = percentOf(bucket.IsolatesXdr, bucket.IsolatesClassified)
+		bucket.PercentPdr = percentOf(bucket.IsolatesPdr, bucket.IsolatesClassified)
+	}
+
+	// Buckets are ordered by facility then period
+	sort.Slice(resistancePrevalence.Buckets, func(i, j int) bool {
+		bi, bj := resistancePrevalence.Buckets[i], resistancePrevalence.Buckets[j]
+		if bi.HospitalId != bj.HospitalId {
+			return bi.HospitalId < bj.HospitalId
+		}
+		return bi.StartTimestampSec < bj.StartTimestampSec
+	})
+
+	// Marshal data
+	bs, err := proto.Marshal(resistancePrevalence)
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.Internal, err, "failed to proto marshal")
+	}
+
+	// Save to cache
+	err = api.setCache(ctx, entry, bs)
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.Internal, err, "fail to save antibiogram from cache")
+	}
+
+	return resistancePrevalence, nil
+}
+
+func (api *apiServer) GenResistancePrevalence(
+	ctx context.Context, prevalenceReq *antibiogram.ResistancePrevalenceRequest,
+) (*antibiogram.ResistancePrevalence, error) {
+	// Request must not be nil
+	if prevalenceReq == nil {
+		return nil, errs.NilObject("ResistancePrevalenceRequest")
+	}
+
+	// Authentication
+	err := api.authAPI.AuthenticateRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	filter := prevalenceReq.GetFilter()
+	switch {
+	case filter == nil:
+		err = errs.NilObject("Filter")
+	default:
+		err = validateFilterScope(filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Get prevalence from filter
+	return api.getResistancePrevalenceFromCache(ctx, prevalenceReq)
+}
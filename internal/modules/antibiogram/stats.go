@@ -0,0 +1,179 @@
+package antibiogram
+
+import (
+	"github.com/gidyon/antibug/internal/modules/culture"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	culture_pb "github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/jinzhu/gorm"
+	"strings"
+)
+
+// pairStat is the number of results of a pathogen against an antimicrobial with a label.
+// Stats with an empty antimicrobial count isolates of the pathogen.
+type pairStat struct {
+	Day               int64
+	PathogenID        string
+	PathogenName      string
+	AntimicrobialID   string
+	AntimicrobialName string
+	Label             string
+	Isolates          int64
+	ScoreSum          float64
+}
+
+func (stat *pairStat) label() culture_pb.Label {
+	return culture_pb.Label(culture_pb.Label_value[stat.Label])
+}
+
+func (stat *pairStat) isolate() bool {
+	return stat.AntimicrobialID == ""
+}
+
+// getPairStats returns stats of results matching the filter for the pathogens and antimicrobials.
+// Empty pathogens or antimicrobials match all. Stats are per day when byDay is true.
+func (api *apiServer) getPairStats(
+	filter *antibiogram.Filter, pathogenIDs, antimicrobialIDs []string, byDay bool,
+) ([]*pairStat, error) {
+	// Rollups cannot tell isolates of the same patient apart
+	if firstIsolateOnly(filter) {
+		return api.getCulturePairStats(filter, pathogenIDs, antimicrobialIDs, byDay)
+	}
+
+	sqlDB := buildQuery(api.sqlDB.Table(culture.RollupsTable), filter, rollupColumns)
+	if len(pathogenIDs) > 0 {
+		sqlDB = sqlDB.Where("pathogen_id IN(?)", pathogenIDs)
+	}
+	if len(antimicrobialIDs) > 0 {
+		sqlDB = sqlDB.Where("antimicrobial_id IN(?) OR antimicrobial_id=''", antimicrobialIDs)
+	}
+
+	day, groupBy := "0 AS day", "pathogen_id, antimicrobial_id, label"
+	if byDay {
+		day, groupBy = "day", "day, "+groupBy
+	}
+
+	stats := make([]*pairStat, 0, 100)
+	err := sqlDB.Select(day + `, pathogen_id, MAX(pathogen_name) AS pathogen_name, antimicrobial_id,
+		MAX(antimicrobial_name) AS antimicrobial_name, label, SUM(isolates) AS isolates, SUM(score_sum) AS score_sum`).
+		Group(groupBy).Having("SUM(isolates)>0").Scan(&stats).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	return stats, nil
+}
+
+// getCulturePairStats aggregates stats from results of cultures. First isolates are picked among cultures
+// of the pathogens whichever antimicrobials were tested.
+func (api *apiServer) getCulturePairStats(
+	filter *antibiogram.Filter, pathogenIDs, antimicrobialIDs []string, byDay bool,
+) ([]*pairStat, error) {
+	culturesDB := make([]*culture.Culture, 0, 500)
+
+	sqlDB := buildQuery(api.sqlDB, filter, cultureColumns)
+	sqlDB = whereMemberOf(sqlDB, "pathogens_found", pathogenIDs)
+	err := sqlDB.Order("results_timestamp_sec, id").Find(&culturesDB).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	var (
+		stats          = make([]*pairStat, 0, 100)
+		statsMap       = make(map[pairStat]*pairStat, 100)
+		pathogens      = toSet(pathogenIDs)
+		antimicrobials = toSet(antimicrobialIDs)
+		firstIsolates  = make(isolateTracker)
+	)
+
+	add := func(key pairStat, pathogenName, antimicrobialName string, score float32) {
+		stat, ok := statsMap[key]
+		if !ok {
+			stat = &pairStat{
+				Day:               key.Day,
+				PathogenID:        key.PathogenID,
+				PathogenName:      pathogenName,
+				AntimicrobialID:   key.AntimicrobialID,
+				AntimicrobialName: antimicrobialName,
+				Label:             key.Label,
+			}
+			statsMap[key] = stat
+			stats = append(stats, stat)
+		}
+		stat.Isolates++
+		stat.ScoreSum += float64(score)
+	}
+
+	// Range over results and aggregate stats
+	for _, cultureDB := range culturesDB {
+		culturePB, err := culture.GetCulturePB(cultureDB)
+		if err != nil {
+			return nil, err
+		}
+
+		var day int64
+		if byDay {
+			day = culture.RollupDay(cultureDB.ResultsTimestampSec)
+		}
+
+		isolates := make(map[string]bool)
+
+		for _, cultureResult := range culturePB.GetCultureResults() {
+			pathogenID := cultureResult.GetPathogenId()
+			antimicrobialID := cultureResult.GetAntimicrobialId()
+			if len(pathogens) > 0 && !pathogens[pathogenID] {
+				continue
+			}
+
+			// CLSI M39 counts only the first isolate per patient per pathogen
+			if firstIsolateOnly(filter) && !firstIsolates.first(cultureDB.PatientID, pathogenID, cultureDB.ID) {
+				continue
+			}
+
+			// A culture is one isolate of each pathogen with results
+			if !isolates[pathogenID] {
+				isolates[pathogenID] = true
+				add(pairStat{Day: day, PathogenID: pathogenID}, cultureResult.GetPathogenName(), "", 0)
+			}
+
+			if len(antimicrobials) > 0 && !antimicrobials[antimicrobialID] {
+				continue
+			}
+
+			add(
+				pairStat{
+					Day:             day,
+					PathogenID:      pathogenID,
+					AntimicrobialID: antimicrobialID,
+					Label:           cultureResult.GetLabel().String(),
+				},
+				cultureResult.GetPathogenName(),
+				cultureResult.GetAntimicrobialName(),
+				cultureResult.GetSusceptibilityScore(),
+			)
+		}
+	}
+
+	return stats, nil
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+// whereMemberOf restricts cultures to those with any of the values in the JSON array column
+func whereMemberOf(sqlDB *gorm.DB, column string, values []string) *gorm.DB {
+	if len(values) == 0 {
+		return sqlDB
+	}
+	args := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		args = append(args, value)
+	}
+	query := strings.TrimSuffix(strings.Repeat("? MEMBER OF("+column+") OR ", len(values)), " OR ")
+	return sqlDB.Where(query, args...)
+}
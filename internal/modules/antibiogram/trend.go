@@ -0,0 +1,260 @@
+package antibiogram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	antibiogram "github.com/gidyon/antibug/pkg/api/antibiogram"
+	culture_pb "github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"math"
+	"time"
+)
+
+const defaultSignificanceLevel = 0.05
+
+// bucketStart returns the start of the calendar bucket containing the timestamp
+func bucketStart(timestamp int64, interval antibiogram.TrendInterval) time.Time {
+	t := time.Unix(timestamp, 0).UTC()
+	switch interval {
+	case antibiogram.TrendInterval_QUARTERLY:
+		return time.Date(t.Year(), (t.Month()-1)/3*3+1, 1, 0, 0, 0, 0, time.UTC)
+	case antibiogram.TrendInterval_YEARLY:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// nextBucket returns the start of the bucket following the bucket starting at start
+func nextBucket(start time.Time, interval antibiogram.TrendInterval) time.Time {
+	switch interval {
+	case antibiogram.TrendInterval_QUARTERLY:
+		return start.AddDate(0, 3, 0)
+	case antibiogram.TrendInterval_YEARLY:
+		return start.AddDate(1, 0, 0)
+	}
+	return start.AddDate(0, 1, 0)
+}
+
+func bucketPeriod(start time.Time, interval antibiogram.TrendInterval) string {
+	switch interval {
+	case antibiogram.TrendInterval_QUARTERLY:
+		return fmt.Sprintf("%d-Q%d", start.Year(), (start.Month()-1)/3+1)
+	case antibiogram.TrendInterval_YEARLY:
+		return start.Format("2006")
+	}
+	return start.Format("2006-01")
+}
+
+// chiSquareForTrend performs the Cochran-Armitage chi-square test for trend in the proportion
+// of susceptible isolates, scoring buckets by their position. The returned slope is negative
+// when susceptibility decreases over time.
+func chiSquareForTrend(buckets []*antibiogram.TrendBucket) (chiSquare, pValue, slope float64) {
+	var tested, susceptible, scoreSum float64
+	for index, bucket := range buckets {
+		tested += float64(bucket.Isolates)
+		susceptible += float64(bucket.IsolatesSusceptible)
+		scoreSum += float64(bucket.Isolates) * float64(index)
+	}
+	if tested == 0 {
+		return 0, 1, 0
+	}
+
+	proportion := susceptible / tested
+	meanScore := scoreSum / tested
+
+	var statistic, sumSquares float64
+	for index, bucket := range buckets {
+		deviation := float64(index) - meanScore
+		statistic += float64(bucket.IsolatesSusceptible) * deviation
+		sumSquares += float64(bucket.Isolates) * deviation * deviation
+	}
+
+	variance := proportion * (1 - proportion) * sumSquares
+	if variance == 0 {
+		return 0, 1, 0
+	}
+
+	chiSquare = statistic * statistic / variance
+	// Upper tail of chi-square distribution with one degree of freedom
+	pValue = math.Erfc(math.Sqrt(chiSquare / 2))
+
+	return chiSquare, pValue, statistic
+}
+
+func trendCacheKey(trendReq *antibiogram.ResistanceTrendRequest) string {
+	return cacheKey(
+		viewTrend,
+		trendReq.GetFilter(),
+		trendReq.GetPathogenId(),
+		trendReq.GetAntimicrobialId(),
+		trendReq.GetInterval().String(),
+		fmt.Sprint(trendReq.GetSignificanceLevel()),
+	)
+}
+
+func (api *apiServer) getResistanceTrendFromCache(
+	ctx context.Context, trendReq *antibiogram.ResistanceTrendRequest,
+) (*antibiogram.ResistanceTrend, error) {
+	// Cache entry of the trend
+	entry := newCacheEntry(
+		trendCacheKey(trendReq),
+		trendReq.GetFilter(),
+		pathogenTag(trendReq.GetPathogenId()),
+		antimicrobialTag(trendReq.GetAntimicrobialId()),
+	)
+
+	// Check cache if it exists
+	data, err := api.getCache(ctx, viewTrend, entry, trendReq.GetFilter().GetMaxStalenessSec())
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return api.getResistanceTrend(ctx, entry, trendReq)
+	default:
+		return nil, errs.RedisCmdFailed(err, "GET")
+	}
+
+	// Unmarshal data
+	resistanceTrend := &antibiogram.ResistanceTrend{}
+	err = proto.Unmarshal([]byte(data), resistanceTrend)
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.Internal, err, "failed to proto unmarshal")
+	}
+
+	return resistanceTrend, nil
+}
+
+func (api *apiServer) getResistanceTrend(
+	ctx context.Context, entry *cacheEntry, trendReq *antibiogram.ResistanceTrendRequest,
+) (*antibiogram.ResistanceTrend, error) {
+
+	filter := trendReq.GetFilter()
+	pathogenID := trendReq.GetPathogenId()
+	antimicrobialID := trendReq.GetAntimicrobialId()
+	interval := trendReq.GetInterval()
+
+	stats, err := api.getPairStats(filter, []string{pathogenID}, []string{antimicrobialID}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resistanceTrend := &antibiogram.ResistanceTrend{
+		PathogenId:      pathogenID,
+		AntimicrobialId: antimicrobialID,
+		Interval:        interval,
+		Buckets:         make([]*antibiogram.TrendBucket, 0),
+	}
+
+	var (
+		buckets     = make(map[int64]*antibiogram.TrendBucket)
+		firstBucket time.Time
+		lastBucket  time.Time
+	)
+
+	// Range over daily stats and populate buckets
+	for _, stat := range stats {
+		if stat.isolate() {
+			continue
+		}
+
+		resistanceTrend.PathogenName = stat.PathogenName
+		resistanceTrend.AntimicrobialName = stat.AntimicrobialName
+
+		start := bucketStart(stat.Day, interval)
+		bucket, ok := buckets[start.Unix()]
+		if !ok {
+			bucket = &antibiogram.TrendBucket{}
+			buckets[start.Unix()] = bucket
+		}
+
+		bucket.Isolates += int32(stat.Isolates)
+		if stat.label() == culture_pb.Label_SUSCEPTIBLE {
+			bucket.IsolatesSusceptible += int32(stat.Isolates)
+		}
+
+		if firstBucket.IsZero() || start.Before(firstBucket) {
+			firstBucket = start
+		}
+		if start.After(lastBucket) {
+			lastBucket = start
+		}
+	}
+
+	// Include empty buckets so that buckets are evenly spaced
+	if !firstBucket.IsZero() {
+		for start := firstBucket; !start.After(lastBucket); start = nextBucket(start, interval) {
+			bucket, ok := buckets[start.Unix()]
+			if !ok {
+				bucket = &antibiogram.TrendBucket{}
+			}
+			bucket.Period = bucketPeriod(start, interval)
+			bucket.StartTimestampSec = start.Unix()
+			bucket.EndTimestampSec = nextBucket(start, interval).Unix()
+			bucket.PercentSusceptible = percentOf(bucket.IsolatesSusceptible, bucket.Isolates)
+			resistanceTrend.Buckets = append(resistanceTrend.Buckets, bucket)
+		}
+	}
+
+	significanceLevel := float64(trendReq.GetSignificanceLevel())
+	if significanceLevel <= 0 {
+		significanceLevel = defaultSignificanceLevel
+	}
+
+	chiSquare, pValue, slope := chiSquareForTrend(resistanceTrend.Buckets)
+	resistanceTrend.ChiSquare = chiSquare
+	resistanceTrend.PValue = pValue
+	resistanceTrend.Declining = slope < 0 && pValue < significanceLevel
+
+	// Marshal data
+	bs, err := proto.Marshal(resistanceTrend)
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.Internal, err, "failed to proto marshal")
+	}
+
+	// Save to cache
+	err = api.setCache(ctx, entry, bs)
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.Internal, err, "fail to save antibiogram from cache")
+	}
+
+	return resistanceTrend, nil
+}
+
+func (api *apiServer) GenResistanceTrend(
+	ctx context.Context, trendReq *antibiogram.ResistanceTrendRequest,
+) (*antibiogram.ResistanceTrend, error) {
+	// Request must not be nil
+	if trendReq == nil {
+		return nil, errs.NilObject("ResistanceTrendRequest")
+	}
+
+	// Authentication
+	err := api.authAPI.AuthenticateRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	filter := trendReq.GetFilter()
+	switch {
+	case filter == nil:
+		err = errs.NilObject("Filter")
+	case trendReq.PathogenId == "":
+		err = errs.MissingField("PathogenId")
+	case trendReq.AntimicrobialId == "":
+		err = errs.MissingField("AntimicrobialId")
+	case trendReq.SignificanceLevel < 0 || trendReq.SignificanceLevel >= 1:
+		err = errs.WrapMessage(codes.InvalidArgument, "significance level must be between 0 and 1")
+	default:
+		err = validateFilterScope(filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Get trend from filter
+	return api.getResistanceTrendFromCache(ctx, trendReq)
+}
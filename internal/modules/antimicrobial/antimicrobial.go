@@ -8,8 +8,10 @@ import (
 	"github.com/gidyon/antibug/internal/pkg/auth"
 	"github.com/gidyon/antibug/internal/pkg/errs"
 	"github.com/gidyon/antibug/pkg/api/antimicrobial"
+	"github.com/go-redis/redis"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
 	"strings"
 )
@@ -20,16 +22,20 @@ var (
 )
 
 type antimicrobialAPIServer struct {
-	sqlDB   *gorm.DB
-	logger  grpclog.LoggerV2
-	authAPI auth.Interface
+	sqlDB       *gorm.DB
+	logger      grpclog.LoggerV2
+	authAPI     auth.Interface
+	maxPageSize int32
 }
 
 // Options contains parameters for NewAntimicrobialAPI
 type Options struct {
 	SQLDB      *gorm.DB
+	RedisDB    *redis.Client
 	Logger     grpclog.LoggerV2
 	SigningKey string
+	// Largest page of listed antimicrobials. Zero is defaultMaxPageSize.
+	MaxPageSize int32
 }
 
 // NewAntimicrobialAPI creates a new antimicrobial API server
@@ -39,26 +45,34 @@ func NewAntimicrobialAPI(ctx context.Context, opt *Options) (antimicrobial.Antim
 	switch {
 	case opt.SQLDB == nil:
 		err = errs.NilObject("SqlDB")
+	case opt.RedisDB == nil:
+		err = errs.NilObject("RedisClient")
 	case opt.Logger == nil:
 		err = errs.NilObject("Logger")
 	case opt.SigningKey == "":
 		err = errs.MissingField("Jwt SigningKey")
 	case ctx == nil:
 		err = errs.NilObject("Context")
+	case opt.MaxPageSize < 0:
+		err = errs.WrapMessage(codes.InvalidArgument, "max page size must not be negative")
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	authAPI, err := auth.NewAPI(opt.SigningKey)
+	authAPI, err := auth.NewAPI(opt.SigningKey, opt.RedisDB)
 	if err != nil {
 		return nil, err
 	}
 
 	papi := &antimicrobialAPIServer{
-		sqlDB:   opt.SQLDB,
-		logger:  opt.Logger,
-		authAPI: authAPI,
+		sqlDB:       opt.SQLDB,
+		logger:      opt.Logger,
+		authAPI:     authAPI,
+		maxPageSize: opt.MaxPageSize,
+	}
+	if papi.maxPageSize == 0 {
+		papi.maxPageSize = defaultMaxPageSize
 	}
 
 	// Perform auto migration
@@ -213,6 +227,15 @@ func (papi *antimicrobialAPIServer) DeleteAntimicrobial(
 	return &empty.Empty{}, nil
 }
 
+// defaultMaxPageSize is the largest page of listed antimicrobials unless configured
+const defaultMaxPageSize = 20
+
+// antimicrobialKeysets are orders of listed antimicrobials
+var antimicrobialKeysets = map[antimicrobial.AntimicrobialSort]modules.Keyset{
+	antimicrobial.AntimicrobialSort_SORT_BY_ID:   {},
+	antimicrobial.AntimicrobialSort_SORT_BY_NAME: {Column: "antimicrobial_name"},
+}
+
 func (papi *antimicrobialAPIServer) ListAntimicrobials(
 	ctx context.Context, listReq *antimicrobial.ListAntimicrobialsRequest,
 ) (*antimicrobial.Antimicrobials, error) {
@@ -227,17 +250,38 @@ func (papi *antimicrobialAPIServer) ListAntimicrobials(
 		return nil, err
 	}
 
-	// Normalize page
-	pageNumber, pageSize := modules.NormalizePage(listReq.PageToken, listReq.PageSize)
-	offset := pageNumber*pageSize - pageSize
+	// Validation
+	keyset, ok := antimicrobialKeysets[listReq.Sort]
+	if !ok {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "unknown sort "+listReq.Sort.String())
+	}
 
-	antimicrobialsDB := make([]*Antimicrobial, 0, pageSize)
-	err = papi.sqlDB.Order("created_at DESC").Offset(offset).Limit(pageSize).
-		Find(&antimicrobialsDB).Error
+	var totalCount int64
+	if listReq.IncludeTotal {
+		err = papi.sqlDB.Model(&Antimicrobial{}).Count(&totalCount).Error
+		if err != nil {
+			return nil, errs.SQLQueryFailed(err, "COUNT")
+		}
+	}
+
+	page, err := modules.NewPage(keyset, listReq.PageToken, listReq.PageSize, papi.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	antimicrobialsDB := make([]*Antimicrobial, 0, page.Size+1)
+	err = page.Apply(papi.sqlDB).Find(&antimicrobialsDB).Error
 	if err != nil {
 		return nil, errs.SQLQueryFailed(err, "LIST")
 	}
 
+	var nextPageToken string
+	if page.HasNext(len(antimicrobialsDB)) {
+		antimicrobialsDB = antimicrobialsDB[:page.Size]
+		last := antimicrobialsDB[page.Size-1]
+		nextPageToken = page.NextToken(last.AntimicrobialName, last.ID)
+	}
+
 	antimicrobialsPB := make([]*antimicrobial.Antimicrobial, 0, len(antimicrobialsDB))
 	for _, antimicrobialDB := range antimicrobialsDB {
 		antimicrobialPB, err := getAntimicrobialPB(antimicrobialDB)
@@ -249,6 +293,8 @@ func (papi *antimicrobialAPIServer) ListAntimicrobials(
 
 	return &antimicrobial.Antimicrobials{
 		Antimicrobials: antimicrobialsPB,
+		NextPageToken:  nextPageToken,
+		TotalCount:     totalCount,
 	}, nil
 }
 
@@ -273,14 +319,16 @@ func (papi *antimicrobialAPIServer) SearchAntimicrobials(
 		}, nil
 	}
 
-	pageNumber, pageSize := modules.NormalizePage(searchReq.GetPageToken(), searchReq.GetPageSize())
-	offset := (pageNumber * pageSize) - pageSize
+	page, err := modules.NewPage(modules.Keyset{}, searchReq.GetPageToken(), searchReq.GetPageSize(), papi.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
 
 	parsedQuery := modules.ParseQuery(searchReq.Query, " antimicrobials", "antimicrobial")
 
-	antimicrobialsDB := make([]*Antimicrobial, 0, pageSize)
+	antimicrobialsDB := make([]*Antimicrobial, 0, page.Size+1)
 
-	err = papi.sqlDB.Unscoped().Offset(offset).Limit(pageSize).
+	err = page.Apply(papi.sqlDB.Unscoped()).
 		Find(&antimicrobialsDB, "MATCH(antimicrobial_name) AGAINST(? IN BOOLEAN MODE)", parsedQuery).Error
 	switch {
 	case err == nil:
@@ -288,6 +336,12 @@ func (papi *antimicrobialAPIServer) SearchAntimicrobials(
 		return nil, errs.SQLQueryFailed(err, "SELECT")
 	}
 
+	var nextPageToken string
+	if page.HasNext(len(antimicrobialsDB)) {
+		antimicrobialsDB = antimicrobialsDB[:page.Size]
+		nextPageToken = page.NextToken(nil, antimicrobialsDB[page.Size-1].ID)
+	}
+
 	// Populate response
 	antimicrobialsPB := make([]*antimicrobial.Antimicrobial, 0, len(antimicrobialsDB))
 
@@ -300,7 +354,7 @@ func (papi *antimicrobialAPIServer) SearchAntimicrobials(
 	}
 
 	return &antimicrobial.Antimicrobials{
-		NextPageToken:  int32(pageNumber + 1),
+		NextPageToken:  nextPageToken,
 		Antimicrobials: antimicrobialsPB,
 	}, nil
 }
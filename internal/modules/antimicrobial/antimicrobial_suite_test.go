@@ -7,6 +7,7 @@ import (
 	"github.com/gidyon/antibug/internal/mocks"
 	"github.com/gidyon/antibug/pkg/api/antimicrobial"
 	"github.com/gidyon/micros"
+	"github.com/go-redis/redis"
 
 	// Imports mysql driver
 	_ "github.com/go-sql-driver/mysql"
@@ -31,6 +32,7 @@ const (
 	dbName         = "antibug"
 	dbAddressAws   = "3.21.234.210:30810"
 	dbAddressLocal = "localhost"
+	redisAddress   = "localhost:6379"
 )
 
 func initDB() (*gorm.DB, error) {
@@ -45,8 +47,13 @@ var _ = BeforeSuite(func() {
 	db, err := initDB()
 	Expect(err).ShouldNot(HaveOccurred())
 
+	redisDB := redis.NewClient(&redis.Options{
+		Addr: redisAddress,
+	})
+
 	opt := &Options{
 		SQLDB:      db,
+		RedisDB:    redisDB,
 		Logger:     micros.NewLogger("antimicrobial_app"),
 		SigningKey: randomdata.RandStringRunes(32),
 	}
@@ -69,6 +76,11 @@ var _ = BeforeSuite(func() {
 	Expect(err).Should(HaveOccurred())
 
 	opt.SQLDB = db
+	opt.RedisDB = nil
+	_, err = NewAntimicrobialAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.RedisDB = redisDB
 	opt.Logger = nil
 	_, err = NewAntimicrobialAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
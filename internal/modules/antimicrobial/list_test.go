@@ -15,8 +15,7 @@ var _ = Describe("List Antimicrobials #list", func() {
 
 	BeforeEach(func() {
 		listReq = &antimicrobial.ListAntimicrobialsRequest{
-			View:      antimicrobial.AntimicrobialView_LIST,
-			PageToken: 0,
+			View: antimicrobial.AntimicrobialView_LIST,
 		}
 		ctx = context.Background()
 	})
@@ -32,12 +31,12 @@ var _ = Describe("List Antimicrobials #list", func() {
 	})
 
 	When("Listing antimicrobials with weird request payload", func() {
-		It("should succeed when page token is weird", func() {
-			listReq.PageToken = int32(-45)
+		It("should fail when page token is malformed", func() {
+			listReq.PageToken = "-45"
 			listRes, err := AntimicrobialAPI.ListAntimicrobials(ctx, listReq)
-			Expect(err).ToNot(HaveOccurred())
-			Expect(status.Code(err)).To(Equal(codes.OK))
-			Expect(listRes).ToNot(BeNil())
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(listRes).To(BeNil())
 		})
 	})
 
@@ -62,13 +61,37 @@ var _ = Describe("List Antimicrobials #list", func() {
 			Expect(len(listRes.Antimicrobials)).ShouldNot(BeZero())
 		})
 
-		It("should list antimicrobials even when page token is large", func() {
-			listReq.PageToken = 3000
+		It("should list the next page after the page token", func() {
+			listReq.PageSize = 1
+			listReq.IncludeTotal = true
 			listRes, err := AntimicrobialAPI.ListAntimicrobials(ctx, listReq)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(status.Code(err)).To(Equal(codes.OK))
-			Expect(listRes).ToNot(BeNil())
-			Expect(len(listRes.Antimicrobials)).Should(BeZero())
+			Expect(listRes.TotalCount).Should(BeNumerically(">=", len(listRes.Antimicrobials)))
+			if listRes.TotalCount == 1 {
+				Expect(listRes.NextPageToken).Should(BeEmpty())
+				return
+			}
+
+			listReq.PageToken = listRes.NextPageToken
+			nextRes, err := AntimicrobialAPI.ListAntimicrobials(ctx, listReq)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextRes.Antimicrobials).Should(HaveLen(1))
+			Expect(nextRes.Antimicrobials[0].AntimicrobialId).ShouldNot(Equal(listRes.Antimicrobials[0].AntimicrobialId))
+		})
+
+		It("should fail when page token is of another sort", func() {
+			listReq.PageSize = 1
+			listReq.Sort = antimicrobial.AntimicrobialSort_SORT_BY_NAME
+			listRes, err := AntimicrobialAPI.ListAntimicrobials(ctx, listReq)
+			Expect(err).ToNot(HaveOccurred())
+			if listRes.NextPageToken == "" {
+				return
+			}
+
+			listReq.PageToken = listRes.NextPageToken
+			listReq.Sort = 0
+			_, err = AntimicrobialAPI.ListAntimicrobials(ctx, listReq)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
 		})
 	})
 })
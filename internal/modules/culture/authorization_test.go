@@ -0,0 +1,246 @@
+package culture
+
+import (
+	"bytes"
+	"context"
+	"github.com/gidyon/antibug/internal/mocks"
+	authmocks "github.com/gidyon/antibug/internal/mocks/mocks"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+)
+
+const (
+	labTechFacility = "hospital-1"
+	otherFacility   = "hospital-2"
+)
+
+var _ = Describe("Authorizing changes to cultures by facility #authorization", func() {
+	var (
+		labTechAPI *cultureAPIServer
+		payload    *auth.Payload
+		ctx        context.Context
+	)
+
+	// fakeFacilityCulture creates a fake culture of the facility
+	fakeFacilityCulture := func(facilityID string) *culture.Culture {
+		culturePB := FakeCulture()
+		culturePB.HospitalId = facilityID
+		return culturePB
+	}
+
+	BeforeEach(func() {
+		payload = &auth.Payload{ID: "labtech-9", Group: auth.LabTechnician, FacilityIDs: []string{labTechFacility}}
+
+		authAPI := &authmocks.AuthAPIMock{}
+		authAPI.On("AuthenticateRequest", mock.Anything).Return(nil)
+		authAPI.On("AuthorizeGroup", mock.Anything, mock.Anything, mock.Anything).Return(payload, nil)
+
+		server := *CultureServer
+		server.authAPI = authAPI
+		labTechAPI = &server
+
+		ctx = context.Background()
+	})
+
+	Describe("Changing cultures of facilities without a job", func() {
+		var cultureID string
+
+		BeforeEach(func() {
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{
+				Culture: fakeFacilityCulture(otherFacility),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			cultureID = createRes.CultureId
+		})
+
+		It("should fail to create culture", func() {
+			createRes, err := labTechAPI.CreateCulture(ctx, &culture.CreateCultureRequest{
+				Culture: fakeFacilityCulture(otherFacility),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(createRes).To(BeNil())
+		})
+		It("should fail to update culture", func() {
+			updateRes, err := labTechAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{PatientAge: 40},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(updateRes).To(BeNil())
+		})
+		It("should fail to delete culture", func() {
+			delRes, err := labTechAPI.DeleteCulture(ctx, &culture.DeleteCultureRequest{CultureId: cultureID})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(delRes).To(BeNil())
+		})
+		It("should still read culture", func() {
+			getRes, err := labTechAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.HospitalId).Should(Equal(otherFacility))
+		})
+	})
+
+	Describe("Changing cultures of facilities with a job", func() {
+		var cultureID string
+
+		BeforeEach(func() {
+			createRes, err := labTechAPI.CreateCulture(ctx, &culture.CreateCultureRequest{
+				Culture: fakeFacilityCulture(labTechFacility),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			cultureID = createRes.CultureId
+		})
+
+		It("should take the editor from the token", func() {
+			_, err := labTechAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				EditorId:  "someone-else",
+				Culture:   &culture.Culture{PatientAge: 40},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			getRes, err := labTechAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Editors).Should(ContainElement(payload.ID))
+			Expect(getRes.Editors).ShouldNot(ContainElement("someone-else"))
+		})
+		It("should fail to move culture to a facility without a job", func() {
+			updateRes, err := labTechAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{HospitalId: otherFacility},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(updateRes).To(BeNil())
+		})
+		It("should delete culture", func() {
+			_, err := labTechAPI.DeleteCulture(ctx, &culture.DeleteCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+		It("should let admins change cultures of any facility", func() {
+			_, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{PatientAge: 41},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Editors).Should(ContainElement(mocks.AdminPayload.ID))
+		})
+	})
+})
+
+var _ = Describe("Rejecting changes to cultures by researchers #authorization", func() {
+	var (
+		researcherAPI *cultureAPIServer
+		cultureID     string
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		payload := &auth.Payload{ID: "researcher-9", Group: auth.Researcher}
+
+		// The mock authorizes the researcher token only when researchers are among the allowed groups
+		authorizeGroup := func(ctx context.Context, allowedGroups ...string) (*auth.Payload, error) {
+			for _, group := range allowedGroups {
+				if group == payload.Group {
+					return payload, nil
+				}
+			}
+			return nil, errs.PermissionDenied("AuthorizeGroup")
+		}
+
+		authAPI := &authmocks.AuthAPIMock{}
+		authAPI.On("AuthenticateRequest", mock.Anything).Return(nil)
+		authAPI.On("AuthorizeGroup", mock.Anything, mock.Anything, mock.Anything).Return(
+			func(ctx context.Context, allowedGroups ...string) *auth.Payload {
+				payload, _ := authorizeGroup(ctx, allowedGroups...)
+				return payload
+			},
+			func(ctx context.Context, allowedGroups ...string) error {
+				_, err := authorizeGroup(ctx, allowedGroups...)
+				return err
+			},
+		)
+
+		server := *CultureServer
+		server.authAPI = authAPI
+		researcherAPI = &server
+
+		ctx = context.Background()
+
+		createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: FakeCulture()})
+		Expect(err).ShouldNot(HaveOccurred())
+		cultureID = createRes.CultureId
+	})
+
+	expectPermissionDenied := func(err error) {
+		Expect(err).To(HaveOccurred())
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	}
+
+	It("should fail to create culture", func() {
+		createRes, err := researcherAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: FakeCulture()})
+		expectPermissionDenied(err)
+		Expect(createRes).To(BeNil())
+	})
+	It("should fail to update culture", func() {
+		updateRes, err := researcherAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+			CultureId: cultureID,
+			Culture:   &culture.Culture{PatientAge: 40},
+		})
+		expectPermissionDenied(err)
+		Expect(updateRes).To(BeNil())
+	})
+	It("should fail to delete culture", func() {
+		delRes, err := researcherAPI.DeleteCulture(ctx, &culture.DeleteCultureRequest{CultureId: cultureID})
+		expectPermissionDenied(err)
+		Expect(delRes).To(BeNil())
+	})
+	It("should fail to move culture to another status", func() {
+		transitionRes, err := researcherAPI.TransitionCulture(ctx, &culture.TransitionCultureRequest{
+			CultureId: cultureID, Status: culture.CultureStatus_AMENDED,
+		})
+		expectPermissionDenied(err)
+		Expect(transitionRes).To(BeNil())
+	})
+	It("should fail to restore culture revision", func() {
+		restoreRes, err := researcherAPI.RestoreCultureRevision(ctx, &culture.RestoreCultureRevisionRequest{
+			CultureId: cultureID, RevisionNumber: 1,
+		})
+		expectPermissionDenied(err)
+		Expect(restoreRes).To(BeNil())
+	})
+	It("should fail to import cultures", func() {
+		stream := newImportStream(&culture.ImportOptions{}, "Facility\n")
+		err := researcherAPI.ImportCultures(stream)
+		expectPermissionDenied(err)
+		Expect(stream.response).To(BeNil())
+	})
+	It("should fail to send hl7 messages over http", func() {
+		codeTables, err := LoadHL7CodeTables(hl7CodeTablesFile)
+		Expect(err).ShouldNot(HaveOccurred())
+		ingester, err := NewHL7Ingester(researcherAPI, codeTables)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		req := httptest.NewRequest(http.MethodPost, "/api/antibug/cultures/hl7", bytes.NewReader(readHL7Sample("oru-r01-mic.hl7")))
+		w := httptest.NewRecorder()
+		ingester.ServeHTTP(w, req)
+		Expect(w.Code).Should(Equal(http.StatusForbidden))
+	})
+	It("should still read culture", func() {
+		getRes, err := researcherAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(getRes.CultureId).Should(Equal(cultureID))
+	})
+})
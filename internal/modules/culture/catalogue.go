@@ -0,0 +1,171 @@
+package culture
+
+import (
+	"fmt"
+	antimicrobial_service "github.com/gidyon/antibug/internal/modules/antimicrobial"
+	pathogen_service "github.com/gidyon/antibug/internal/modules/pathogen"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"sync"
+	"time"
+)
+
+const (
+	// Catalogues are reloaded after this age, or on a miss after catalogueMinRefresh
+	catalogueTTL        = 10 * time.Minute
+	catalogueMinRefresh = time.Minute
+)
+
+// Catalogue looks up names of pathogens and antimicrobials that cultures refer to
+type Catalogue interface {
+	PathogenName(pathogenID string) (string, bool, error)
+	AntimicrobialName(antimicrobialID string) (string, bool, error)
+}
+
+// sqlCatalogue caches pathogens and antimicrobials from tables of the pathogen and antimicrobial services
+type sqlCatalogue struct {
+	db             *gorm.DB
+	mu             sync.RWMutex
+	pathogens      map[string]string
+	antimicrobials map[string]string
+	loadedAt       time.Time
+}
+
+// NewSQLCatalogue creates a catalogue reading pathogens and antimicrobials from the database
+func NewSQLCatalogue(db *gorm.DB) (Catalogue, error) {
+	if db == nil {
+		return nil, errs.NilObject("SqlDB")
+	}
+	catalogue := &sqlCatalogue{db: db}
+	err := catalogue.load()
+	if err != nil {
+		return nil, err
+	}
+	return catalogue, nil
+}
+
+func (catalogue *sqlCatalogue) load() error {
+	type entry struct {
+		ID   uint
+		Name string
+	}
+
+	read := func(model interface{}, nameColumn string) (map[string]string, error) {
+		entries := make([]*entry, 0)
+		err := catalogue.db.Model(model).Select("id, " + nameColumn + " AS name").Scan(&entries).Error
+		if err != nil {
+			return nil, errs.SQLQueryFailed(err, "SELECT")
+		}
+		names := make(map[string]string, len(entries))
+		for _, entry := range entries {
+			names[fmt.Sprint(entry.ID)] = entry.Name
+		}
+		return names, nil
+	}
+
+	pathogens, err := read(&pathogen_service.Pathogen{}, "pathogen_name")
+	if err != nil {
+		return err
+	}
+
+	antimicrobials, err := read(&antimicrobial_service.Antimicrobial{}, "antimicrobial_name")
+	if err != nil {
+		return err
+	}
+
+	catalogue.pathogens, catalogue.antimicrobials, catalogue.loadedAt = pathogens, antimicrobials, time.Now()
+	return nil
+}
+
+// lookup finds the id in the cached names. The catalogue is reloaded when stale, or on a miss
+// so that newly added entries are found.
+func (catalogue *sqlCatalogue) lookup(names func() map[string]string, id string) (string, bool, error) {
+	catalogue.mu.RLock()
+	name, ok := names()[id]
+	age := time.Since(catalogue.loadedAt)
+	catalogue.mu.RUnlock()
+
+	if age < catalogueMinRefresh || ok && age < catalogueTTL {
+		return name, ok, nil
+	}
+
+	catalogue.mu.Lock()
+	defer catalogue.mu.Unlock()
+
+	// Another lookup may have reloaded the catalogue
+	if time.Since(catalogue.loadedAt) >= catalogueMinRefresh {
+		err := catalogue.load()
+		if err != nil {
+			return "", false, err
+		}
+	}
+
+	name, ok = names()[id]
+	return name, ok, nil
+}
+
+func (catalogue *sqlCatalogue) PathogenName(pathogenID string) (string, bool, error) {
+	return catalogue.lookup(func() map[string]string { return catalogue.pathogens }, pathogenID)
+}
+
+func (catalogue *sqlCatalogue) AntimicrobialName(antimicrobialID string) (string, bool, error) {
+	return catalogue.lookup(func() map[string]string { return catalogue.antimicrobials }, antimicrobialID)
+}
+
+// resolveReferences checks that pathogens and antimicrobials of a culture are in the catalogues and that
+// results are of pathogens found and antimicrobials used. Names of results are filled from the catalogues.
+func resolveReferences(catalogue Catalogue, culturePB *culture.Culture) error {
+	pathogens := make(map[string]string, len(culturePB.PathogensFound))
+	for _, pathogenID := range culturePB.PathogensFound {
+		name, ok, err := catalogue.PathogenName(pathogenID)
+		switch {
+		case err != nil:
+			return err
+		case !ok:
+			return errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("unknown pathogen %q", pathogenID))
+		}
+		pathogens[pathogenID] = name
+	}
+
+	antimicrobials := make(map[string]string, len(culturePB.AntimicrobialsUsed))
+	for _, antimicrobialID := range culturePB.AntimicrobialsUsed {
+		name, ok, err := catalogue.AntimicrobialName(antimicrobialID)
+		switch {
+		case err != nil:
+			return err
+		case !ok:
+			return errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("unknown antimicrobial %q", antimicrobialID))
+		}
+		antimicrobials[antimicrobialID] = name
+	}
+
+	tested := make(map[string]bool, len(culturePB.CultureResults))
+	for index, cultureResult := range culturePB.CultureResults {
+		pathogenName, ok := pathogens[cultureResult.PathogenId]
+		if !ok {
+			return errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf(
+				"culture result %d pathogen %q is not in pathogens found", index, cultureResult.PathogenId,
+			))
+		}
+		antimicrobialName, ok := antimicrobials[cultureResult.AntimicrobialId]
+		if !ok {
+			return errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf(
+				"culture result %d antimicrobial %q is not in antimicrobials used", index, cultureResult.AntimicrobialId,
+			))
+		}
+		key := cultureResult.PathogenId + "/" + cultureResult.AntimicrobialId
+		if tested[key] {
+			return errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf(
+				"culture result %d repeats %s against %s", index, pathogenName, antimicrobialName,
+			))
+		}
+		tested[key] = true
+
+		cultureResult.PathogenName = pathogenName
+		cultureResult.AntimicrobialName = antimicrobialName
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package culture
+
+import (
+	"context"
+	"fmt"
+	"github.com/Pallinder/go-randomdata"
+	antimicrobial_service "github.com/gidyon/antibug/internal/modules/antimicrobial"
+	pathogen_service "github.com/gidyon/antibug/internal/modules/pathogen"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeCatalogue has pathogens and antimicrobials of fake cultures, imports and HL7 samples
+type fakeCatalogue struct {
+	pathogens      map[string]string
+	antimicrobials map[string]string
+}
+
+func newFakeCatalogue(codeTables *HL7CodeTables) *fakeCatalogue {
+	catalogue := &fakeCatalogue{
+		pathogens:      make(map[string]string),
+		antimicrobials: make(map[string]string),
+	}
+	for _, pathogenID := range Pathogens {
+		catalogue.pathogens[pathogenID] = pathogenID
+	}
+	for _, antimicrobialID := range Antimicrobials {
+		catalogue.antimicrobials[antimicrobialID] = antimicrobialID
+	}
+	for code, name := range whonetOrganisms {
+		catalogue.pathogens[code] = name
+	}
+	for code, name := range whonetAntimicrobials {
+		catalogue.antimicrobials[code] = name
+	}
+	for _, organism := range codeTables.Organisms {
+		catalogue.pathogens[organism.ID] = organism.Name
+	}
+	for _, antimicrobial := range codeTables.Antimicrobials {
+		catalogue.antimicrobials[antimicrobial.ID] = antimicrobial.Name
+	}
+	return catalogue
+}
+
+func (catalogue *fakeCatalogue) PathogenName(pathogenID string) (string, bool, error) {
+	name, ok := catalogue.pathogens[pathogenID]
+	return name, ok, nil
+}
+
+func (catalogue *fakeCatalogue) AntimicrobialName(antimicrobialID string) (string, bool, error) {
+	name, ok := catalogue.antimicrobials[antimicrobialID]
+	return name, ok, nil
+}
+
+var _ = Describe("Validating references of cultures to catalogues #catalogue", func() {
+	var (
+		culturePB *culture.Culture
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		culturePB = FakeCulture()
+		ctx = context.Background()
+	})
+
+	Describe("Resolving references", func() {
+		It("should fail when pathogen is not in the catalogue", func() {
+			culturePB.PathogensFound[0] = "pathogen-typo"
+			err := resolveReferences(CultureServer.catalogue, culturePB)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+		It("should fail when antimicrobial is not in the catalogue", func() {
+			culturePB.AntimicrobialsUsed[0] = "antimicrobial-typo"
+			err := resolveReferences(CultureServer.catalogue, culturePB)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+		It("should fail when result pathogen is not in pathogens found", func() {
+			culturePB.PathogensFound = culturePB.PathogensFound[1:]
+			err := resolveReferences(CultureServer.catalogue, culturePB)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+		It("should fail when result antimicrobial is not in antimicrobials used", func() {
+			culturePB.AntimicrobialsUsed = culturePB.AntimicrobialsUsed[1:]
+			err := resolveReferences(CultureServer.catalogue, culturePB)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+		It("should fail when a pathogen is tested twice against an antimicrobial", func() {
+			culturePB.CultureResults[1].PathogenId = culturePB.CultureResults[0].PathogenId
+			culturePB.CultureResults[1].AntimicrobialId = culturePB.CultureResults[0].AntimicrobialId
+			err := resolveReferences(CultureServer.catalogue, culturePB)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+		It("should fill names from the catalogue", func() {
+			culturePB.PathogensFound[0] = "eco"
+			culturePB.CultureResults[0].PathogenId = "eco"
+			culturePB.CultureResults[0].PathogenName = "E. coli"
+			Expect(resolveReferences(CultureServer.catalogue, culturePB)).ShouldNot(HaveOccurred())
+			Expect(culturePB.CultureResults[0].PathogenName).Should(Equal("Escherichia coli"))
+		})
+	})
+
+	Describe("Saving cultures with unknown references", func() {
+		It("should fail to create culture", func() {
+			culturePB.PathogensFound[0] = "pathogen-typo"
+			culturePB.CultureResults[0].PathogenId = "pathogen-typo"
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(createRes).To(BeNil())
+		})
+		It("should fail to update results of pathogens not found", func() {
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			cultureResult := *culturePB.CultureResults[0]
+			cultureResult.PathogenId = "eco"
+			_, err = CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: createRes.CultureId,
+				Culture:   &culture.Culture{CultureResults: []*culture.LabTestResult{&cultureResult}},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Reading catalogues from the database", func() {
+		It("should find pathogens and antimicrobials by id", func() {
+			db := CultureServer.sqlDB
+			Expect(db.AutoMigrate(&pathogen_service.Pathogen{}, &antimicrobial_service.Antimicrobial{}).Error).
+				ShouldNot(HaveOccurred())
+
+			empty := []byte("[]")
+			pathogenDB := &pathogen_service.Pathogen{
+				PathogenName:            "pathogen " + randomdata.RandStringRunes(10),
+				Epidemology:             empty,
+				Symptoms:                empty,
+				AdditionalInformation:   empty,
+				GeneralSusceptibilities: empty,
+				Editors:                 empty,
+			}
+			Expect(db.Create(pathogenDB).Error).ShouldNot(HaveOccurred())
+
+			catalogue, err := NewSQLCatalogue(db)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			name, ok, err := catalogue.PathogenName(fmt.Sprint(pathogenDB.ID))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ok).Should(BeTrue())
+			Expect(name).Should(Equal(pathogenDB.PathogenName))
+
+			_, ok, err = catalogue.AntimicrobialName("0")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ok).Should(BeFalse())
+		})
+	})
+})
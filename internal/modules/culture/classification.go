@@ -0,0 +1,371 @@
+package culture
+
+import (
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"sort"
+	"strings"
+)
+
+// Antimicrobial categories of Magiorakos et al. (ECDC/CDC, 2012)
+const (
+	aminoglycosides                = "Aminoglycosides"
+	ansamycins                     = "Ansamycins"
+	antiMRSACephalosporins         = "Anti-MRSA cephalosporins"
+	antiStaphylococcalBetaLactams  = "Anti-staphylococcal beta-lactams"
+	antipseudomonalCarbapenems     = "Antipseudomonal carbapenems"
+	antipseudomonalCephalosporins  = "Antipseudomonal cephalosporins"
+	antipseudomonalFluoroquinolone = "Antipseudomonal fluoroquinolones"
+	antipseudomonalPenicillins     = "Antipseudomonal penicillins + beta-lactamase inhibitors"
+	carbapenems                    = "Carbapenems"
+	nonExtendedCephalosporins      = "Non-extended spectrum cephalosporins"
+	extendedCephalosporins         = "Extended-spectrum cephalosporins"
+	cephamycins                    = "Cephamycins"
+	fluoroquinolones               = "Fluoroquinolones"
+	folatePathwayInhibitors        = "Folate pathway inhibitors"
+	fucidanes                      = "Fucidanes"
+	glycopeptides                  = "Glycopeptides"
+	glycylcyclines                 = "Glycylcyclines"
+	lincosamides                   = "Lincosamides"
+	lipopeptides                   = "Lipopeptides"
+	macrolides                     = "Macrolides"
+	monobactams                    = "Monobactams"
+	oxazolidinones                 = "Oxazolidinones"
+	penicillins                    = "Penicillins"
+	penicillinsInhibitors          = "Penicillins + beta-lactamase inhibitors"
+	phenicols                      = "Phenicols"
+	phosphonicAcids                = "Phosphonic acids"
+	polymyxins                     = "Polymyxins"
+	streptogramins                 = "Streptogramins"
+	tetracyclines                  = "Tetracyclines"
+)
+
+// organismGroup maps antimicrobials to their categories for pathogens of the group
+type organismGroup struct {
+	pathogens  []string
+	categories map[string][]string
+	intrinsic  []*intrinsicResistance
+}
+
+// intrinsicResistance lists antimicrobials pathogens of a group are intrinsically resistant to.
+// Results of these antimicrobials are not acquired resistance and are not counted.
+type intrinsicResistance struct {
+	pathogens      []string
+	antimicrobials []string
+}
+
+var organismGroups = []*organismGroup{
+	{
+		pathogens: []string{"staphylococcus aureus", "s. aureus", "mrsa"},
+		categories: map[string][]string{
+			aminoglycosides:               {"gentamicin"},
+			ansamycins:                    {"rifampicin", "rifampin"},
+			antiMRSACephalosporins:        {"ceftaroline"},
+			antiStaphylococcalBetaLactams: {"oxacillin", "cefoxitin"},
+			fluoroquinolones:              {"ciprofloxacin", "moxifloxacin"},
+			folatePathwayInhibitors:       {"trimethoprim-sulfamethoxazole"},
+			fucidanes:                     {"fusidic acid"},
+			glycopeptides:                 {"vancomycin", "teicoplanin", "telavancin"},
+			glycylcyclines:                {"tigecycline"},
+			lincosamides:                  {"clindamycin"},
+			lipopeptides:                  {"daptomycin"},
+			macrolides:                    {"erythromycin"},
+			oxazolidinones:                {"linezolid"},
+			phenicols:                     {"chloramphenicol"},
+			phosphonicAcids:               {"fosfomycin"},
+			streptogramins:                {"quinupristin-dalfopristin"},
+			tetracyclines:                 {"tetracycline", "doxycycline", "minocycline"},
+		},
+	},
+	{
+		pathogens: []string{"enterococcus"},
+		categories: map[string][]string{
+			aminoglycosides:  {"gentamicin", "streptomycin"},
+			carbapenems:      {"imipenem", "meropenem", "doripenem"},
+			fluoroquinolones: {"ciprofloxacin", "levofloxacin", "moxifloxacin"},
+			glycopeptides:    {"vancomycin", "teicoplanin"},
+			glycylcyclines:   {"tigecycline"},
+			lipopeptides:     {"daptomycin"},
+			oxazolidinones:   {"linezolid"},
+			penicillins:      {"ampicillin"},
+			streptogramins:   {"quinupristin-dalfopristin"},
+			tetracyclines:    {"doxycycline", "minocycline"},
+		},
+	},
+	{
+		pathogens: []string{
+			"escherichia", "e. coli", "klebsiella", "enterobacter", "proteus", "citrobacter",
+			"serratia", "salmonella", "shigella", "morganella", "providencia",
+		},
+		categories: map[string][]string{
+			aminoglycosides:            {"gentamicin", "tobramycin", "amikacin", "netilmicin"},
+			antiMRSACephalosporins:     {"ceftaroline"},
+			antipseudomonalPenicillins: {"ticarcillin-clavulanic acid", "piperacillin-tazobactam"},
+			carbapenems:                {"ertapenem", "imipenem", "meropenem", "doripenem"},
+			nonExtendedCephalosporins:  {"cefazolin", "cefuroxime"},
+			extendedCephalosporins:     {"cefotaxime", "ceftriaxone", "ceftazidime", "cefepime"},
+			cephamycins:                {"cefoxitin", "cefotetan"},
+			fluoroquinolones:           {"ciprofloxacin"},
+			folatePathwayInhibitors:    {"trimethoprim-sulfamethoxazole"},
+			glycylcyclines:             {"tigecycline"},
+			monobactams:                {"aztreonam"},
+			penicillins:                {"ampicillin"},
+			penicillinsInhibitors:      {"amoxicillin-clavulanic acid", "ampicillin-sulbactam"},
+			phenicols:                  {"chloramphenicol"},
+			phosphonicAcids:            {"fosfomycin"},
+			polymyxins:                 {"colistin"},
+			tetracyclines:              {"tetracycline", "doxycycline", "minocycline"},
+		},
+		intrinsic: []*intrinsicResistance{
+			{
+				pathogens: []string{
+					"citrobacter", "enterobacter", "klebsiella", "morganella", "proteus penneri", "proteus vulgaris",
+					"providencia", "serratia marcescens",
+				},
+				antimicrobials: []string{"ampicillin"},
+			},
+			{
+				pathogens: []string{
+					"citrobacter freundii", "enterobacter", "klebsiella aerogenes", "morganella", "providencia",
+					"serratia marcescens",
+				},
+				antimicrobials: []string{"amoxicillin-clavulanic acid"},
+			},
+			{
+				pathogens: []string{
+					"citrobacter freundii", "enterobacter", "klebsiella aerogenes", "providencia rettgeri",
+					"serratia marcescens",
+				},
+				antimicrobials: []string{"ampicillin-sulbactam"},
+			},
+			{
+				pathogens: []string{
+					"citrobacter freundii", "enterobacter", "klebsiella aerogenes", "morganella", "proteus penneri",
+					"proteus vulgaris", "providencia", "serratia marcescens",
+				},
+				antimicrobials: []string{"cefazolin"},
+			},
+			{
+				pathogens:      []string{"morganella", "proteus penneri", "proteus vulgaris", "serratia marcescens"},
+				antimicrobials: []string{"cefuroxime"},
+			},
+			{
+				pathogens:      []string{"citrobacter freundii", "enterobacter", "klebsiella aerogenes"},
+				antimicrobials: []string{"cefoxitin", "cefotetan"},
+			},
+			{
+				pathogens:      []string{"morganella", "proteus", "providencia"},
+				antimicrobials: []string{"tigecycline", "tetracycline", "doxycycline", "minocycline", "colistin"},
+			},
+			{
+				pathogens:      []string{"serratia marcescens"},
+				antimicrobials: []string{"colistin"},
+			},
+		},
+	},
+	{
+		pathogens: []string{"pseudomonas aeruginosa", "p. aeruginosa"},
+		categories: map[string][]string{
+			aminoglycosides:                {"gentamicin", "tobramycin", "amikacin", "netilmicin"},
+			antipseudomonalCarbapenems:     {"imipenem", "meropenem", "doripenem"},
+			antipseudomonalCephalosporins:  {"ceftazidime", "cefepime"},
+			antipseudomonalFluoroquinolone: {"ciprofloxacin", "levofloxacin"},
+			antipseudomonalPenicillins:     {"ticarcillin-clavulanic acid", "piperacillin-tazobactam"},
+			monobactams:                    {"aztreonam"},
+			phosphonicAcids:                {"fosfomycin"},
+			polymyxins:                     {"colistin", "polymyxin b"},
+		},
+	},
+	{
+		pathogens: []string{"acinetobacter"},
+		categories: map[string][]string{
+			aminoglycosides:                {"gentamicin", "tobramycin", "amikacin", "netilmicin"},
+			antipseudomonalCarbapenems:     {"imipenem", "meropenem", "doripenem"},
+			antipseudomonalFluoroquinolone: {"ciprofloxacin", "levofloxacin"},
+			antipseudomonalPenicillins:     {"piperacillin-tazobactam", "ticarcillin-clavulanic acid"},
+			extendedCephalosporins:         {"cefotaxime", "ceftriaxone", "ceftazidime", "cefepime"},
+			folatePathwayInhibitors:        {"trimethoprim-sulfamethoxazole"},
+			penicillinsInhibitors:          {"ampicillin-sulbactam"},
+			polymyxins:                     {"colistin", "polymyxin b"},
+			tetracyclines:                  {"tetracycline", "doxycycline", "minocycline"},
+		},
+	},
+}
+
+// antimicrobialAliases maps other names of antimicrobials to names used in categories
+var antimicrobialAliases = map[string]string{
+	"co-trimoxazole":                 "trimethoprim-sulfamethoxazole",
+	"cotrimoxazole":                  "trimethoprim-sulfamethoxazole",
+	"trimethoprim-sulphamethoxazole": "trimethoprim-sulfamethoxazole",
+	"sulfamethoxazole-trimethoprim":  "trimethoprim-sulfamethoxazole",
+	"sulphamethoxazole-trimethoprim": "trimethoprim-sulfamethoxazole",
+	"co-amoxiclav":                   "amoxicillin-clavulanic acid",
+	"amoxicillin-clavulanate":        "amoxicillin-clavulanic acid",
+	"amoxycillin-clavulanic acid":    "amoxicillin-clavulanic acid",
+	"ticarcillin-clavulanate":        "ticarcillin-clavulanic acid",
+	"piperacillin-tazobactum":        "piperacillin-tazobactam",
+	"polymyxin-b":                    "polymyxin b",
+	"fusidate":                       "fusidic acid",
+	"gentamicin high level":          "gentamicin",
+	"streptomycin high level":        "streptomycin",
+	"gentamicin (high level)":        "gentamicin",
+	"streptomycin (high level)":      "streptomycin",
+	"high level gentamicin":          "gentamicin",
+	"high level streptomycin":        "streptomycin",
+}
+
+// normalizeAntimicrobial returns the name of the antimicrobial as used in categories
+func normalizeAntimicrobial(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, sep := range []string{" / ", "/", " + ", "+", " - "} {
+		name = strings.Replace(name, sep, "-", -1)
+	}
+	name = strings.Join(strings.Fields(name), " ")
+	if alias, ok := antimicrobialAliases[name]; ok {
+		return alias
+	}
+	return name
+}
+
+// findOrganismGroup returns the organism group of the pathogen or nil if it has no categories
+func findOrganismGroup(pathogenName string) *organismGroup {
+	pathogenName = strings.ToLower(pathogenName)
+	for _, group := range organismGroups {
+		for _, pathogen := range group.pathogens {
+			if strings.Contains(pathogenName, pathogen) {
+				return group
+			}
+		}
+	}
+	return nil
+}
+
+// categoriesOf returns the categories of the antimicrobial for the organism group
+func (group *organismGroup) categoriesOf(antimicrobialName string) []string {
+	antimicrobialName = normalizeAntimicrobial(antimicrobialName)
+	categories := make([]string, 0, 1)
+	for category, antimicrobials := range group.categories {
+		for _, antimicrobial := range antimicrobials {
+			if antimicrobial == antimicrobialName {
+				categories = append(categories, category)
+				break
+			}
+		}
+	}
+	return categories
+}
+
+// intrinsicallyResistant checks whether the pathogen is intrinsically resistant to the antimicrobial
+func (group *organismGroup) intrinsicallyResistant(pathogenName, antimicrobialName string) bool {
+	pathogenName = strings.ToLower(pathogenName)
+	antimicrobialName = normalizeAntimicrobial(antimicrobialName)
+	for _, resistance := range group.intrinsic {
+		for _, pathogen := range resistance.pathogens {
+			if !strings.Contains(pathogenName, pathogen) {
+				continue
+			}
+			for _, antimicrobial := range resistance.antimicrobials {
+				if antimicrobial == antimicrobialName {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// categoriesFor returns the number of categories of the group the pathogen is not intrinsically resistant to
+func (group *organismGroup) categoriesFor(pathogenName string) int {
+	count := 0
+	for _, antimicrobials := range group.categories {
+		for _, antimicrobial := range antimicrobials {
+			if !group.intrinsicallyResistant(pathogenName, antimicrobial) {
+				count++
+				break
+			}
+		}
+	}
+	return count
+}
+
+func nonSusceptible(label culture.Label) bool {
+	return label == culture.Label_INTERMEDIATE || label == culture.Label_RESISTANT
+}
+
+// ClassifyIsolates classifies every pathogen in the culture as MDR, XDR or PDR.
+// Antimicrobials outside the categories of the pathogen and intrinsic resistance are ignored.
+func ClassifyIsolates(culturePB *culture.Culture) []*culture.IsolateClassification {
+	type isolate struct {
+		classification *culture.IsolateClassification
+		group          *organismGroup
+		tested         map[string]bool
+		nonSusceptible map[string]bool
+		allResistant   bool
+	}
+
+	isolates := make([]*isolate, 0, len(culturePB.GetPathogensFound()))
+	isolatesMap := make(map[string]*isolate, len(culturePB.GetPathogensFound()))
+
+	for _, cultureResult := range culturePB.GetCultureResults() {
+		item, ok := isolatesMap[cultureResult.PathogenId]
+		if !ok {
+			item = &isolate{
+				classification: &culture.IsolateClassification{
+					PathogenId:               cultureResult.PathogenId,
+					PathogenName:             cultureResult.PathogenName,
+					NonSusceptibleCategories: make([]string, 0),
+				},
+				group:          findOrganismGroup(cultureResult.PathogenName),
+				tested:         make(map[string]bool),
+				nonSusceptible: make(map[string]bool),
+				allResistant:   true,
+			}
+			isolatesMap[cultureResult.PathogenId] = item
+			isolates = append(isolates, item)
+		}
+
+		if item.group == nil || item.group.intrinsicallyResistant(cultureResult.PathogenName, cultureResult.AntimicrobialName) {
+			continue
+		}
+
+		for _, category := range item.group.categoriesOf(cultureResult.AntimicrobialName) {
+			item.tested[category] = true
+			if nonSusceptible(cultureResult.Label) {
+				item.nonSusceptible[category] = true
+			} else {
+				item.allResistant = false
+			}
+		}
+	}
+
+	classifications := make([]*culture.IsolateClassification, 0, len(isolates))
+
+	for _, item := range isolates {
+		classification := item.classification
+		classification.CategoriesTested = int32(len(item.tested))
+		for category := range item.nonSusceptible {
+			classification.NonSusceptibleCategories = append(classification.NonSusceptibleCategories, category)
+		}
+		sort.Strings(classification.NonSusceptibleCategories)
+
+		if item.group != nil {
+			var (
+				categories     = item.group.categoriesFor(item.classification.PathogenName)
+				nonSusceptible = len(item.nonSusceptible)
+			)
+			switch {
+			case len(item.tested) == categories && item.allResistant:
+				classification.ResistanceClass = culture.ResistanceClass_PDR
+			case nonSusceptible >= categories-2:
+				classification.ResistanceClass = culture.ResistanceClass_XDR
+			case nonSusceptible >= 3:
+				classification.ResistanceClass = culture.ResistanceClass_MDR
+			case len(item.tested) >= 3:
+				classification.ResistanceClass = culture.ResistanceClass_NON_MDR
+			}
+		}
+
+		classifications = append(classifications, classification)
+	}
+
+	return classifications
+}
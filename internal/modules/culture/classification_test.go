@@ -0,0 +1,106 @@
+package culture
+
+import (
+	"github.com/gidyon/antibug/pkg/api/culture"
+)
+
+var _ = Describe("Classifying isolates #classify", func() {
+	result := func(pathogen, antimicrobial string, label culture.Label) *culture.LabTestResult {
+		return &culture.LabTestResult{
+			PathogenId:        pathogen,
+			PathogenName:      pathogen,
+			AntimicrobialId:   antimicrobial,
+			AntimicrobialName: antimicrobial,
+			Label:             label,
+		}
+	}
+
+	It("should normalize antimicrobial names", func() {
+		Expect(normalizeAntimicrobial(" Piperacillin / Tazobactam")).Should(Equal("piperacillin-tazobactam"))
+		Expect(normalizeAntimicrobial("Co-trimoxazole")).Should(Equal("trimethoprim-sulfamethoxazole"))
+	})
+
+	It("should classify isolates non-susceptible in three categories as MDR", func() {
+		classifications := ClassifyIsolates(&culture.Culture{
+			CultureResults: []*culture.LabTestResult{
+				result("Escherichia coli", "Ampicillin", culture.Label_RESISTANT),
+				result("Escherichia coli", "Ceftriaxone", culture.Label_RESISTANT),
+				result("Escherichia coli", "Ciprofloxacin", culture.Label_INTERMEDIATE),
+				result("Escherichia coli", "Meropenem", culture.Label_SUSCEPTIBLE),
+			},
+		})
+		Expect(classifications).Should(HaveLen(1))
+		Expect(classifications[0].ResistanceClass).Should(Equal(culture.ResistanceClass_MDR))
+		Expect(classifications[0].CategoriesTested).Should(Equal(int32(4)))
+		Expect(classifications[0].NonSusceptibleCategories).Should(HaveLen(3))
+	})
+
+	It("should classify isolates susceptible to most categories as non-MDR", func() {
+		classifications := ClassifyIsolates(&culture.Culture{
+			CultureResults: []*culture.LabTestResult{
+				result("Acinetobacter baumannii", "Gentamicin", culture.Label_RESISTANT),
+				result("Acinetobacter baumannii", "Meropenem", culture.Label_SUSCEPTIBLE),
+				result("Acinetobacter baumannii", "Colistin", culture.Label_SUSCEPTIBLE),
+			},
+		})
+		Expect(classifications[0].ResistanceClass).Should(Equal(culture.ResistanceClass_NON_MDR))
+	})
+
+	It("should not count intrinsic resistance of wild-type isolates", func() {
+		classifications := ClassifyIsolates(&culture.Culture{
+			CultureResults: []*culture.LabTestResult{
+				result("Enterobacter cloacae", "Ampicillin", culture.Label_RESISTANT),
+				result("Enterobacter cloacae", "Amoxicillin-clavulanic acid", culture.Label_RESISTANT),
+				result("Enterobacter cloacae", "Cefazolin", culture.Label_RESISTANT),
+				result("Enterobacter cloacae", "Cefoxitin", culture.Label_RESISTANT),
+				result("Enterobacter cloacae", "Ceftriaxone", culture.Label_SUSCEPTIBLE),
+				result("Enterobacter cloacae", "Gentamicin", culture.Label_SUSCEPTIBLE),
+				result("Enterobacter cloacae", "Ciprofloxacin", culture.Label_SUSCEPTIBLE),
+				result("Enterobacter cloacae", "Meropenem", culture.Label_SUSCEPTIBLE),
+			},
+		})
+		Expect(classifications[0].ResistanceClass).Should(Equal(culture.ResistanceClass_NON_MDR))
+		Expect(classifications[0].CategoriesTested).Should(Equal(int32(4)))
+		Expect(classifications[0].NonSusceptibleCategories).Should(BeEmpty())
+
+		classifications = ClassifyIsolates(&culture.Culture{
+			CultureResults: []*culture.LabTestResult{
+				result("Klebsiella pneumoniae", "Ampicillin", culture.Label_RESISTANT),
+				result("Klebsiella pneumoniae", "Ceftriaxone", culture.Label_RESISTANT),
+				result("Klebsiella pneumoniae", "Ciprofloxacin", culture.Label_RESISTANT),
+				result("Klebsiella pneumoniae", "Meropenem", culture.Label_SUSCEPTIBLE),
+			},
+		})
+		Expect(classifications[0].ResistanceClass).Should(Equal(culture.ResistanceClass_NON_MDR))
+		Expect(classifications[0].NonSusceptibleCategories).Should(HaveLen(2))
+	})
+
+	It("should classify isolates non-susceptible to all but two categories as XDR", func() {
+		culturePB := &culture.Culture{}
+		for _, antimicrobial := range []string{"Gentamicin", "Imipenem", "Ceftazidime", "Ciprofloxacin", "Aztreonam", "Fosfomycin"} {
+			culturePB.CultureResults = append(
+				culturePB.CultureResults, result("Pseudomonas aeruginosa", antimicrobial, culture.Label_RESISTANT),
+			)
+		}
+		culturePB.CultureResults = append(
+			culturePB.CultureResults, result("Pseudomonas aeruginosa", "Colistin", culture.Label_SUSCEPTIBLE),
+		)
+		Expect(ClassifyIsolates(culturePB)[0].ResistanceClass).Should(Equal(culture.ResistanceClass_XDR))
+
+		// Resistant to every category
+		culturePB.CultureResults[len(culturePB.CultureResults)-1].Label = culture.Label_RESISTANT
+		culturePB.CultureResults = append(
+			culturePB.CultureResults, result("Pseudomonas aeruginosa", "Piperacillin/Tazobactam", culture.Label_RESISTANT),
+		)
+		Expect(ClassifyIsolates(culturePB)[0].ResistanceClass).Should(Equal(culture.ResistanceClass_PDR))
+	})
+
+	It("should not classify pathogens without category definitions", func() {
+		classifications := ClassifyIsolates(&culture.Culture{
+			CultureResults: []*culture.LabTestResult{
+				result("Candida albicans", "Fluconazole", culture.Label_RESISTANT),
+			},
+		})
+		Expect(classifications[0].ResistanceClass).Should(Equal(culture.ResistanceClass_UNCLASSIFIED))
+	})
+})
@@ -105,6 +105,45 @@ var _ = Describe("Creating culture resource #create", func() {
 			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
 			Expect(createRes).To(BeNil())
 		})
+		It("should fail when disk diffusion result is missing disk diameter", func() {
+			createReq.Culture.TestMethod = culture.TestMethod_DISK_DIFFUSION
+			createReq.Culture.CultureResults[0].DiskDiameter = ""
+			createRes, err := CultureAPI.CreateCulture(ctx, createReq)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(createRes).To(BeNil())
+		})
+		It("should fail when broth microdilution result is missing mic", func() {
+			createReq.Culture.TestMethod = culture.TestMethod_BROTH_MICRODILUTION
+			createRes, err := CultureAPI.CreateCulture(ctx, createReq)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(createRes).To(BeNil())
+		})
+		It("should fail when specimen type is unknown", func() {
+			createReq.Culture.SpecimenType = 99
+			createRes, err := CultureAPI.CreateCulture(ctx, createReq)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(createRes).To(BeNil())
+		})
+		It("should fail when specimen is collected after results are reported", func() {
+			createReq.Culture.SpecimenCollectedTimestampSec = createReq.Culture.ResultsTimestampSec + 3600
+			createRes, err := CultureAPI.CreateCulture(ctx, createReq)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(createRes).To(BeNil())
+		})
+		It("should fail when mic unit is not supported", func() {
+			createReq.Culture.TestMethod = culture.TestMethod_GRADIENT_STRIP
+			for _, cultureResult := range createReq.Culture.CultureResults {
+				cultureResult.Mic = &culture.MIC{Value: 2, Unit: "mm"}
+			}
+			createRes, err := CultureAPI.CreateCulture(ctx, createReq)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(createRes).To(BeNil())
+		})
 	})
 
 	Describe("Creating culture with well-formed request", func() {
@@ -115,5 +154,40 @@ var _ = Describe("Creating culture resource #create", func() {
 			Expect(createRes.CultureId).ShouldNot(BeZero())
 			Expect(status.Code(err)).To(Equal(codes.OK))
 		})
+		It("should create culture tested by disk diffusion when the test method is not given", func() {
+			createReq.Culture.TestMethod = culture.TestMethod_TEST_METHOD_UNSPECIFIED
+			createRes, err := CultureAPI.CreateCulture(ctx, createReq)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: createRes.CultureId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.TestMethod).Should(Equal(culture.TestMethod_DISK_DIFFUSION))
+		})
+		It("should create culture tested by an automated system", func() {
+			createReq.Culture.TestMethod = culture.TestMethod_PHOENIX
+			for _, cultureResult := range createReq.Culture.CultureResults {
+				cultureResult.DiskDiameter = ""
+				cultureResult.Mic = &culture.MIC{Value: 4, Comparator: culture.Comparator_EQUAL, Unit: "mg/L"}
+			}
+			createRes, err := CultureAPI.CreateCulture(ctx, createReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(createRes.CultureId).ShouldNot(BeZero())
+		})
+		It("should save the ward and specimen of the culture", func() {
+			createReq.Culture.Ward = "Ward 4B"
+			createReq.Culture.Department = culture.Department_INTENSIVE_CARE
+			createReq.Culture.PatientSetting = culture.PatientSetting_INPATIENT
+			createReq.Culture.SpecimenType = culture.SpecimenType_BLOOD
+			createRes, err := CultureAPI.CreateCulture(ctx, createReq)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: createRes.CultureId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Ward).Should(Equal("Ward 4B"))
+			Expect(getRes.Department).Should(Equal(culture.Department_INTENSIVE_CARE))
+			Expect(getRes.PatientSetting).Should(Equal(culture.PatientSetting_INPATIENT))
+			Expect(getRes.SpecimenType).Should(Equal(culture.SpecimenType_BLOOD))
+			Expect(getRes.SpecimenCollectedTimestampSec).Should(Equal(createReq.Culture.SpecimenCollectedTimestampSec))
+		})
 	})
 })
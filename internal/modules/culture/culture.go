@@ -2,34 +2,56 @@ package culture
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/gidyon/antibug/internal/modules"
 	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/breakpoints"
 	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/internal/pkg/expertrules"
 	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
 	"strings"
 )
 
+// Groups that may change cultures. Lab technicians may only change cultures of facilities where they hold a job.
+// Other groups such as researchers have read-only access.
 var (
-	authorizedGroups = []string{auth.Physician, auth.Researcher, auth.LabTechnician, auth.Admin}
+	authorizedGroups = []string{auth.LabTechnician, auth.Admin}
 )
 
 type cultureAPIServer struct {
-	sqlDB   *gorm.DB
-	logger  grpclog.LoggerV2
-	authAPI auth.Interface
+	sqlDB        *gorm.DB
+	redisClient  *redis.Client
+	logger       grpclog.LoggerV2
+	authAPI      auth.Interface
+	breakpoints  *breakpoints.Interpreter
+	catalogue    Catalogue
+	expertRules  *expertrules.Engine
+	pseudonymKey []byte
+	maxPageSize  int32
 }
 
 // Options contains parameters to NewCultureAPI
 type Options struct {
-	SQLDB      *gorm.DB
-	Logger     grpclog.LoggerV2
-	SigningKey string
+	SQLDB       *gorm.DB
+	RedisDB     *redis.Client
+	Logger      grpclog.LoggerV2
+	SigningKey  string
+	Breakpoints *breakpoints.Interpreter
+	Catalogue   Catalogue
+	// Rules checked against interpreted results. Results are not checked when nil.
+	ExpertRules *expertrules.Engine
+	// Key of the keyed hash replacing patient ids
+	PseudonymKey string
+	// Largest page of listed cultures. Zero is defaultMaxPageSize.
+	MaxPageSize int32
 }
 
 // NewCultureAPI is factory for creating culture APIs
@@ -41,32 +63,64 @@ func NewCultureAPI(ctx context.Context, opt *Options) (culture.CultureAPIServer,
 		err = errs.NilObject("Context")
 	case opt.SQLDB == nil:
 		err = errs.NilObject("SqlDB")
+	case opt.RedisDB == nil:
+		err = errs.NilObject("RedisClient")
 	case opt.Logger == nil:
 		err = errs.NilObject("Logger")
 	case opt.SigningKey == "":
 		err = errs.MissingField("JWT SigningKey")
+	case opt.Breakpoints == nil:
+		err = errs.NilObject("Breakpoints")
+	case opt.Catalogue == nil:
+		err = errs.NilObject("Catalogue")
+	case opt.PseudonymKey == "":
+		err = errs.MissingField("PseudonymKey")
+	case opt.MaxPageSize < 0:
+		err = errs.WrapMessage(codes.InvalidArgument, "max page size must not be negative")
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	authAPI, err := auth.NewAPI(opt.SigningKey)
+	authAPI, err := auth.NewAPI(opt.SigningKey, opt.RedisDB)
 	if err != nil {
 		return nil, err
 	}
 
 	capi := &cultureAPIServer{
-		sqlDB:   opt.SQLDB,
-		logger:  opt.Logger,
-		authAPI: authAPI,
+		sqlDB:        opt.SQLDB,
+		redisClient:  opt.RedisDB,
+		logger:       opt.Logger,
+		authAPI:      authAPI,
+		breakpoints:  opt.Breakpoints,
+		catalogue:    opt.Catalogue,
+		expertRules:  opt.ExpertRules,
+		pseudonymKey: []byte(opt.PseudonymKey),
+		maxPageSize:  opt.MaxPageSize,
+	}
+	if capi.maxPageSize == 0 {
+		capi.maxPageSize = defaultMaxPageSize
 	}
 
 	// Perform automigration
-	err = capi.sqlDB.AutoMigrate(&Culture{}).Error
+	err = capi.sqlDB.AutoMigrate(
+		&Culture{}, &Rollup{}, &RollupLock{}, &CultureLock{}, &CultureRevision{}, &PatientIdentity{},
+	).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to perform automigration: %v", err)
 	}
 
+	err = initRollupLock(capi.sqlDB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cultures saved before pseudonymisation keep raw patient ids
+	err = pseudonymizeCultures(capi.sqlDB, capi.pseudonymKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pseudonymize patients: %v", err)
+	}
+
 	return capi, nil
 }
 
@@ -79,14 +133,84 @@ func (capi *cultureAPIServer) CreateCulture(
 	}
 
 	// Authorize request
-	_, err := capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
+	payload, err := capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validation
+	// Validation, interpretation and classification
 	culturePB := createReq.GetCulture()
+	if len(createReq.IdempotencyKey) > maxIdempotencyKeyLen {
+		return nil, errs.WrapMessage(
+			codes.InvalidArgument, fmt.Sprintf("idempotency key must be at most %d characters", maxIdempotencyKeyLen),
+		)
+	}
+	err = capi.prepareCulture(culturePB)
+	if err != nil {
+		return nil, err
+	}
+
+	err = auth.AuthorizeFacility(payload, culturePB.HospitalId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save culture and its rollups in a transaction
+	tx := capi.sqlDB.Begin()
+	if tx.Error != nil {
+		return nil, errs.SQLQueryFailed(tx.Error, "BEGIN")
+	}
+
+	// Concurrent creates of the patient's cultures wait so that they see each other
+	err = lockPatientCultures(tx, culturePB)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Retried requests get the culture of the first request
+	idempotencyKey := ""
+	if createReq.IdempotencyKey != "" {
+		idempotencyKey = scopeIdempotencyKey(culturePB.HospitalId, createReq.IdempotencyKey)
+		cultureDB, err := getIdempotentCulture(tx, idempotencyKey, culturePB)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if cultureDB != nil {
+			tx.Rollback()
+			return &culture.CreateCultureResponse{
+				CultureId: fmt.Sprint(cultureDB.ID),
+			}, nil
+		}
+	}
+
+	cultureDB, err := createCulture(tx, culturePB, idempotencyKey, createReq.AllowDuplicate, payload.ID, "")
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "COMMIT")
+	}
+
+	capi.publishChange(ctx, newChangeEvent(OperationCreate, fmt.Sprint(cultureDB.ID), culturePB))
+
+	return &culture.CreateCultureResponse{
+		CultureId: fmt.Sprint(cultureDB.ID),
+		Warnings:  ruleWarnings(culturePB),
+	}, nil
+}
+
+// validateCulture checks that a culture has the required fields and measurements of its status.
+// Organisms are required once identified and susceptibility results once final.
+func validateCulture(culturePB *culture.Culture) error {
+	var err error
 	switch {
+	case culturePB == nil:
+		err = errs.NilObject("culture")
 	case strings.TrimSpace(culturePB.LabTechId) == "":
 		err = errs.MissingField("lab tech id")
 	case strings.TrimSpace(culturePB.HospitalId) == "":
@@ -103,46 +227,147 @@ func (capi *cultureAPIServer) CreateCulture(
 		err = errs.MissingField("patient age")
 	case strings.TrimSpace(culturePB.CultureSource) == "":
 		err = errs.MissingField("culture source")
-	case len(culturePB.PathogensFound) == 0:
+	case culture.CultureStatus_name[int32(culturePB.Status)] == "":
+		err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("unknown culture status %d", culturePB.Status))
+	case len(culturePB.PathogensFound) == 0 && organismsIdentified(culturePB.Status):
 		err = errs.MissingField("pathogens found")
-	case len(culturePB.AntimicrobialsUsed) == 0:
+	case len(culturePB.AntimicrobialsUsed) == 0 && isFinalStatus(culturePB.Status):
 		err = errs.MissingField("antimicrobials used")
-	case len(culturePB.CultureResults) == 0:
+	case len(culturePB.CultureResults) == 0 && isFinalStatus(culturePB.Status):
 		err = errs.MissingField("culture results")
+	default:
+		err = validateSpecimen(culturePB, culturePB.ResultsTimestampSec)
+		if err == nil {
+			err = validateResults(culturePB)
+		}
 	}
+	return err
+}
+
+// validateSpecimen validates the ward and specimen of a culture with results reported at resultsTimestampSec
+func validateSpecimen(culturePB *culture.Culture, resultsTimestampSec int64) error {
+	var err error
+	switch {
+	case culture.Department_name[int32(culturePB.Department)] == "":
+		err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("unknown department %d", culturePB.Department))
+	case culture.PatientSetting_name[int32(culturePB.PatientSetting)] == "":
+		err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("unknown patient setting %d", culturePB.PatientSetting))
+	case culture.SpecimenType_name[int32(culturePB.SpecimenType)] == "":
+		err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("unknown specimen type %d", culturePB.SpecimenType))
+	case culturePB.SpecimenCollectedTimestampSec < 0:
+		err = errs.WrapMessage(codes.InvalidArgument, "specimen collection time must not be negative")
+	case culturePB.SpecimenCollectedTimestampSec > 0 && resultsTimestampSec > 0 &&
+		culturePB.SpecimenCollectedTimestampSec > resultsTimestampSec:
+		err = errs.WrapMessage(codes.InvalidArgument, "specimen must be collected before results are reported")
+	}
+	return err
+}
+
+// prepareCulture validates a new culture and its references to catalogues, interprets its results,
+// checks them against expert rules, classifies its isolates and pseudonymizes its patient
+func (capi *cultureAPIServer) prepareCulture(culturePB *culture.Culture) error {
+	if culturePB.GetStatus() == culture.CultureStatus_AMENDED {
+		return errs.WrapMessage(codes.InvalidArgument, "new cultures cannot be amended")
+	}
+
+	if culturePB != nil && culturePB.TestMethod == culture.TestMethod_TEST_METHOD_UNSPECIFIED {
+		culturePB.TestMethod = defaultTestMethod
+	}
+
+	err := capi.checkCulture(culturePB)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	culturePB.Editors = []string{culturePB.LabTechId}
 
+	return capi.pseudonymizePatient(culturePB)
+}
+
+// checkCulture validates a culture and its references to catalogues, interprets its results,
+// checks them against expert rules and classifies its isolates
+func (capi *cultureAPIServer) checkCulture(culturePB *culture.Culture) error {
+	err := validateCulture(culturePB)
+	if err != nil {
+		return err
+	}
+
+	err = resolveReferences(capi.catalogue, culturePB)
+	if err != nil {
+		return err
+	}
+
+	// Interpret results from breakpoints
+	err = capi.interpretResults(culturePB)
+	if err != nil {
+		return err
+	}
+
+	err = capi.applyExpertRules(culturePB)
+	if err != nil {
+		return err
+	}
+
+	culturePB.IsolateClassifications = ClassifyIsolates(culturePB)
+
+	return nil
+}
+
+// createCulture saves a prepared culture with its first revision and adds its results to rollups.
+// Duplicates of existing cultures are rejected unless allowed. Cultures of the patient must be locked
+// with lockPatientCultures.
+func createCulture(
+	tx *gorm.DB, culturePB *culture.Culture, idempotencyKey string, allowDuplicate bool, actorID, reason string,
+) (*Culture, error) {
+	if !allowDuplicate {
+		err := checkDuplicateCulture(tx, culturePB)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Get culture model
 	cultureDB, err := getCultureDB(culturePB)
 	if err != nil {
 		return nil, err
 	}
+	if idempotencyKey != "" {
+		cultureDB.IdempotencyKey = &idempotencyKey
+	}
 
-	// Save to database
-	err = capi.sqlDB.Create(cultureDB).Error
-	if err != nil {
+	// Keys are unique so a key used by a concurrent create of another patient fails
+	err = tx.Create(cultureDB).Error
+	switch {
+	case err == nil:
+	case idempotencyKey != "" && strings.Contains(strings.ToLower(err.Error()), "duplicate"):
+		return nil, errs.WrapMessage(codes.AlreadyExists, "a culture with the idempotency key already exists")
+	default:
 		return nil, errs.SQLQueryFailed(err, "SAVE")
 	}
 
-	return &culture.CreateCultureResponse{
-		CultureId: fmt.Sprint(cultureDB.ID),
-	}, nil
+	err = UpdateRollups(tx, culturePB, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	err = saveRevision(tx, culture.RevisionOperation_CREATED, cultureDB.ID, nil, culturePB, actorID, reason, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return cultureDB, nil
 }
 
 func (capi *cultureAPIServer) UpdateCulture(
 	ctx context.Context, updateReq *culture.UpdateCultureRequest,
-) (*empty.Empty, error) {
+) (*culture.UpdateCultureResponse, error) {
 	// Request must not be nil
 	if updateReq == nil {
 		return nil, errs.NilObject("UpdateCultureRequest")
 	}
 
 	// Authorize request
-	_, err := capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
+	payload, err := capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
 	if err != nil {
 		return nil, err
 	}
@@ -154,48 +379,221 @@ func (capi *cultureAPIServer) UpdateCulture(
 		err = errs.NilObject("culture")
 	case updateReq.CultureId == "":
 		err = errs.MissingField("culture id")
-	case updateReq.EditorId == "":
-		err = errs.MissingField("editor id")
 	}
 	if err != nil {
 		return nil, err
 	}
 
+	// Update model and move its results in rollups in a transaction
+	tx := capi.sqlDB.Begin()
+	if tx.Error != nil {
+		return nil, errs.SQLQueryFailed(tx.Error, "BEGIN")
+	}
+
+	oldCulturePB, updatedCulturePB, err := capi.updateCulture(tx, updateReq, payload)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "COMMIT")
+	}
+
+	capi.publishChange(ctx, newChangeEvent(OperationUpdate, updateReq.CultureId, oldCulturePB, updatedCulturePB))
+
+	return &culture.UpdateCultureResponse{
+		Warnings: ruleWarnings(updatedCulturePB),
+	}, nil
+}
+
+// lockCulture reads the culture in the transaction and locks it until the transaction ends, so that
+// concurrent changes of the culture move its results in rollups one after the other
+func lockCulture(tx *gorm.DB, cultureID string) (*Culture, error) {
 	cultureDB := &Culture{}
+	err := tx.Set("gorm:query_option", "FOR UPDATE").First(cultureDB, "id=?", cultureID).Error
+	switch {
+	case err == nil:
+		return cultureDB, nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.NotFound("culture", cultureID)
+	default:
+		return nil, errs.SQLQueryFailed(err, "GET")
+	}
+}
+
+// updateCulture applies the update to the culture locked in the transaction. It returns the culture before
+// and after the update.
+func (capi *cultureAPIServer) updateCulture(
+	tx *gorm.DB, updateReq *culture.UpdateCultureRequest, payload *auth.Payload,
+) (*culture.Culture, *culture.Culture, error) {
+	culturePB := updateReq.GetCulture()
+
+	cultureDB, err := lockCulture(tx, updateReq.CultureId)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	// Check if record exist in db, we also need culture editors
-	if notFound := capi.sqlDB.Select("editors").
-		First(cultureDB, "id=?", updateReq.CultureId).
-		RecordNotFound(); notFound {
-		return nil, errs.NotFound("culture", updateReq.CultureId)
+	oldCulturePB, err := getCulturePB(cultureDB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The actor must be authorized for the current and new facility
+	err = auth.AuthorizeFacility(payload, oldCulturePB.HospitalId)
+	if err == nil && culturePB.HospitalId != "" {
+		err = auth.AuthorizeFacility(payload, culturePB.HospitalId)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = capi.prepareUpdate(oldCulturePB, culturePB, payload.ID)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Unmarshal the editors
-	if len(cultureDB.Editors) > 0 {
-		err = json.Unmarshal(cultureDB.Editors, &culturePB.Editors)
+	updatedCulturePB, err := saveUpdate(tx, cultureDB.ID, oldCulturePB, culturePB, payload.ID, updateReq.Reason)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return oldCulturePB, updatedCulturePB, nil
+}
+
+// prepareUpdate validates changes of the culture and interprets, checks and classifies changed results.
+// Fields missing in the update keep their current values.
+func (capi *cultureAPIServer) prepareUpdate(oldCulturePB, culturePB *culture.Culture, actorID string) error {
+	// Add the actor to list of editors
+	culturePB.Editors = append(append(make([]string, 0, len(oldCulturePB.Editors)+1), oldCulturePB.Editors...), actorID)
+
+	// Pseudonyms read from the culture are sent back unchanged. Other patient ids are hashed.
+	if culturePB.PatientId != "" && culturePB.PatientId != oldCulturePB.PatientId {
+		err := capi.pseudonymizePatient(culturePB)
 		if err != nil {
-			return nil, errs.FromJSONUnMarshal(err, "editors")
+			return err
 		}
-	} else {
-		culturePB.Editors = make([]string, 0)
 	}
 
-	// Add the actor to list of editors
-	culturePB.Editors = append(culturePB.Editors, updateReq.EditorId)
+	// Status changes through transitions only. Changes to final results are amendments.
+	culturePB.Status = oldCulturePB.Status
+	if isFinalStatus(culturePB.Status) {
+		culturePB.Status = culture.CultureStatus_AMENDED
+	}
 
-	cultureDB, err = getCultureDB(culturePB)
+	// Unspecified values of the test method and specimen keep the previous ones
+	if culturePB.TestMethod == culture.TestMethod_TEST_METHOD_UNSPECIFIED {
+		culturePB.TestMethod = oldCulturePB.TestMethod
+	}
+	if culturePB.TestMethod != oldCulturePB.TestMethod && len(culturePB.CultureResults) == 0 {
+		return errs.MissingField("culture results measured with the new test method")
+	}
+	if culturePB.Department == culture.Department_DEPARTMENT_UNSPECIFIED {
+		culturePB.Department = oldCulturePB.Department
+	}
+	if culturePB.PatientSetting == culture.PatientSetting_SETTING_UNSPECIFIED {
+		culturePB.PatientSetting = oldCulturePB.PatientSetting
+	}
+	if culturePB.SpecimenType == culture.SpecimenType_SPECIMEN_UNSPECIFIED {
+		culturePB.SpecimenType = oldCulturePB.SpecimenType
+	}
+	if culturePB.SpecimenCollectedTimestampSec == 0 {
+		culturePB.SpecimenCollectedTimestampSec = oldCulturePB.SpecimenCollectedTimestampSec
+	}
+
+	resultsTimestampSec := culturePB.ResultsTimestampSec
+	if resultsTimestampSec == 0 {
+		resultsTimestampSec = oldCulturePB.ResultsTimestampSec
+	}
+	err := validateSpecimen(culturePB, resultsTimestampSec)
+	if err != nil {
+		return err
+	}
+
+	// Results must stay consistent with pathogens found and antimicrobials used of the culture
+	if len(culturePB.PathogensFound) > 0 || len(culturePB.AntimicrobialsUsed) > 0 || len(culturePB.CultureResults) > 0 {
+		mergedPB := proto.Clone(oldCulturePB).(*culture.Culture)
+		if len(culturePB.PathogensFound) > 0 {
+			mergedPB.PathogensFound = culturePB.PathogensFound
+		}
+		if len(culturePB.AntimicrobialsUsed) > 0 {
+			mergedPB.AntimicrobialsUsed = culturePB.AntimicrobialsUsed
+		}
+		if len(culturePB.CultureResults) > 0 {
+			mergedPB.CultureResults = culturePB.CultureResults
+		}
+		err = resolveReferences(capi.catalogue, mergedPB)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Results are interpreted and isolates classified again when results change
+	if len(culturePB.CultureResults) > 0 {
+		err = validateResults(culturePB)
+		if err != nil {
+			return err
+		}
+		err = capi.interpretResults(culturePB)
+		if err != nil {
+			return err
+		}
+		err = capi.applyExpertRules(culturePB)
+		if err != nil {
+			return err
+		}
+		culturePB.IsolateClassifications = ClassifyIsolates(culturePB)
+	}
+
+	return nil
+}
+
+// saveUpdate saves changes of the culture in the transaction, moves its results in rollups and records
+// the revision. It returns the culture after the update.
+func saveUpdate(
+	tx *gorm.DB, cultureID uint, oldCulturePB, culturePB *culture.Culture, actorID, reason string,
+) (*culture.Culture, error) {
+	cultureDB, err := getCultureDB(culturePB)
 	if err != nil {
 		return nil, err
 	}
 
-	// Update model in database
-	err = capi.sqlDB.Table(culturesTable).Where("id=?", updateReq.CultureId).
-		Updates(cultureDB).Error
+	err = tx.Table(culturesTable).Where("id=?", cultureID).Updates(cultureDB).Error
 	if err != nil {
 		return nil, errs.SQLQueryFailed(err, "UPDATE")
 	}
 
-	return &empty.Empty{}, nil
+	// Fields missing in the update are unchanged
+	updatedCultureDB := &Culture{}
+	err = tx.First(updatedCultureDB, "id=?", cultureID).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	updatedCulturePB, err := getCulturePB(updatedCultureDB)
+	if err != nil {
+		return nil, err
+	}
+
+	err = UpdateRollups(tx, oldCulturePB, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	err = UpdateRollups(tx, updatedCulturePB, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	err = saveRevision(
+		tx, culture.RevisionOperation_UPDATED, cultureID, oldCulturePB, updatedCulturePB, actorID, reason, 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedCulturePB, nil
 }
 
 func (capi *cultureAPIServer) DeleteCulture(
@@ -207,7 +605,7 @@ func (capi *cultureAPIServer) DeleteCulture(
 	}
 
 	// Authorize request
-	_, err := capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
+	payload, err := capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
 	if err != nil {
 		return nil, err
 	}
@@ -217,80 +615,179 @@ func (capi *cultureAPIServer) DeleteCulture(
 		return nil, errs.MissingField("culture id")
 	}
 
-	// Delete in database
-	err = capi.sqlDB.Delete(&Culture{}, "id=?", delReq.CultureId).Error
+	// Delete culture and remove its results from rollups in a transaction
+	tx := capi.sqlDB.Begin()
+	if tx.Error != nil {
+		return nil, errs.SQLQueryFailed(tx.Error, "BEGIN")
+	}
+
+	// Get the culture being deleted so that subscribers know what changed
+	cultureDB, err := lockCulture(tx, delReq.CultureId)
+	switch {
+	case err == nil:
+	case status.Code(err) == codes.NotFound:
+		tx.Rollback()
+		return &empty.Empty{}, nil
+	default:
+		tx.Rollback()
+		return nil, err
+	}
+
+	oldCulturePB, err := getCulturePB(cultureDB)
 	if err != nil {
-		return nil, errs.SQLQueryFailed(err, "DELETE")
+		tx.Rollback()
+		return nil, err
 	}
 
-	return &empty.Empty{}, nil
-}
+	err = auth.AuthorizeFacility(payload, oldCulturePB.HospitalId)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
 
-func (capi *cultureAPIServer) ListCultures(
-	ctx context.Context, listReq *culture.ListCulturesRequest,
-) (*culture.Cultures, error) {
-	// Request must not be nil
-	if listReq == nil {
-		return nil, errs.NilObject("ListCulturesRequest")
+	err = tx.Delete(&Culture{}, "id=?", delReq.CultureId).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, errs.SQLQueryFailed(err, "DELETE")
 	}
 
-	// Authenticate request
-	err := capi.authAPI.AuthenticateRequest(ctx)
+	err = UpdateRollups(tx, oldCulturePB, -1)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = saveRevision(
+		tx, culture.RevisionOperation_DELETED, cultureDB.ID, oldCulturePB, nil,
+		payload.ID, delReq.Reason, 0,
+	)
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
 
-	db := capi.sqlDB
-	if listReq.Filter != nil {
+	err = tx.Commit().Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "COMMIT")
+	}
+
+	capi.publishChange(ctx, newChangeEvent(OperationDelete, delReq.CultureId, oldCulturePB))
+
+	return &empty.Empty{}, nil
+}
+
+// filterCultures selects cultures matching the filter
+func filterCultures(db *gorm.DB, filter *culture.ListCultureFilter) *gorm.DB {
+	if filter != nil {
 		// Target filter
-		switch listReq.Filter.GetListTarget() {
+		switch filter.GetListTarget() {
 		case culture.ListTarget_ALL:
 		case culture.ListTarget_COUNTY:
-			if len(listReq.Filter.GetTargetIds()) > 0 {
-				db = db.Where("county_code IN (?)", listReq.Filter.GetTargetIds())
+			if len(filter.GetTargetIds()) > 0 {
+				db = db.Where("county_code IN (?)", filter.GetTargetIds())
 			}
 		case culture.ListTarget_SUB_COUNTY:
-			if len(listReq.Filter.GetTargetIds()) > 0 {
-				db = db.Where("sub_county_code IN (?)", listReq.Filter.GetTargetIds())
+			if len(filter.GetTargetIds()) > 0 {
+				db = db.Where("sub_county_code IN (?)", filter.GetTargetIds())
 			}
 		case culture.ListTarget_HOSPITAL:
-			if len(listReq.Filter.GetTargetIds()) > 0 {
-				db = db.Where("hospital_id IN (?)", listReq.Filter.GetTargetIds())
+			if len(filter.GetTargetIds()) > 0 {
+				db = db.Where("hospital_id IN (?)", filter.GetTargetIds())
 			}
 		case culture.ListTarget_PATIENT:
-			if len(listReq.Filter.GetTargetIds()) > 0 {
-				db = db.Where("patient_id IN (?)", listReq.Filter.GetTargetIds())
+			if len(filter.GetTargetIds()) > 0 {
+				db = db.Where("patient_id IN (?)", filter.GetTargetIds())
 			}
 		case culture.ListTarget_LAB_TECHNICIAN:
-			if len(listReq.Filter.GetTargetIds()) > 0 {
-				db = db.Where("lab_tech_id IN (?)", listReq.Filter.GetTargetIds())
+			if len(filter.GetTargetIds()) > 0 {
+				db = db.Where("lab_tech_id IN (?)", filter.GetTargetIds())
+			}
+		}
+
+		// Status filter
+		if len(filter.GetStatuses()) > 0 {
+			statuses := make([]string, 0, len(filter.GetStatuses()))
+			for _, status := range filter.GetStatuses() {
+				statuses = append(statuses, status.String())
 			}
+			db = db.Where("status IN (?)", statuses)
 		}
 
 		// Date filter
-		if listReq.Filter.GetDateFilter() != nil && listReq.Filter.GetDateFilter().GetFilter() {
-			startTimestamp := listReq.Filter.DateFilter.GetStartTimestampSec()
-			endTimestamp := listReq.Filter.DateFilter.GetEndTimestampSec()
+		if filter.GetDateFilter() != nil && filter.GetDateFilter().GetFilter() {
+			startTimestamp := filter.DateFilter.GetStartTimestampSec()
+			endTimestamp := filter.DateFilter.GetEndTimestampSec()
 			switch {
 			case startTimestamp < endTimestamp:
 				db = db.Where("results_timestamp_sec BETWEEN ? AND ?", startTimestamp, endTimestamp)
 			case startTimestamp > endTimestamp:
 				db = db.Where("results_timestamp_sec > ?", startTimestamp)
+			case startTimestamp > 0 && startTimestamp == endTimestamp:
+				db = db.Where("results_timestamp_sec = ?", startTimestamp)
 			}
 		}
 	}
 
-	// Normalize page
-	pageNumber, pageSize := modules.NormalizePage(listReq.PageToken, listReq.PageSize)
-	offset := pageNumber*pageSize - pageSize
+	return db
+}
+
+// defaultMaxPageSize is the largest page of listed cultures unless configured
+const defaultMaxPageSize = 20
+
+// cultureKeysets are orders of listed cultures
+var cultureKeysets = map[culture.CultureSort]modules.Keyset{
+	culture.CultureSort_RESULTS_NEWEST_FIRST: {Column: "results_timestamp_sec", Descending: true},
+	culture.CultureSort_RESULTS_OLDEST_FIRST: {Column: "results_timestamp_sec"},
+}
+
+func (capi *cultureAPIServer) ListCultures(
+	ctx context.Context, listReq *culture.ListCulturesRequest,
+) (*culture.Cultures, error) {
+	// Request must not be nil
+	if listReq == nil {
+		return nil, errs.NilObject("ListCulturesRequest")
+	}
+
+	// Authenticate request
+	err := capi.authAPI.AuthenticateRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	if _, ok := cultureKeysets[listReq.Sort]; !ok {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "unknown sort "+listReq.Sort.String())
+	}
+
+	db := filterCultures(capi.sqlDB, listReq.Filter)
+
+	// Count matches before paging
+	var totalCount int64
+	if listReq.IncludeTotal {
+		err = db.Model(&Culture{}).Count(&totalCount).Error
+		if err != nil {
+			return nil, errs.SQLQueryFailed(err, "COUNT")
+		}
+	}
+
+	page, err := modules.NewPage(cultureKeysets[listReq.Sort], listReq.PageToken, listReq.PageSize, capi.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
 
-	culturesDB := make([]*Culture, 0, pageSize)
-	err = db.Order("created_at DESC").Offset(offset).Limit(pageSize).
-		Find(&culturesDB).Error
+	culturesDB := make([]*Culture, 0, page.Size+1)
+	err = page.Apply(db).Find(&culturesDB).Error
 	if err != nil {
 		return nil, errs.SQLQueryFailed(err, "LIST")
 	}
 
+	var nextPageToken string
+	if page.HasNext(len(culturesDB)) {
+		culturesDB = culturesDB[:page.Size]
+		last := culturesDB[page.Size-1]
+		nextPageToken = page.NextToken(last.ResultsTimestampSec, last.ID)
+	}
+
 	culturesPB := make([]*culture.Culture, 0, len(culturesDB))
 
 	for _, cultureDB := range culturesDB {
@@ -302,7 +799,9 @@ func (capi *cultureAPIServer) ListCultures(
 	}
 
 	return &culture.Cultures{
-		Cultures: culturesPB,
+		Cultures:      culturesPB,
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
 	}, nil
 }
 
@@ -64,6 +64,11 @@ func LabTechID() string {
 	return fmt.Sprintf("labtech-%d", rand.Intn(5)+1)
 }
 
+// Ward returns a random ward name
+func Ward() string {
+	return fmt.Sprintf("Ward %d", rand.Intn(10)+1)
+}
+
 // PatientGender returns a random gender either male or female
 func PatientGender() string {
 	if rand.Intn(50)%2 == 0 {
@@ -82,6 +87,15 @@ func Antimicrobial() string {
 	return Antimicrobials[rand.Intn(len(Antimicrobials))]
 }
 
+// pick returns n distinct random values. Results of a culture are of distinct pathogens and antimicrobials.
+func pick(values []string, n int) []string {
+	picked := make([]string, 0, n)
+	for _, index := range rand.Perm(len(values))[:n] {
+		picked = append(picked, values[index])
+	}
+	return picked
+}
+
 // Sample returns a random culture source
 func Sample() string {
 	return Samples[rand.Intn(len(Samples))]
@@ -123,24 +137,26 @@ func updateLabTestResults(culturePB *culture.Culture) *culture.Culture {
 func FakeCulture() *culture.Culture {
 	labTechID := LabTechID()
 	return updateLabTestResults(&culture.Culture{
-		CultureId:     randomdata.RandStringRunes(20),
-		LabTechId:     labTechID,
-		HospitalId:    HospitalID(),
-		CountyCode:    CountyCode(),
-		SubCountyCode: SubCountyCode(),
-		PatientId:     PatientID(),
-		PatientGender: PatientGender(),
-		PatientAge:    int32(randomdata.Number(1, 100)),
-		Editors:       []string{labTechID},
-		TestMethod:    culture.TestMethod_DISK_DIFFUSION,
-		CultureSource: Sample(),
-		PathogensFound: []string{
-			Pathogen(), Pathogen(), Pathogen(),
-		},
-		AntimicrobialsUsed: []string{
-			Antimicrobial(), Antimicrobial(), Antimicrobial(),
-		},
+		CultureId:           randomdata.RandStringRunes(20),
+		LabTechId:           labTechID,
+		HospitalId:          HospitalID(),
+		CountyCode:          CountyCode(),
+		SubCountyCode:       SubCountyCode(),
+		PatientId:           PatientID(),
+		PatientGender:       PatientGender(),
+		PatientAge:          int32(randomdata.Number(1, 100)),
+		Editors:             []string{labTechID},
+		TestMethod:          culture.TestMethod_DISK_DIFFUSION,
+		CultureSource:       Sample(),
+		PathogensFound:      pick(Pathogens, 3),
+		AntimicrobialsUsed:  pick(Antimicrobials, 3),
 		CultureResults:      []*culture.LabTestResult{},
 		ResultsTimestampSec: time.Now().Unix(),
+		Ward:                Ward(),
+		Department:          culture.Department(rand.Intn(len(culture.Department_name))),
+		PatientSetting:      culture.PatientSetting(rand.Intn(len(culture.PatientSetting_name))),
+		SpecimenType:        culture.SpecimenType(rand.Intn(len(culture.SpecimenType_name))),
+
+		SpecimenCollectedTimestampSec: time.Now().Add(-48 * time.Hour).Unix(),
 	})
 }
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"github.com/Pallinder/go-randomdata"
 	"github.com/gidyon/antibug/internal/mocks"
+	"github.com/gidyon/antibug/internal/pkg/breakpoints"
+	"github.com/gidyon/antibug/internal/pkg/expertrules"
 	"github.com/gidyon/antibug/pkg/api/culture"
 	"github.com/gidyon/micros"
+	"github.com/go-redis/redis"
 
 	// Imports mysql driver
 	_ "github.com/go-sql-driver/mysql"
@@ -28,8 +31,13 @@ var (
 )
 
 const (
-	dbName    = "antibug"
-	dbAddress = "localhost:3306"
+	dbName       = "antibug"
+	dbAddress    = "localhost:3306"
+	redisAddress = "localhost:6379"
+
+	breakpointsDir     = "../../../configs/breakpoints"
+	defaultBreakpoints = "CLSI M100-ED30"
+	expertRulesFile    = "../../../configs/expertrules/expert-rules.json"
 )
 
 func initDB() (*gorm.DB, error) {
@@ -46,10 +54,29 @@ var _ = BeforeSuite(func() {
 	db, err := initDB()
 	Expect(err).ShouldNot(HaveOccurred())
 
+	redisDB := redis.NewClient(&redis.Options{
+		Addr: redisAddress,
+	})
+
+	interpreter, err := breakpoints.LoadDir(breakpointsDir, defaultBreakpoints)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	codeTables, err := LoadHL7CodeTables(hl7CodeTablesFile)
+	Expect(err).ShouldNot(HaveOccurred())
+	catalogue := newFakeCatalogue(codeTables)
+
+	expertRules, err := expertrules.Load(expertRulesFile)
+	Expect(err).ShouldNot(HaveOccurred())
+
 	opt := &Options{
-		SQLDB:      db,
-		Logger:     micros.NewLogger("culture_app"),
-		SigningKey: randomdata.RandStringRunes(32),
+		SQLDB:        db,
+		RedisDB:      redisDB,
+		Logger:       micros.NewLogger("culture_app"),
+		SigningKey:   randomdata.RandStringRunes(32),
+		Breakpoints:  interpreter,
+		Catalogue:    catalogue,
+		ExpertRules:  expertRules,
+		PseudonymKey: randomdata.RandStringRunes(32),
 	}
 
 	CultureAPI, err = NewCultureAPI(ctx, opt)
@@ -69,6 +96,11 @@ var _ = BeforeSuite(func() {
 	Expect(err).Should(HaveOccurred())
 
 	opt.SQLDB = db
+	opt.RedisDB = nil
+	_, err = NewCultureAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.RedisDB = redisDB
 	opt.Logger = nil
 	_, err = NewCultureAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
@@ -77,6 +109,21 @@ var _ = BeforeSuite(func() {
 	opt.SigningKey = ""
 	_, err = NewCultureAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
+
+	opt.SigningKey = randomdata.RandStringRunes(32)
+	opt.Breakpoints = nil
+	_, err = NewCultureAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.Breakpoints = interpreter
+	opt.Catalogue = nil
+	_, err = NewCultureAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.Catalogue = catalogue
+	opt.PseudonymKey = ""
+	_, err = NewCultureAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
 })
 
 var _ = AfterSuite(func() {
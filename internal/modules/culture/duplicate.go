@@ -0,0 +1,156 @@
+package culture
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"sort"
+	"strings"
+)
+
+const (
+	// Cultures of a specimen with results reported within this window of each other are duplicates
+	duplicateWindowSec   = 24 * 60 * 60
+	maxIdempotencyKeyLen = 100
+	cultureLocksTable    = "culture_locks"
+)
+
+// CultureLock is a row of a patient at a facility that transactions creating their cultures lock until they end,
+// so that duplicates and retries of concurrent creates are found
+type CultureLock struct {
+	PatientID  string `gorm:"primary_key;type:varchar(50)"`
+	HospitalID string `gorm:"primary_key;type:varchar(50)"`
+}
+
+// TableName ...
+func (*CultureLock) TableName() string {
+	return cultureLocksTable
+}
+
+// lockPatientCultures locks creating cultures of the patients at their facilities until the transaction ends.
+// It must be called before reading cultures in the transaction. Locks are taken in order so that transactions
+// creating cultures of the same patients do not deadlock.
+func lockPatientCultures(tx *gorm.DB, culturesPB ...*culture.Culture) error {
+	locks := make([]*CultureLock, 0, len(culturesPB))
+	for _, culturePB := range culturesPB {
+		locks = append(locks, &CultureLock{PatientID: culturePB.PatientId, HospitalID: culturePB.HospitalId})
+	}
+	sort.Slice(locks, func(i, j int) bool {
+		if locks[i].PatientID != locks[j].PatientID {
+			return locks[i].PatientID < locks[j].PatientID
+		}
+		return locks[i].HospitalID < locks[j].HospitalID
+	})
+
+	for index, lock := range locks {
+		if index > 0 && *lock == *locks[index-1] {
+			continue
+		}
+		err := tx.Exec(
+			"INSERT INTO "+cultureLocksTable+" (patient_id, hospital_id) VALUES (?, ?) "+
+				"ON DUPLICATE KEY UPDATE patient_id=patient_id",
+			lock.PatientID, lock.HospitalID,
+		).Error
+		if err != nil {
+			return errs.SQLQueryFailed(err, "LOCK")
+		}
+	}
+
+	return nil
+}
+
+// pathogenSet returns sorted unique pathogens of a culture
+func pathogenSet(pathogens []string) []string {
+	set := make([]string, 0, len(pathogens))
+	seen := make(map[string]bool, len(pathogens))
+	for _, pathogen := range pathogens {
+		pathogen = strings.TrimSpace(pathogen)
+		if !seen[pathogen] {
+			seen[pathogen] = true
+			set = append(set, pathogen)
+		}
+	}
+	sort.Strings(set)
+	return set
+}
+
+func samePathogens(a, b []string) bool {
+	setA, setB := pathogenSet(a), pathogenSet(b)
+	if len(setA) != len(setB) {
+		return false
+	}
+	for index := range setA {
+		if setA[index] != setB[index] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkDuplicateCulture fails with AlreadyExists when a culture of the same patient, facility and culture source
+// with the same pathogens has results reported within the duplicate window
+func checkDuplicateCulture(db *gorm.DB, culturePB *culture.Culture) error {
+	candidates := make([]*Culture, 0)
+	err := db.Select("id, pathogens_found").
+		Where("patient_id=? AND hospital_id=? AND culture_source=?",
+			culturePB.PatientId, culturePB.HospitalId, culturePB.CultureSource).
+		Where("results_timestamp_sec BETWEEN ? AND ?",
+			culturePB.ResultsTimestampSec-duplicateWindowSec, culturePB.ResultsTimestampSec+duplicateWindowSec).
+		Find(&candidates).Error
+	if err != nil {
+		return errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	for _, candidate := range candidates {
+		pathogens := make([]string, 0)
+		if len(candidate.PathogensFound) > 0 {
+			err = json.Unmarshal(candidate.PathogensFound, &pathogens)
+			if err != nil {
+				return errs.FromJSONUnMarshal(err, "pathogens found")
+			}
+		}
+		if samePathogens(pathogens, culturePB.PathogensFound) {
+			return errs.WrapMessage(codes.AlreadyExists, fmt.Sprintf(
+				"culture %d of the same specimen already exists", candidate.ID,
+			))
+		}
+	}
+
+	return nil
+}
+
+// scopeIdempotencyKey scopes the idempotency key of a request to the facility of the culture,
+// so that requests of other facilities never find its culture
+func scopeIdempotencyKey(hospitalID, idempotencyKey string) string {
+	return fmt.Sprintf("key:%x", sha256.Sum256([]byte(hospitalID+"|"+idempotencyKey)))
+}
+
+// getIdempotentCulture returns the culture created with the idempotency key or nil when there is none.
+// It fails with AlreadyExists when the culture is of another patient, facility or specimen than the culture
+// of the request. Deleted cultures keep their key.
+func getIdempotentCulture(db *gorm.DB, idempotencyKey string, culturePB *culture.Culture) (*Culture, error) {
+	cultureDB := &Culture{}
+	err := db.Unscoped().Select("id, patient_id, hospital_id, culture_source").
+		First(cultureDB, "idempotency_key=?", idempotencyKey).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil
+	default:
+		return nil, errs.SQLQueryFailed(err, "GET")
+	}
+
+	if cultureDB.PatientID != culturePB.PatientId || cultureDB.HospitalID != culturePB.HospitalId ||
+		cultureDB.CultureSource != culturePB.CultureSource {
+		return nil, errs.WrapMessage(
+			codes.AlreadyExists, "idempotency key was used for a culture of another patient or specimen",
+		)
+	}
+
+	return cultureDB, nil
+}
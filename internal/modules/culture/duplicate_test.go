@@ -0,0 +1,181 @@
+package culture
+
+import (
+	"context"
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"sync"
+)
+
+var _ = Describe("Detecting duplicate cultures #duplicate", func() {
+	var (
+		culturePB *culture.Culture
+		cultureID string
+		ctx       context.Context
+	)
+
+	// createCopy creates a copy of the culture. Creating a culture changes its patient id to a pseudonym.
+	createCopy := func(createReq *culture.CreateCultureRequest) (*culture.CreateCultureResponse, error) {
+		createReq.Culture = proto.Clone(culturePB).(*culture.Culture)
+		return CultureAPI.CreateCulture(ctx, createReq)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		culturePB = FakeCulture()
+		culturePB.PatientId = "dup-" + randomdata.RandStringRunes(10)
+		createRes, err := createCopy(&culture.CreateCultureRequest{})
+		Expect(err).ShouldNot(HaveOccurred())
+		cultureID = createRes.CultureId
+	})
+
+	It("should compare pathogens as sets", func() {
+		Expect(samePathogens([]string{"b", "a", "a"}, []string{"a", "b"})).Should(BeTrue())
+		Expect(samePathogens([]string{"a", "b"}, []string{"a", "c"})).Should(BeFalse())
+		Expect(samePathogens([]string{"a"}, []string{"a", "b"})).Should(BeFalse())
+	})
+
+	Describe("Creating a culture of the same specimen", func() {
+		It("should fail with the id of the existing culture", func() {
+			createRes, err := createCopy(&culture.CreateCultureRequest{})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			Expect(status.Convert(err).Message()).Should(ContainSubstring(cultureID))
+			Expect(createRes).To(BeNil())
+		})
+		It("should fail when results are reported within the window", func() {
+			culturePB.ResultsTimestampSec += duplicateWindowSec / 2
+			_, err := createCopy(&culture.CreateCultureRequest{})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+		})
+		It("should succeed when duplicates are allowed", func() {
+			createRes, err := createCopy(&culture.CreateCultureRequest{AllowDuplicate: true})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(createRes.CultureId).ShouldNot(Equal(cultureID))
+		})
+	})
+
+	Describe("Creating a culture of another specimen", func() {
+		It("should succeed when culture source differs", func() {
+			culturePB.CultureSource = "csf"
+			_, err := createCopy(&culture.CreateCultureRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+		It("should succeed when pathogens differ", func() {
+			for _, pathogen := range Pathogens {
+				if !samePathogens(culturePB.PathogensFound, append([]string{pathogen}, culturePB.PathogensFound...)) {
+					culturePB.PathogensFound = append(culturePB.PathogensFound, pathogen)
+					break
+				}
+			}
+			_, err := createCopy(&culture.CreateCultureRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+		It("should succeed when results are reported outside the window", func() {
+			culturePB.ResultsTimestampSec += 2 * duplicateWindowSec
+			_, err := createCopy(&culture.CreateCultureRequest{})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+
+	Describe("Creating cultures of the same specimen concurrently", func() {
+		// createConcurrently creates copies of the culture at the same time and returns their ids and errors
+		createConcurrently := func(createReq *culture.CreateCultureRequest) ([]string, []error) {
+			var (
+				wg         sync.WaitGroup
+				mu         sync.Mutex
+				cultureIDs = make([]string, 0)
+				createErrs = make([]error, 0)
+			)
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					createRes, err := createCopy(proto.Clone(createReq).(*culture.CreateCultureRequest))
+					mu.Lock()
+					defer mu.Unlock()
+					if err != nil {
+						createErrs = append(createErrs, err)
+						return
+					}
+					cultureIDs = append(cultureIDs, createRes.CultureId)
+				}()
+			}
+			wg.Wait()
+			return cultureIDs, createErrs
+		}
+
+		It("should create one culture", func() {
+			culturePB.ResultsTimestampSec += 2 * duplicateWindowSec
+			cultureIDs, createErrs := createConcurrently(&culture.CreateCultureRequest{})
+			Expect(cultureIDs).Should(HaveLen(1))
+			for _, err := range createErrs {
+				Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			}
+		})
+
+		It("should return the same culture to retries with an idempotency key", func() {
+			culturePB.ResultsTimestampSec += 2 * duplicateWindowSec
+			cultureIDs, createErrs := createConcurrently(&culture.CreateCultureRequest{
+				IdempotencyKey: randomdata.RandStringRunes(32),
+			})
+			Expect(createErrs).Should(BeEmpty())
+			Expect(cultureIDs).Should(HaveLen(5))
+			for _, id := range cultureIDs {
+				Expect(id).Should(Equal(cultureIDs[0]))
+			}
+		})
+	})
+
+	Describe("Retrying requests with an idempotency key", func() {
+		It("should fail when the key is too long", func() {
+			createRes, err := createCopy(&culture.CreateCultureRequest{
+				IdempotencyKey: strings.Repeat("k", maxIdempotencyKeyLen+1),
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(createRes).To(BeNil())
+		})
+		It("should return the culture of the first request", func() {
+			idempotencyKey := randomdata.RandStringRunes(32)
+			culturePB.CultureSource = "csf"
+
+			createRes, err := createCopy(&culture.CreateCultureRequest{IdempotencyKey: idempotencyKey})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			retryRes, err := createCopy(&culture.CreateCultureRequest{IdempotencyKey: idempotencyKey})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(retryRes.CultureId).Should(Equal(createRes.CultureId))
+		})
+		It("should fail when the key was used for another specimen", func() {
+			idempotencyKey := randomdata.RandStringRunes(32)
+			culturePB.CultureSource = "csf"
+
+			_, err := createCopy(&culture.CreateCultureRequest{IdempotencyKey: idempotencyKey})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			culturePB.CultureSource = "sputum"
+			createRes, err := createCopy(&culture.CreateCultureRequest{IdempotencyKey: idempotencyKey})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			Expect(createRes).To(BeNil())
+		})
+		It("should not find cultures of other facilities by their key", func() {
+			idempotencyKey := randomdata.RandStringRunes(32)
+			culturePB.CultureSource = "csf"
+
+			createRes, err := createCopy(&culture.CreateCultureRequest{IdempotencyKey: idempotencyKey})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			culturePB.HospitalId = "other-" + randomdata.RandStringRunes(10)
+			otherRes, err := createCopy(&culture.CreateCultureRequest{IdempotencyKey: idempotencyKey})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(otherRes.CultureId).ShouldNot(Equal(createRes.CultureId))
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package culture
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"time"
+)
+
+// ChangesChannel is the redis channel where changes to cultures are published
+const ChangesChannel = "antibug:cultures:changes"
+
+// Operations on cultures
+const (
+	OperationCreate = "CREATE"
+	OperationUpdate = "UPDATE"
+	OperationDelete = "DELETE"
+)
+
+// ChangeEvent describes a culture that was created, updated or deleted.
+// For updates, it holds values before and after the change.
+type ChangeEvent struct {
+	Operation      string   `json:"operation"`
+	CultureID      string   `json:"culture_id"`
+	CountyCodes    []string `json:"county_codes"`
+	SubCountyCodes []string `json:"sub_county_codes"`
+	HospitalIDs    []string `json:"hospital_ids"`
+	Pathogens      []string `json:"pathogens"`
+	Antimicrobials []string `json:"antimicrobials"`
+	TimestampSec   int64    `json:"timestamp_sec"`
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func newChangeEvent(operation, cultureID string, culturesPB ...*culture.Culture) *ChangeEvent {
+	event := &ChangeEvent{
+		Operation:      operation,
+		CultureID:      cultureID,
+		CountyCodes:    make([]string, 0, len(culturesPB)),
+		SubCountyCodes: make([]string, 0, len(culturesPB)),
+		HospitalIDs:    make([]string, 0, len(culturesPB)),
+		Pathogens:      make([]string, 0),
+		Antimicrobials: make([]string, 0),
+		TimestampSec:   time.Now().Unix(),
+	}
+	for _, culturePB := range culturesPB {
+		if culturePB == nil {
+			continue
+		}
+		event.CountyCodes = appendUnique(event.CountyCodes, culturePB.CountyCode)
+		event.SubCountyCodes = appendUnique(event.SubCountyCodes, culturePB.SubCountyCode)
+		event.HospitalIDs = appendUnique(event.HospitalIDs, culturePB.HospitalId)
+		for _, pathogen := range culturePB.PathogensFound {
+			event.Pathogens = appendUnique(event.Pathogens, pathogen)
+		}
+		for _, antimicrobial := range culturePB.AntimicrobialsUsed {
+			event.Antimicrobials = appendUnique(event.Antimicrobials, antimicrobial)
+		}
+	}
+	return event
+}
+
+// publishChange notifies subscribers such as the antibiogram service about a change.
+// The change is already saved, so failures are logged rather than returned.
+func (capi *cultureAPIServer) publishChange(ctx context.Context, event *ChangeEvent) {
+	bs, err := json.Marshal(event)
+	if err != nil {
+		capi.logger.Errorf("failed to json marshal culture change event: %v", err)
+		return
+	}
+
+	err = capi.redisClient.Publish(ctx, ChangesChannel, bs).Err()
+	if err != nil {
+		capi.logger.Errorf("failed to publish culture change event: %v", err)
+	}
+}
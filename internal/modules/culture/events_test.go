@@ -0,0 +1,33 @@
+package culture
+
+import (
+	"github.com/gidyon/antibug/pkg/api/culture"
+)
+
+var _ = Describe("Culture change events #events", func() {
+	It("should merge values of cultures before and after a change", func() {
+		oldCulture := &culture.Culture{
+			HospitalId:         "hospital",
+			CountyCode:         "1",
+			SubCountyCode:      "10",
+			PathogensFound:     []string{"ecoli", "kleb"},
+			AntimicrobialsUsed: []string{"amoxicillin"},
+		}
+		newCulture := &culture.Culture{
+			HospitalId:         "hospital",
+			CountyCode:         "2",
+			SubCountyCode:      "20",
+			PathogensFound:     []string{"ecoli"},
+			AntimicrobialsUsed: []string{"ceftriaxone"},
+		}
+
+		event := newChangeEvent(OperationUpdate, "1", oldCulture, newCulture)
+		Expect(event.Operation).Should(Equal(OperationUpdate))
+		Expect(event.CultureID).Should(Equal("1"))
+		Expect(event.HospitalIDs).Should(ConsistOf("hospital"))
+		Expect(event.CountyCodes).Should(ConsistOf("1", "2"))
+		Expect(event.SubCountyCodes).Should(ConsistOf("10", "20"))
+		Expect(event.Pathogens).Should(ConsistOf("ecoli", "kleb"))
+		Expect(event.Antimicrobials).Should(ConsistOf("amoxicillin", "ceftriaxone"))
+	})
+})
@@ -0,0 +1,69 @@
+package culture
+
+import (
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/internal/pkg/expertrules"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"strings"
+)
+
+// applyExpertRules checks results against expert rules after they are interpreted. Results violating
+// warning rules are annotated and given the expected label of rules inferring labels. Results violating
+// blocking rules are rejected.
+func (capi *cultureAPIServer) applyExpertRules(culturePB *culture.Culture) error {
+	for _, cultureResult := range culturePB.GetCultureResults() {
+		cultureResult.RuleAnnotations = nil
+	}
+
+	if capi.expertRules == nil {
+		return nil
+	}
+
+	violations := make([]string, 0)
+
+	for _, finding := range capi.expertRules.Check(culturePB.GetCultureResults()) {
+		cultureResult := finding.Result
+		message := fmt.Sprintf(
+			"%s %s to %s: %s",
+			cultureResult.PathogenName, cultureResult.Label, cultureResult.AntimicrobialName, finding.Rule.Description,
+		)
+
+		if finding.Rule.Severity == expertrules.SeverityBlock {
+			violations = append(violations, finding.Rule.ID+" "+message)
+			continue
+		}
+
+		annotation := &culture.RuleAnnotation{
+			RuleId:  finding.Rule.ID,
+			RuleSet: capi.expertRules.Name(),
+			Message: message,
+		}
+
+		if finding.Rule.Infer {
+			cultureResult.Label = finding.Rule.Label()
+			cultureResult.LabelDisagreement = cultureResult.ReportedLabel != cultureResult.Label
+			annotation.Inferred = true
+		}
+
+		cultureResult.RuleAnnotations = append(cultureResult.RuleAnnotations, annotation)
+	}
+
+	if len(violations) > 0 {
+		return errs.WrapMessage(
+			codes.InvalidArgument, "results violate expert rules: "+strings.Join(violations, "; "),
+		)
+	}
+
+	return nil
+}
+
+// ruleWarnings returns annotations of results of the culture
+func ruleWarnings(culturePB *culture.Culture) []*culture.RuleAnnotation {
+	warnings := make([]*culture.RuleAnnotation, 0)
+	for _, cultureResult := range culturePB.GetCultureResults() {
+		warnings = append(warnings, cultureResult.RuleAnnotations...)
+	}
+	return warnings
+}
@@ -0,0 +1,85 @@
+package culture
+
+import (
+	"context"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Checking results against expert rules #expert", func() {
+	var (
+		culturePB *culture.Culture
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		culturePB = FakeCulture()
+		culturePB.PathogensFound = []string{"sau"}
+		culturePB.AntimicrobialsUsed = []string{"FOX", "AMP"}
+		culturePB.CultureResults = []*culture.LabTestResult{
+			{PathogenId: "sau", AntimicrobialId: "FOX", DiskDiameter: "18 mm", Label: culture.Label_RESISTANT},
+			{PathogenId: "sau", AntimicrobialId: "AMP", DiskDiameter: "30 mm", Label: culture.Label_SUSCEPTIBLE},
+		}
+		ctx = context.Background()
+	})
+
+	It("should infer labels of warning rules and annotate the results", func() {
+		createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(createRes.Warnings).Should(HaveLen(1))
+		Expect(createRes.Warnings[0].RuleId).Should(Equal("ER-MRSA-BLA"))
+		Expect(createRes.Warnings[0].RuleSet).Should(Equal("Antibug Expert Rules 2020.1"))
+		Expect(createRes.Warnings[0].Inferred).Should(BeTrue())
+
+		getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: createRes.CultureId})
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, cultureResult := range getRes.CultureResults {
+			if cultureResult.AntimicrobialId != "AMP" {
+				Expect(cultureResult.RuleAnnotations).Should(BeEmpty())
+				continue
+			}
+			Expect(cultureResult.Label).Should(Equal(culture.Label_RESISTANT))
+			Expect(cultureResult.ReportedLabel).Should(Equal(culture.Label_SUSCEPTIBLE))
+			Expect(cultureResult.LabelDisagreement).Should(BeTrue())
+			Expect(cultureResult.RuleAnnotations).Should(HaveLen(1))
+		}
+	})
+
+	It("should infer resistance of ESBL producers", func() {
+		culturePB = &culture.Culture{
+			CultureResults: []*culture.LabTestResult{
+				{PathogenName: "Escherichia coli", AntimicrobialName: "ESBL", Label: culture.Label_RESISTANT},
+				{PathogenName: "Escherichia coli", AntimicrobialName: "Ceftriaxone", Label: culture.Label_SUSCEPTIBLE},
+			},
+		}
+		Expect(CultureServer.applyExpertRules(culturePB)).ShouldNot(HaveOccurred())
+		Expect(culturePB.CultureResults[1].Label).Should(Equal(culture.Label_RESISTANT))
+		Expect(ruleWarnings(culturePB)).Should(HaveLen(1))
+		Expect(ruleWarnings(culturePB)[0].RuleId).Should(Equal("ER-ESBL-CEP"))
+	})
+
+	It("should annotate exceptional phenotypes without changing their labels", func() {
+		culturePB = &culture.Culture{
+			CultureResults: []*culture.LabTestResult{
+				{PathogenName: "Staphylococcus aureus", AntimicrobialName: "Vancomycin", Label: culture.Label_RESISTANT},
+			},
+		}
+		Expect(CultureServer.applyExpertRules(culturePB)).ShouldNot(HaveOccurred())
+		Expect(culturePB.CultureResults[0].Label).Should(Equal(culture.Label_RESISTANT))
+		Expect(culturePB.CultureResults[0].RuleAnnotations).Should(HaveLen(1))
+		Expect(culturePB.CultureResults[0].RuleAnnotations[0].Inferred).Should(BeFalse())
+	})
+
+	It("should reject results violating intrinsic resistance", func() {
+		culturePB.PathogensFound = []string{"kpn"}
+		culturePB.AntimicrobialsUsed = []string{"AMP"}
+		culturePB.CultureResults = []*culture.LabTestResult{
+			{PathogenId: "kpn", AntimicrobialId: "AMP", DiskDiameter: "25 mm", Label: culture.Label_SUSCEPTIBLE},
+		}
+		createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+		Expect(err).Should(HaveOccurred())
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		Expect(createRes).Should(BeNil())
+	})
+})
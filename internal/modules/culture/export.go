@@ -0,0 +1,471 @@
+package culture
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"github.com/gidyon/antibug/internal/modules"
+	facility_service "github.com/gidyon/antibug/internal/modules/facility"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/internal/pkg/parquet"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// Cultures are read a page at a time so that exports use bounded memory
+	exportPageSize     = 500
+	exportChunkSize    = 64 * 1024
+	exportRowGroupSize = 10000
+)
+
+var exportGroups = []string{auth.Researcher, auth.DataSteward, auth.Admin}
+
+var exportContentTypes = map[culture.ExportFormat]string{
+	culture.ExportFormat_EXPORT_CSV:     "text/csv",
+	culture.ExportFormat_EXPORT_NDJSON:  "application/x-ndjson",
+	culture.ExportFormat_EXPORT_PARQUET: parquet.ContentType,
+}
+
+var exportFileExtensions = map[culture.ExportFormat]string{
+	culture.ExportFormat_EXPORT_CSV:     ".csv",
+	culture.ExportFormat_EXPORT_NDJSON:  ".ndjson",
+	culture.ExportFormat_EXPORT_PARQUET: ".parquet",
+}
+
+// exportColumns are columns of exported rows. Each row is a lab test result of a culture.
+var exportColumns = []parquet.Column{
+	{Name: "culture_id", Kind: parquet.String},
+	{Name: "status", Kind: parquet.String},
+	{Name: "results_timestamp_sec", Kind: parquet.Int64},
+	{Name: "results_date", Kind: parquet.String},
+	{Name: "hospital_id", Kind: parquet.String},
+	{Name: "facility_name", Kind: parquet.String},
+	{Name: "county_code", Kind: parquet.String},
+	{Name: "county", Kind: parquet.String},
+	{Name: "sub_county_code", Kind: parquet.String},
+	{Name: "sub_county", Kind: parquet.String},
+	{Name: "patient_id", Kind: parquet.String},
+	{Name: "patient_gender", Kind: parquet.String},
+	{Name: "patient_age", Kind: parquet.Int64},
+	{Name: "culture_source", Kind: parquet.String},
+	{Name: "ward", Kind: parquet.String},
+	{Name: "department", Kind: parquet.String},
+	{Name: "patient_setting", Kind: parquet.String},
+	{Name: "specimen_type", Kind: parquet.String},
+	{Name: "specimen_collected_timestamp_sec", Kind: parquet.Int64},
+	{Name: "test_method", Kind: parquet.String},
+	{Name: "pathogen_id", Kind: parquet.String},
+	{Name: "pathogen_name", Kind: parquet.String},
+	{Name: "antimicrobial_id", Kind: parquet.String},
+	{Name: "antimicrobial_name", Kind: parquet.String},
+	{Name: "label", Kind: parquet.String},
+	{Name: "reported_label", Kind: parquet.String},
+	{Name: "susceptibility_score", Kind: parquet.Double},
+	{Name: "disk_diameter", Kind: parquet.String},
+	{Name: "mic_value", Kind: parquet.Double},
+	{Name: "mic_comparator", Kind: parquet.String},
+	{Name: "mic_unit", Kind: parquet.String},
+	{Name: "breakpoint_version", Kind: parquet.String},
+}
+
+// facilityGeography is the name and region names of a facility
+type facilityGeography struct {
+	name      string
+	county    string
+	subCounty string
+}
+
+func exportRow(
+	culturePB *culture.Culture, cultureResult *culture.LabTestResult, geography *facilityGeography,
+) []interface{} {
+	var (
+		micValue      float64
+		micComparator string
+		micUnit       string
+	)
+	if cultureResult.Mic != nil {
+		micValue = cultureResult.Mic.Value
+		micComparator = cultureResult.Mic.Comparator.String()
+		micUnit = cultureResult.Mic.Unit
+	}
+
+	return []interface{}{
+		culturePB.CultureId,
+		culturePB.Status.String(),
+		culturePB.ResultsTimestampSec,
+		time.Unix(culturePB.ResultsTimestampSec, 0).UTC().Format("2006-01-02"),
+		culturePB.HospitalId,
+		geography.name,
+		culturePB.CountyCode,
+		geography.county,
+		culturePB.SubCountyCode,
+		geography.subCounty,
+		culturePB.PatientId,
+		culturePB.PatientGender,
+		int64(culturePB.PatientAge),
+		culturePB.CultureSource,
+		culturePB.Ward,
+		culturePB.Department.String(),
+		culturePB.PatientSetting.String(),
+		culturePB.SpecimenType.String(),
+		culturePB.SpecimenCollectedTimestampSec,
+		culturePB.TestMethod.String(),
+		cultureResult.PathogenId,
+		cultureResult.PathogenName,
+		cultureResult.AntimicrobialId,
+		cultureResult.AntimicrobialName,
+		cultureResult.Label.String(),
+		cultureResult.ReportedLabel.String(),
+		float64(cultureResult.SusceptibilityScore),
+		cultureResult.DiskDiameter,
+		micValue,
+		micComparator,
+		micUnit,
+		cultureResult.BreakpointVersion,
+	}
+}
+
+// rowWriter writes exported rows in a file format
+type rowWriter interface {
+	Write(values []interface{}) error
+	Close() error
+}
+
+type csvRowWriter struct {
+	*csv.Writer
+	record []string
+}
+
+func (writer *csvRowWriter) Write(values []interface{}) error {
+	for i, value := range values {
+		switch v := value.(type) {
+		case string:
+			writer.record[i] = v
+		case int64:
+			writer.record[i] = strconv.FormatInt(v, 10)
+		case float64:
+			writer.record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+	return writer.Writer.Write(writer.record)
+}
+
+func (writer *csvRowWriter) Close() error {
+	writer.Flush()
+	return writer.Error()
+}
+
+type ndjsonRowWriter struct {
+	w    io.Writer
+	line bytes.Buffer
+}
+
+// Write writes the row as a JSON object with keys in the order of the columns
+func (writer *ndjsonRowWriter) Write(values []interface{}) error {
+	writer.line.Reset()
+	writer.line.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			writer.line.WriteByte(',')
+		}
+		bs, err := json.Marshal(value)
+		if err != nil {
+			return errs.FromJSONMarshal(err, exportColumns[i].Name)
+		}
+		writer.line.WriteString(strconv.Quote(exportColumns[i].Name))
+		writer.line.WriteByte(':')
+		writer.line.Write(bs)
+	}
+	writer.line.WriteString("}\n")
+	_, err := writer.w.Write(writer.line.Bytes())
+	return err
+}
+
+func (*ndjsonRowWriter) Close() error {
+	return nil
+}
+
+func newRowWriter(format culture.ExportFormat, w io.Writer) (rowWriter, error) {
+	switch format {
+	case culture.ExportFormat_EXPORT_NDJSON:
+		return &ndjsonRowWriter{w: w}, nil
+	case culture.ExportFormat_EXPORT_PARQUET:
+		return parquet.NewWriter(w, exportColumns, exportRowGroupSize)
+	default:
+		header := make([]string, 0, len(exportColumns))
+		for _, column := range exportColumns {
+			header = append(header, column.Name)
+		}
+		writer := csv.NewWriter(w)
+		err := writer.Write(header)
+		if err != nil {
+			return nil, err
+		}
+		return &csvRowWriter{Writer: writer, record: make([]string, len(exportColumns))}, nil
+	}
+}
+
+// chunkWriter sends written bytes to the stream in chunks
+type chunkWriter struct {
+	stream      culture.CultureAPI_ExportCulturesServer
+	contentType string
+	buffer      bytes.Buffer
+	sent        bool
+}
+
+func (writer *chunkWriter) send(data []byte) error {
+	chunk := &culture.ExportChunk{Data: data}
+	if !writer.sent {
+		chunk.ContentType = writer.contentType
+		writer.sent = true
+	}
+	return writer.stream.Send(chunk)
+}
+
+func (writer *chunkWriter) Write(p []byte) (int, error) {
+	writer.buffer.Write(p)
+	for writer.buffer.Len() >= exportChunkSize {
+		err := writer.send(writer.buffer.Next(exportChunkSize))
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends the remaining bytes. Empty exports still send the content type.
+func (writer *chunkWriter) Flush() error {
+	if writer.buffer.Len() == 0 && writer.sent {
+		return nil
+	}
+	return writer.send(writer.buffer.Next(writer.buffer.Len()))
+}
+
+// filterDemographics selects cultures of patients matching the demographic filter
+func filterDemographics(db *gorm.DB, demographics *culture.DemographicFilter) *gorm.DB {
+	if demographics.GetPatientGender() != "" {
+		db = db.Where("patient_gender=?", demographics.GetPatientGender())
+	}
+	if demographics.GetAgeMinDays() < demographics.GetAgeMaxDays() {
+		db = db.Where(
+			"patient_age BETWEEN ? AND ?", demographics.GetAgeMinDays()/365, demographics.GetAgeMaxDays()/365,
+		)
+	}
+	return db
+}
+
+// getFacilityGeography reads names of the facility and its regions. Facilities that are not
+// registered have empty names.
+func (capi *cultureAPIServer) getFacilityGeography(
+	geographies map[string]*facilityGeography, hospitalID string,
+) (*facilityGeography, error) {
+	if geography, ok := geographies[hospitalID]; ok {
+		return geography, nil
+	}
+
+	geography := &facilityGeography{}
+
+	facilityDB := &facility_service.Facility{}
+	err := capi.sqlDB.First(facilityDB, "id=?", hospitalID).Error
+	switch {
+	case err == nil:
+		geography.name = facilityDB.FacilityName
+		geography.county = facilityDB.County
+		geography.subCounty = facilityDB.SubCounty
+	case errors.Is(err, gorm.ErrRecordNotFound):
+	default:
+		return nil, errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	geographies[hospitalID] = geography
+
+	return geography, nil
+}
+
+func (capi *cultureAPIServer) ExportCultures(
+	exportReq *culture.ExportCulturesRequest, stream culture.CultureAPI_ExportCulturesServer,
+) error {
+	// Request must not be nil
+	if exportReq == nil {
+		return errs.NilObject("ExportCulturesRequest")
+	}
+
+	ctx := stream.Context()
+
+	// Authorize request
+	_, err := capi.authAPI.AuthorizeGroup(ctx, exportGroups...)
+	if err != nil {
+		return err
+	}
+
+	// Validation
+	contentType, ok := exportContentTypes[exportReq.Format]
+	switch {
+	case !ok:
+		return errs.WrapMessage(codes.InvalidArgument, "unknown export format "+exportReq.Format.String())
+	case exportReq.GetDemographics().GetPatientGender() != "" &&
+		exportReq.Demographics.PatientGender != "male" && exportReq.Demographics.PatientGender != "female":
+		return errs.WrapMessage(codes.InvalidArgument, "patient gender must be male or female")
+	}
+
+	db := filterDemographics(filterCultures(capi.sqlDB, exportReq.Filter), exportReq.Demographics)
+
+	chunks := &chunkWriter{stream: stream, contentType: contentType}
+
+	rows, err := newRowWriter(exportReq.Format, chunks)
+	if err != nil {
+		return errs.WrapErrWithMessage(codes.Internal, err, "failed to start export")
+	}
+
+	geographies := make(map[string]*facilityGeography)
+
+	page, err := modules.NewPage(
+		modules.Keyset{Column: "results_timestamp_sec"}, "", exportPageSize, exportPageSize,
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		if errs.CtxCancelled(ctx) {
+			return errs.CtxError(ctx, "exporting cultures")
+		}
+
+		culturesDB := make([]*Culture, 0, page.Size+1)
+		err = page.Apply(db).Find(&culturesDB).Error
+		if err != nil {
+			return errs.SQLQueryFailed(err, "LIST")
+		}
+
+		hasNext := page.HasNext(len(culturesDB))
+		if hasNext {
+			culturesDB = culturesDB[:page.Size]
+		}
+
+		for _, cultureDB := range culturesDB {
+			culturePB, err := getCulturePB(cultureDB)
+			if err != nil {
+				return err
+			}
+
+			geography, err := capi.getFacilityGeography(geographies, culturePB.HospitalId)
+			if err != nil {
+				return err
+			}
+
+			for _, cultureResult := range culturePB.CultureResults {
+				err = rows.Write(exportRow(culturePB, cultureResult, geography))
+				if err != nil {
+					return errs.WrapErrWithMessage(codes.Internal, err, "failed to export culture")
+				}
+			}
+		}
+
+		if !hasNext {
+			break
+		}
+
+		last := culturesDB[len(culturesDB)-1]
+		page = page.Next(last.ResultsTimestampSec, last.ID)
+	}
+
+	err = rows.Close()
+	if err != nil {
+		return errs.WrapErrWithMessage(codes.Internal, err, "failed to export cultures")
+	}
+
+	return chunks.Flush()
+}
+
+// ExportHandler downloads culture exports over HTTP. The gateway cannot serve streaming RPCs.
+type ExportHandler struct {
+	capi *cultureAPIServer
+}
+
+// NewExportHandler creates an HTTP download of culture exports
+func NewExportHandler(cultureAPI culture.CultureAPIServer) (*ExportHandler, error) {
+	capi, ok := cultureAPI.(*cultureAPIServer)
+	if !ok {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "culture api must be created by NewCultureAPI")
+	}
+	return &ExportHandler{capi: capi}, nil
+}
+
+// ServeHTTP streams the export as an attachment. Query parameters are fields of the request e.g
+// GET /api/antibug/cultures/action/export?format=EXPORT_CSV&filter.list_target=HOSPITAL&filter.target_ids=1
+func (handler *ExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	exportReq := &culture.ExportCulturesRequest{}
+	err := runtime.PopulateQueryParameters(exportReq, r.URL.Query(), utilities.NewDoubleArray(nil))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stream := &httpExportStream{
+		ctx:      metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization"))),
+		w:        w,
+		filename: "cultures" + exportFileExtensions[exportReq.Format],
+	}
+
+	err = handler.capi.ExportCultures(exportReq, stream)
+	if err != nil {
+		// Errors after the first chunk can only end the download
+		if stream.started {
+			errs.LogError("culture export failed: %v", err)
+			return
+		}
+		http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+	}
+}
+
+// httpExportStream writes chunks of an export to an HTTP response
+type httpExportStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	w        http.ResponseWriter
+	filename string
+	started  bool
+}
+
+func (stream *httpExportStream) Send(chunk *culture.ExportChunk) error {
+	if !stream.started {
+		stream.w.Header().Set("Content-Type", chunk.ContentType)
+		stream.w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(stream.filename))
+		stream.w.WriteHeader(http.StatusOK)
+		stream.started = true
+	}
+
+	_, err := stream.w.Write(chunk.Data)
+	if err != nil {
+		return err
+	}
+
+	if flusher, ok := stream.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+func (stream *httpExportStream) Context() context.Context {
+	return stream.ctx
+}
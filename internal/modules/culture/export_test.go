@@ -0,0 +1,185 @@
+package culture
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/Pallinder/go-randomdata"
+	authmocks "github.com/gidyon/antibug/internal/mocks/mocks"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/parquet"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+// fakeExportStream collects chunks of an export
+type fakeExportStream struct {
+	grpc.ServerStream
+	chunks []*culture.ExportChunk
+	data   bytes.Buffer
+}
+
+func (stream *fakeExportStream) Send(chunk *culture.ExportChunk) error {
+	stream.chunks = append(stream.chunks, chunk)
+	stream.data.Write(chunk.Data)
+	return nil
+}
+
+func (*fakeExportStream) Context() context.Context {
+	return context.Background()
+}
+
+var _ = Describe("Exporting cultures #export", func() {
+	var (
+		exportReq  *culture.ExportCulturesRequest
+		stream     *fakeExportStream
+		hospitalID string
+		results    int
+	)
+
+	BeforeEach(func() {
+		stream = &fakeExportStream{}
+	})
+
+	Describe("Exporting cultures with malformed request", func() {
+		It("should fail when request is nil", func() {
+			err := CultureAPI.ExportCultures(nil, stream)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(stream.chunks).Should(BeEmpty())
+		})
+		It("should fail when format is unknown", func() {
+			err := CultureAPI.ExportCultures(&culture.ExportCulturesRequest{Format: 9}, stream)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+		It("should fail when patient gender is unknown", func() {
+			err := CultureAPI.ExportCultures(&culture.ExportCulturesRequest{
+				Demographics: &culture.DemographicFilter{PatientGender: "other"},
+			}, stream)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+		It("should fail when caller is not in the export groups", func() {
+			authAPI := &authmocks.AuthAPIMock{}
+			authAPI.On("AuthorizeGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+				nil, status.Error(codes.PermissionDenied, "permission denied"),
+			)
+			server := *CultureServer
+			server.authAPI = authAPI
+
+			err := server.ExportCultures(&culture.ExportCulturesRequest{}, stream)
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			authAPI.AssertCalled(GinkgoT(), "AuthorizeGroup", mock.Anything, auth.Researcher, auth.DataSteward, auth.Admin)
+		})
+	})
+
+	Describe("Exporting cultures of a hospital", func() {
+		BeforeEach(func() {
+			hospitalID = "export-" + randomdata.RandStringRunes(10)
+			results = 0
+			for _, gender := range []string{"male", "female", "male"} {
+				culturePB := FakeCulture()
+				culturePB.HospitalId = hospitalID
+				culturePB.PatientGender = gender
+				_, err := CultureAPI.CreateCulture(context.Background(), &culture.CreateCultureRequest{Culture: culturePB})
+				Expect(err).ShouldNot(HaveOccurred())
+				results += len(culturePB.CultureResults)
+			}
+
+			exportReq = &culture.ExportCulturesRequest{
+				Filter: &culture.ListCultureFilter{
+					ListTarget: culture.ListTarget_HOSPITAL,
+					TargetIds:  []string{hospitalID},
+				},
+			}
+		})
+
+		It("should export a CSV row per lab test result", func() {
+			err := CultureAPI.ExportCultures(exportReq, stream)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.chunks).ShouldNot(BeEmpty())
+			Expect(stream.chunks[0].ContentType).Should(Equal("text/csv"))
+
+			records, err := csv.NewReader(&stream.data).ReadAll()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(records).Should(HaveLen(results + 1))
+			Expect(records[0][0]).Should(Equal("culture_id"))
+			for _, record := range records[1:] {
+				Expect(record[4]).Should(Equal(hospitalID))
+			}
+		})
+
+		It("should export a JSON line per lab test result", func() {
+			exportReq.Format = culture.ExportFormat_EXPORT_NDJSON
+			err := CultureAPI.ExportCultures(exportReq, stream)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.chunks[0].ContentType).Should(Equal("application/x-ndjson"))
+
+			lines := strings.Split(strings.TrimSpace(stream.data.String()), "\n")
+			Expect(lines).Should(HaveLen(results))
+			row := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(lines[0]), &row)).ShouldNot(HaveOccurred())
+			Expect(row["hospital_id"]).Should(Equal(hospitalID))
+			Expect(row).Should(HaveKey("pathogen_name"))
+		})
+
+		It("should export a parquet file", func() {
+			exportReq.Format = culture.ExportFormat_EXPORT_PARQUET
+			err := CultureAPI.ExportCultures(exportReq, stream)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.chunks[0].ContentType).Should(Equal(parquet.ContentType))
+
+			data := stream.data.Bytes()
+			Expect(string(data[:4])).Should(Equal("PAR1"))
+			Expect(string(data[len(data)-4:])).Should(Equal("PAR1"))
+		})
+
+		It("should export cultures of patients of the gender", func() {
+			exportReq.Format = culture.ExportFormat_EXPORT_NDJSON
+			exportReq.Demographics = &culture.DemographicFilter{PatientGender: "female"}
+			err := CultureAPI.ExportCultures(exportReq, stream)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			lines := strings.Split(strings.TrimSpace(stream.data.String()), "\n")
+			Expect(len(lines)).Should(BeNumerically("<", results))
+			for _, line := range lines {
+				Expect(line).Should(ContainSubstring(`"patient_gender":"female"`))
+			}
+		})
+
+		It("should download the export over HTTP", func() {
+			handler, err := NewExportHandler(CultureAPI)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			req := httptest.NewRequest(
+				http.MethodGet,
+				"/api/antibug/cultures/action/export?format=EXPORT_CSV&filter.list_target=HOSPITAL&filter.target_ids="+hospitalID,
+				nil,
+			)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			Expect(w.Code).Should(Equal(http.StatusOK))
+			Expect(w.Header().Get("Content-Type")).Should(Equal("text/csv"))
+			Expect(w.Header().Get("Content-Disposition")).Should(ContainSubstring("cultures.csv"))
+
+			records, err := csv.NewReader(w.Body).ReadAll()
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(records).Should(HaveLen(results + 1))
+		})
+
+		It("should fail to download the export when format is unknown", func() {
+			handler, err := NewExportHandler(CultureAPI)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/antibug/cultures/action/export?format=XLSX", nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			Expect(w.Code).Should(Equal(http.StatusBadRequest))
+		})
+	})
+})
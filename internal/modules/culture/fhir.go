@@ -0,0 +1,386 @@
+package culture
+
+import (
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/breakpoints"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/internal/pkg/fhir"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Codes of FHIR resources of cultures
+const (
+	loincMicrobiologyReport = "29576-6"
+	loincOrganismIdentified = "11475-1"
+	pathogenSystem          = "urn:antibug:pathogen"
+	antimicrobialSystem     = "urn:antibug:antimicrobial"
+)
+
+var fhirInterpretations = map[culture.Label]*fhir.Coding{
+	culture.Label_SUSCEPTIBLE:      {System: fhir.SystemObservationInterpretation, Code: "S", Display: "Susceptible"},
+	culture.Label_DOSE_SUSCEPTIBLE: {System: fhir.SystemObservationInterpretation, Code: "SDD", Display: "Susceptible-dose dependent"},
+	culture.Label_INTERMEDIATE:     {System: fhir.SystemObservationInterpretation, Code: "I", Display: "Intermediate"},
+	culture.Label_RESISTANT:        {System: fhir.SystemObservationInterpretation, Code: "R", Display: "Resistant"},
+}
+
+var fhirHTTPStatus = map[codes.Code]int{
+	codes.InvalidArgument:  http.StatusBadRequest,
+	codes.Unauthenticated:  http.StatusUnauthorized,
+	codes.PermissionDenied: http.StatusForbidden,
+	codes.NotFound:         http.StatusNotFound,
+}
+
+var fhirIssueCodes = map[codes.Code]string{
+	codes.InvalidArgument:  "invalid",
+	codes.Unauthenticated:  "login",
+	codes.PermissionDenied: "forbidden",
+	codes.NotFound:         "not-found",
+}
+
+// FHIRHandler serves cultures as FHIR R4 DiagnosticReport resources with their observations
+type FHIRHandler struct {
+	capi *cultureAPIServer
+}
+
+// NewFHIRHandler creates a FHIR facade over the culture API
+func NewFHIRHandler(cultureAPI culture.CultureAPIServer) (*FHIRHandler, error) {
+	capi, ok := cultureAPI.(*cultureAPIServer)
+	if !ok {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "culture api must be created by NewCultureAPI")
+	}
+	return &FHIRHandler{capi: capi}, nil
+}
+
+// ServeHTTP searches DiagnosticReport resources by patient and date e.g
+// GET /fhir/DiagnosticReport?patient=Patient/123&date=ge2020-01-01&date=lt2020-04-01
+func (handler *FHIRHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		fhir.WriteError(w, http.StatusMethodNotAllowed, "not-supported", "method not allowed")
+		return
+	}
+
+	bundle, err := handler.searchDiagnosticReports(r)
+	if err != nil {
+		code := status.Code(err)
+		statusCode, ok := fhirHTTPStatus[code]
+		if !ok {
+			statusCode = http.StatusInternalServerError
+		}
+		issueCode, ok := fhirIssueCodes[code]
+		if !ok {
+			issueCode = "exception"
+		}
+		fhir.WriteError(w, statusCode, issueCode, status.Convert(err).Message())
+		return
+	}
+
+	fhir.WriteResource(w, http.StatusOK, bundle)
+}
+
+func (handler *FHIRHandler) searchDiagnosticReports(r *http.Request) (*fhir.Bundle, error) {
+	query := r.URL.Query()
+
+	listReq := &culture.ListCulturesRequest{
+		Filter: &culture.ListCultureFilter{},
+	}
+
+	var err error
+	for param, values := range query {
+		switch param {
+		case "patient", "subject":
+			listReq.Filter.ListTarget = culture.ListTarget_PATIENT
+			for _, value := range values {
+				for _, patientID := range strings.Split(value, ",") {
+					listReq.Filter.TargetIds = append(listReq.Filter.TargetIds, strings.TrimPrefix(patientID, "Patient/"))
+				}
+			}
+		case "date":
+			listReq.Filter.DateFilter, err = fhirDateFilter(values)
+		case "_count":
+			listReq.PageSize, err = fhirIntParam(param, values[0])
+		case "_page":
+			listReq.PageToken = values[0]
+		case "_format":
+		default:
+			err = errs.WrapMessage(codes.InvalidArgument, "unsupported search parameter "+param)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bundle := &fhir.Bundle{
+		ResourceType: "Bundle",
+		Type:         "searchset",
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+		Link:         []*fhir.BundleLink{{Relation: "self", URL: r.URL.String()}},
+		Entry:        make([]*fhir.BundleEntry, 0),
+	}
+
+	// Date parameters that cannot overlap match nothing
+	if listReq.Filter.DateFilter != nil && !listReq.Filter.DateFilter.Filter {
+		return bundle, nil
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+
+	culturesPB, err := handler.capi.ListCultures(ctx, listReq)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, culturePB := range culturesPB.Cultures {
+		report, observations := newDiagnosticReport(culturePB)
+		bundle.Entry = append(bundle.Entry, &fhir.BundleEntry{
+			FullURL:  "DiagnosticReport/" + report.ID,
+			Resource: report,
+			Search:   &fhir.BundleSearch{Mode: "match"},
+		})
+		for _, observation := range observations {
+			bundle.Entry = append(bundle.Entry, &fhir.BundleEntry{
+				FullURL:  "Observation/" + observation.ID,
+				Resource: observation,
+				Search:   &fhir.BundleSearch{Mode: "include"},
+			})
+		}
+	}
+
+	if culturesPB.NextPageToken != "" {
+		next := *r.URL
+		nextQuery := next.Query()
+		nextQuery.Set("_page", culturesPB.NextPageToken)
+		next.RawQuery = nextQuery.Encode()
+		bundle.Link = append(bundle.Link, &fhir.BundleLink{Relation: "next", URL: next.String()})
+	}
+
+	return bundle, nil
+}
+
+func fhirIntParam(param, value string) (int32, error) {
+	number, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || number < 0 {
+		return 0, errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("malformed %s %q", param, value))
+	}
+	return int32(number), nil
+}
+
+// fhirDateFilter intersects date parameters into a date filter of results timestamp. The filter is
+// off when the parameters cannot match any date.
+func fhirDateFilter(values []string) (*culture.DateFilter, error) {
+	var lower, upper int64 = math.MinInt64, math.MaxInt64
+	for _, value := range values {
+		param, err := fhir.ParseDateParam(value)
+		if err != nil {
+			return nil, errs.WrapMessage(codes.InvalidArgument, err.Error())
+		}
+		start, end := param.Start.Unix(), param.End.Unix()-1
+		switch param.Prefix {
+		case "eq":
+			lower, upper = maxInt64(lower, start), minInt64(upper, end)
+		case "ge":
+			lower = maxInt64(lower, start)
+		case "gt":
+			lower = maxInt64(lower, end+1)
+		case "le":
+			upper = minInt64(upper, end)
+		case "lt":
+			upper = minInt64(upper, start-1)
+		default:
+			return nil, errs.WrapMessage(codes.InvalidArgument, "unsupported date prefix "+param.Prefix)
+		}
+	}
+
+	switch {
+	case lower > upper:
+		return &culture.DateFilter{}, nil
+	case upper == math.MaxInt64:
+		// Results after the lower bound
+		return &culture.DateFilter{StartTimestampSec: lower - 1, Filter: true}, nil
+	case lower == math.MinInt64:
+		lower = 0
+	}
+
+	return &culture.DateFilter{StartTimestampSec: lower, EndTimestampSec: upper, Filter: true}, nil
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Statuses of reports and observations of cultures in each status
+var (
+	fhirReportStatus = map[culture.CultureStatus]string{
+		culture.CultureStatus_RECEIVED:        "registered",
+		culture.CultureStatus_GROWTH_DETECTED: "partial",
+		culture.CultureStatus_PRELIMINARY:     "preliminary",
+		culture.CultureStatus_FINAL:           "final",
+		culture.CultureStatus_AMENDED:         "amended",
+	}
+	fhirObservationStatus = map[culture.CultureStatus]string{
+		culture.CultureStatus_RECEIVED:        "registered",
+		culture.CultureStatus_GROWTH_DETECTED: "preliminary",
+		culture.CultureStatus_PRELIMINARY:     "preliminary",
+		culture.CultureStatus_FINAL:           "final",
+		culture.CultureStatus_AMENDED:         "amended",
+	}
+)
+
+// newDiagnosticReport maps a culture to a report with an observation per organism identified and
+// per susceptibility result
+func newDiagnosticReport(culturePB *culture.Culture) (*fhir.DiagnosticReport, []*fhir.Observation) {
+	subject := &fhir.Reference{Reference: "Patient/" + culturePB.PatientId}
+	// Reports are effective when the specimen was collected, if known, and issued with results
+	issued := fhir.FormatDateTime(culturePB.ResultsTimestampSec)
+	effective := issued
+	if culturePB.SpecimenCollectedTimestampSec > 0 {
+		effective = fhir.FormatDateTime(culturePB.SpecimenCollectedTimestampSec)
+	}
+	performer := []*fhir.Reference{{Reference: "Organization/" + culturePB.HospitalId}}
+
+	report := &fhir.DiagnosticReport{
+		ResourceType: "DiagnosticReport",
+		ID:           culturePB.CultureId,
+		Status:       fhirReportStatus[culturePB.Status],
+		Category: []*fhir.CodeableConcept{{
+			Coding: []*fhir.Coding{{System: fhir.SystemDiagnosticService, Code: "MB", Display: "Microbiology"}},
+		}},
+		Code: &fhir.CodeableConcept{
+			Coding: []*fhir.Coding{{System: fhir.SystemLOINC, Code: loincMicrobiologyReport, Display: "Bacterial susceptibility panel"}},
+			Text:   "Culture and susceptibility",
+		},
+		Subject:            subject,
+		EffectiveDateTime:  effective,
+		Issued:             issued,
+		Performer:          performer,
+		ResultsInterpreter: []*fhir.Reference{{Reference: "Practitioner/" + culturePB.LabTechId}},
+		Specimen:           []*fhir.Reference{{Display: culturePB.CultureSource}},
+	}
+
+	newObservation := func(id string, code *fhir.CodeableConcept, category string) *fhir.Observation {
+		return &fhir.Observation{
+			ResourceType: "Observation",
+			ID:           id,
+			Status:       fhirObservationStatus[culturePB.Status],
+			Category: []*fhir.CodeableConcept{{
+				Coding: []*fhir.Coding{{System: fhir.SystemObservationCategory, Code: category}},
+			}},
+			Code:              code,
+			Subject:           subject,
+			EffectiveDateTime: effective,
+			Performer:         performer,
+		}
+	}
+
+	observations := make([]*fhir.Observation, 0, len(culturePB.PathogensFound)+len(culturePB.CultureResults))
+	isolates := make(map[string]*fhir.Observation, len(culturePB.PathogensFound))
+
+	// Organisms identified
+	for i, pathogenID := range culturePB.PathogensFound {
+		observation := newObservation(
+			fmt.Sprintf("%s-isolate-%d", culturePB.CultureId, i+1),
+			&fhir.CodeableConcept{
+				Coding: []*fhir.Coding{{System: fhir.SystemLOINC, Code: loincOrganismIdentified, Display: "Bacteria identified"}},
+			},
+			"laboratory",
+		)
+		observation.ValueCodeableConcept = &fhir.CodeableConcept{
+			Coding: []*fhir.Coding{{System: pathogenSystem, Code: pathogenID}},
+		}
+		isolates[pathogenID] = observation
+		observations = append(observations, observation)
+		report.Result = append(report.Result, &fhir.Reference{Reference: "Observation/" + observation.ID})
+	}
+
+	// Susceptibility results
+	for i, cultureResult := range culturePB.CultureResults {
+		observation := newObservation(
+			fmt.Sprintf("%s-ast-%d", culturePB.CultureId, i+1),
+			&fhir.CodeableConcept{
+				Coding: []*fhir.Coding{{
+					System:  antimicrobialSystem,
+					Code:    cultureResult.AntimicrobialId,
+					Display: cultureResult.AntimicrobialName,
+				}},
+				Text: cultureResult.AntimicrobialName,
+			},
+			"laboratory",
+		)
+		observation.ValueQuantity = fhirQuantity(culturePB.TestMethod, cultureResult)
+		observation.Method = &fhir.CodeableConcept{Text: strings.ReplaceAll(strings.ToLower(culturePB.TestMethod.String()), "_", " ")}
+		if interpretation, ok := fhirInterpretations[cultureResult.Label]; ok {
+			observation.Interpretation = []*fhir.CodeableConcept{{Coding: []*fhir.Coding{interpretation}}}
+		}
+		if cultureResult.BreakpointVersion != "" {
+			observation.Note = append(observation.Note, &fhir.Annotation{Text: "Interpreted using " + cultureResult.BreakpointVersion})
+		}
+		if cultureResult.ResultComment != "" {
+			observation.Note = append(observation.Note, &fhir.Annotation{Text: cultureResult.ResultComment})
+		}
+
+		if isolate, ok := isolates[cultureResult.PathogenId]; ok {
+			observation.DerivedFrom = []*fhir.Reference{{Reference: "Observation/" + isolate.ID}}
+			isolate.HasMember = append(isolate.HasMember, &fhir.Reference{Reference: "Observation/" + observation.ID})
+		} else {
+			report.Result = append(report.Result, &fhir.Reference{Reference: "Observation/" + observation.ID})
+		}
+
+		observations = append(observations, observation)
+	}
+
+	// Names of organisms are only recorded on results
+	for _, cultureResult := range culturePB.CultureResults {
+		if isolate, ok := isolates[cultureResult.PathogenId]; ok && cultureResult.PathogenName != "" {
+			isolate.ValueCodeableConcept.Coding[0].Display = cultureResult.PathogenName
+			isolate.ValueCodeableConcept.Text = cultureResult.PathogenName
+		}
+	}
+
+	return report, observations
+}
+
+// fhirQuantity returns the zone diameter in mm or MIC in mg/L of the result
+func fhirQuantity(testMethod culture.TestMethod, cultureResult *culture.LabTestResult) *fhir.Quantity {
+	if measuresMIC(testMethod) {
+		mic := cultureResult.GetMic()
+		if mic == nil {
+			return nil
+		}
+		return &fhir.Quantity{
+			Value:      mic.Value,
+			Comparator: micComparators[mic.Comparator],
+			Unit:       "mg/L",
+			System:     fhir.SystemUCUM,
+			Code:       "mg/L",
+		}
+	}
+
+	diameter, comparator, err := breakpoints.ParseMeasurement(cultureResult.DiskDiameter)
+	if err != nil {
+		return nil
+	}
+	return &fhir.Quantity{
+		Value:      diameter,
+		Comparator: comparator,
+		Unit:       "mm",
+		System:     fhir.SystemUCUM,
+		Code:       "mm",
+	}
+}
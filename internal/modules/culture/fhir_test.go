@@ -0,0 +1,115 @@
+package culture
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/antibug/internal/pkg/fhir"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+)
+
+// fhirEntry is a bundle entry decoded as a map
+type fhirEntry struct {
+	Resource map[string]interface{} `json:"resource"`
+	Search   *fhir.BundleSearch     `json:"search"`
+}
+
+var _ = Describe("Searching FHIR DiagnosticReport resources #fhir", func() {
+	var handler *FHIRHandler
+
+	search := func(query string) (*httptest.ResponseRecorder, []*fhirEntry) {
+		req := httptest.NewRequest(http.MethodGet, "/fhir/DiagnosticReport?"+query, nil)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		Expect(w.Header().Get("Content-Type")).Should(Equal(fhir.ContentType))
+
+		bundle := struct {
+			ResourceType string       `json:"resourceType"`
+			Entry        []*fhirEntry `json:"entry"`
+		}{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &bundle)).ShouldNot(HaveOccurred())
+		return w, bundle.Entry
+	}
+
+	BeforeEach(func() {
+		var err error
+		handler, err = NewFHIRHandler(CultureAPI)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	Describe("Searching with malformed request", func() {
+		It("should fail when method is not GET", func() {
+			req := httptest.NewRequest(http.MethodPost, "/fhir/DiagnosticReport", nil)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			Expect(w.Code).Should(Equal(http.StatusMethodNotAllowed))
+		})
+		It("should fail when date is malformed", func() {
+			w, _ := search("date=yesterday")
+			Expect(w.Code).Should(Equal(http.StatusBadRequest))
+		})
+		It("should fail when date prefix is unsupported", func() {
+			w, _ := search("date=ap2020-01-01")
+			Expect(w.Code).Should(Equal(http.StatusBadRequest))
+		})
+		It("should fail when search parameter is unsupported", func() {
+			w, _ := search("code=29576-6")
+			Expect(w.Code).Should(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("Searching with well-formed request", func() {
+		var culturePB *culture.Culture
+
+		BeforeEach(func() {
+			culturePB = FakeCulture()
+			culturePB.PatientId = "fhir-" + randomdata.RandStringRunes(10)
+			culturePB.ResultsTimestampSec = time.Date(2020, 3, 15, 10, 0, 0, 0, time.UTC).Unix()
+			_, err := CultureAPI.CreateCulture(context.Background(), &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should return reports of the patient with their observations", func() {
+			w, entries := search("patient=" + url.QueryEscape("Patient/"+culturePB.PatientId))
+			Expect(w.Code).Should(Equal(http.StatusOK))
+			Expect(entries).ShouldNot(BeEmpty())
+
+			reports, observations := 0, 0
+			for _, entry := range entries {
+				switch entry.Resource["resourceType"] {
+				case "DiagnosticReport":
+					reports++
+					Expect(entry.Search.Mode).Should(Equal("match"))
+					Expect(entry.Resource["subject"]).Should(HaveKeyWithValue("reference", "Patient/"+culturePB.PatientId))
+				case "Observation":
+					observations++
+					Expect(entry.Search.Mode).Should(Equal("include"))
+				}
+			}
+			Expect(reports).ShouldNot(BeZero())
+			Expect(observations).Should(BeNumerically(">=", len(culturePB.CultureResults)))
+		})
+		It("should code susceptibility interpretations", func() {
+			_, entries := search("patient=" + url.QueryEscape(culturePB.PatientId) + "&date=2020-03-15")
+			interpreted := false
+			for _, entry := range entries {
+				if entry.Resource["resourceType"] == "Observation" && entry.Resource["interpretation"] != nil {
+					interpreted = true
+				}
+			}
+			Expect(interpreted).Should(BeTrue())
+		})
+		It("should filter reports by date", func() {
+			w, entries := search("patient=" + url.QueryEscape(culturePB.PatientId) + "&date=ge2020-03&date=lt2020-03-15")
+			Expect(w.Code).Should(Equal(http.StatusOK))
+			Expect(entries).Should(BeEmpty())
+
+			_, entries = search("patient=" + url.QueryEscape(culturePB.PatientId) + "&date=ge2020-03&date=le2020-03-15")
+			Expect(entries).ShouldNot(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,730 @@
+package culture
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/breakpoints"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/internal/pkg/hl7"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	maxHL7MessageSize = 1 << 20
+	// Connections may idle between messages but a message must be read and acknowledged in time
+	mllpIdleTimeout  = 5 * time.Minute
+	mllpReadTimeout  = 30 * time.Second
+	mllpWriteTimeout = 30 * time.Second
+)
+
+// Culture statuses of OBR-25 result statuses of orders with results. Final results of the order replace
+// preliminary results and corrected results replace final results. Orders in other statuses are not stored.
+var hl7ResultStatuses = map[string]culture.CultureStatus{
+	"":  culture.CultureStatus_FINAL,
+	"F": culture.CultureStatus_FINAL,
+	"C": culture.CultureStatus_FINAL,
+	"P": culture.CultureStatus_PRELIMINARY,
+	"A": culture.CultureStatus_PRELIMINARY,
+	"R": culture.CultureStatus_PRELIMINARY,
+	"I": culture.CultureStatus_PRELIMINARY,
+}
+
+const hl7CorrectedStatus = "C"
+
+// PV1-2 patient classes of patient settings. Emergency and recurring patients are not admitted.
+var hl7PatientSettings = map[string]culture.PatientSetting{
+	"I": culture.PatientSetting_INPATIENT,
+	"O": culture.PatientSetting_OUTPATIENT,
+	"E": culture.PatientSetting_OUTPATIENT,
+	"R": culture.PatientSetting_OUTPATIENT,
+}
+
+// HL7Facility is the facility of a sending facility code
+type HL7Facility struct {
+	HospitalID    string `json:"hospital_id"`
+	CountyCode    string `json:"county_code"`
+	SubCountyCode string `json:"sub_county_code"`
+}
+
+// HL7Code is the id and name of an organism code
+type HL7Code struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// HL7Antimicrobial is the antimicrobial of a susceptibility observation code.
+// An empty test method is inferred from the units of the observation.
+type HL7Antimicrobial struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	TestMethod string `json:"test_method,omitempty"`
+}
+
+// HL7CodeTables map codes used by laboratory information systems to cultures
+type HL7CodeTables struct {
+	// Facilities keyed by MSH-4 sending facility
+	Facilities map[string]*HL7Facility `json:"facilities"`
+	// Codes of OBX-3 of organism identification observations
+	OrganismObservations []string `json:"organism_observations"`
+	// Organisms keyed by OBX-5 code of organism identification observations
+	Organisms map[string]*HL7Code `json:"organisms"`
+	// Antimicrobials keyed by OBX-3 code of susceptibility observations
+	Antimicrobials map[string]*HL7Antimicrobial `json:"antimicrobials"`
+	// Names of specimen source codes
+	Specimens map[string]string `json:"specimens"`
+	// Lab technician of orders without OBR-34 technician
+	DefaultLabTechID string `json:"default_lab_tech_id"`
+}
+
+// LoadHL7CodeTables reads code tables from a json file
+func LoadHL7CodeTables(file string) (*HL7CodeTables, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read hl7 code tables: %v", err)
+	}
+
+	codeTables := &HL7CodeTables{}
+	err = json.Unmarshal(bs, codeTables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to json unmarshal hl7 code tables: %v", err)
+	}
+
+	for code, antimicrobial := range codeTables.Antimicrobials {
+		if _, ok := culture.TestMethod_value[antimicrobial.TestMethod]; antimicrobial.TestMethod != "" && !ok {
+			return nil, fmt.Errorf("unknown test method %q of antimicrobial code %s", antimicrobial.TestMethod, code)
+		}
+	}
+
+	return codeTables, nil
+}
+
+// HL7Ingester stores cultures from HL7 v2 ORU^R01 messages received over MLLP or HTTP
+type HL7Ingester struct {
+	capi       *cultureAPIServer
+	codeTables *HL7CodeTables
+}
+
+// NewHL7Ingester creates an ingester that stores cultures using the culture API
+func NewHL7Ingester(cultureAPI culture.CultureAPIServer, codeTables *HL7CodeTables) (*HL7Ingester, error) {
+	capi, ok := cultureAPI.(*cultureAPIServer)
+	var err error
+	switch {
+	case !ok:
+		err = errs.WrapMessage(codes.InvalidArgument, "culture api must be created by NewCultureAPI")
+	case codeTables == nil:
+		err = errs.NilObject("HL7CodeTables")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &HL7Ingester{capi: capi, codeTables: codeTables}, nil
+}
+
+// Ingest stores cultures of the message and returns its acknowledgment. The sender is trusted for the facility
+// of its MSH-4 sending facility, so callers must authenticate senders.
+func (ingester *HL7Ingester) Ingest(ctx context.Context, data []byte) []byte {
+	return ingester.ingest(ctx, data, nil)
+}
+
+// ingest stores cultures of the message. When the sender has a token, it must be authorized for facilities of the cultures.
+func (ingester *HL7Ingester) ingest(ctx context.Context, data []byte, payload *auth.Payload) []byte {
+	message, err := hl7.Parse(data)
+	if err != nil {
+		return hl7.NewACK(nil, hl7.AR, err.Error())
+	}
+
+	if message.Type() != "ORU^R01" {
+		return hl7.NewACK(message, hl7.AR, "unsupported message type "+message.Type())
+	}
+
+	count, err := ingester.ingestORU(ctx, message, payload)
+	if err != nil {
+		return hl7.NewACK(message, hl7.AE, status.Convert(err).Message())
+	}
+
+	return hl7.NewACK(message, hl7.AA, fmt.Sprintf("stored %d cultures", count))
+}
+
+// ingestORU prepares cultures of the message and saves them in a transaction. Cultures of orders that were
+// received before are updated with newer results of the order.
+func (ingester *HL7Ingester) ingestORU(ctx context.Context, message *hl7.Message, payload *auth.Payload) (int, error) {
+	orders, err := ingester.parseORU(message)
+	if err != nil {
+		return 0, err
+	}
+
+	culturesPB := make([]*culture.Culture, 0, len(orders))
+	for _, order := range orders {
+		culturePB := order.culturePB
+		err = ingester.capi.prepareCulture(culturePB)
+		if err != nil {
+			return 0, err
+		}
+		err = checkLabels(culturePB, order.labeled)
+		if err != nil {
+			return 0, err
+		}
+		if payload != nil {
+			err = auth.AuthorizeFacility(payload, culturePB.HospitalId)
+			if err != nil {
+				return 0, err
+			}
+		}
+		culturesPB = append(culturesPB, culturePB)
+	}
+
+	// Messages without results are acknowledged without storing anything
+	if len(orders) == 0 {
+		return 0, nil
+	}
+
+	tx := ingester.capi.sqlDB.Begin()
+	if tx.Error != nil {
+		return 0, errs.SQLQueryFailed(tx.Error, "BEGIN")
+	}
+
+	// Concurrent messages of the patients wait so that re-sent cultures are found
+	err = lockPatientCultures(tx, culturesPB...)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	events := make([]*ChangeEvent, 0, len(orders))
+	for index, order := range orders {
+		culturePB := order.culturePB
+		actorID := culturePB.LabTechId
+		if payload != nil {
+			actorID = payload.ID
+		}
+		reason := "received in HL7 message " + message.ControlID()
+
+		idempotencyKey := hl7OrderKey(message, order, index)
+		if idempotencyKey != "" {
+			cultureDB, err := getIdempotentCulture(tx, idempotencyKey, culturePB)
+			if err != nil {
+				tx.Rollback()
+				return 0, err
+			}
+			if cultureDB != nil {
+				event, err := ingester.updateOrder(tx, cultureDB.ID, order, payload, actorID, reason)
+				if err != nil {
+					tx.Rollback()
+					return 0, err
+				}
+				if event != nil {
+					events = append(events, event)
+				}
+				continue
+			}
+		}
+
+		cultureDB, err := createCulture(tx, culturePB, idempotencyKey, false, actorID, reason)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		events = append(events, newChangeEvent(OperationCreate, fmt.Sprint(cultureDB.ID), culturePB))
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		return 0, errs.SQLQueryFailed(err, "COMMIT")
+	}
+
+	for _, event := range events {
+		ingester.capi.publishChange(ctx, event)
+	}
+
+	return len(events), nil
+}
+
+// updateOrder replaces results of the culture of an order received before with results of the order.
+// It returns nil when the culture is not changed, e.g when the message is re-sent, preliminary results arrive
+// after final results or the culture was deleted.
+func (ingester *HL7Ingester) updateOrder(
+	tx *gorm.DB, cultureID uint, order *hl7Order, payload *auth.Payload, actorID, reason string,
+) (*ChangeEvent, error) {
+	cultureDB, err := lockCulture(tx, fmt.Sprint(cultureID))
+	switch {
+	case err == nil:
+	case status.Code(err) == codes.NotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	oldCulturePB, err := getCulturePB(cultureDB)
+	if err != nil {
+		return nil, err
+	}
+
+	// Final results only change with corrections. Other results take the status of the order.
+	culturePB := order.culturePB
+	if isFinalStatus(oldCulturePB.Status) {
+		if !order.corrected {
+			return nil, nil
+		}
+		culturePB.Status = culture.CultureStatus_AMENDED
+	}
+
+	if payload != nil {
+		err = auth.AuthorizeFacility(payload, oldCulturePB.HospitalId)
+		if err == nil && culturePB.Status != oldCulturePB.Status {
+			err = authorizeTransition(payload, oldCulturePB.Status, culturePB.Status)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	culturePB.Editors = append(append(make([]string, 0, len(oldCulturePB.Editors)+1), oldCulturePB.Editors...), actorID)
+
+	updatedCulturePB, err := saveUpdate(tx, cultureDB.ID, oldCulturePB, culturePB, actorID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return newChangeEvent(OperationUpdate, fmt.Sprint(cultureDB.ID), oldCulturePB, updatedCulturePB), nil
+}
+
+// hl7OrderKey identifies the culture of an order by the sending facility and the OBR-3 filler order number,
+// so that corrected results of the order find its culture. Orders without a filler order number are identified
+// by the message control id and their index in the message.
+func hl7OrderKey(message *hl7.Message, order *hl7Order, index int) string {
+	sender := message.Segment("MSH").Component(4, 1)
+	if order.fillerOrderNumber != "" {
+		return fmt.Sprintf("hl7:order:%x", sha256.Sum256([]byte(sender+"|"+order.fillerOrderNumber)))
+	}
+	controlID := message.ControlID()
+	if controlID == "" {
+		return ""
+	}
+	return fmt.Sprintf("hl7:%x:%d", sha256.Sum256([]byte(sender+"|"+controlID)), index)
+}
+
+type hl7Order struct {
+	culturePB *culture.Culture
+	organisms map[string]*HL7Code
+	// Isolates of results keyed by result
+	isolates map[*culture.LabTestResult]string
+	labeled  []bool
+	// OBR-3 filler order number
+	fillerOrderNumber string
+	// Whether OBR-25 result status has results of the order and whether they are corrected
+	reported  bool
+	corrected bool
+}
+
+// parseORU returns orders with results mapped to cultures. Final results must have susceptibility results
+// and preliminary results identified organisms.
+// Susceptibility observations belong to the organism observation with the same OBX-4 sub-id,
+// or the sub-id in OBR-26 of child orders.
+func (ingester *HL7Ingester) parseORU(message *hl7.Message) ([]*hl7Order, error) {
+	msh, pid, pv1 := message.Segment("MSH"), message.Segment("PID"), message.Segment("PV1")
+	if pid == nil {
+		return nil, errs.MissingField("PID segment")
+	}
+
+	facility, ok := ingester.codeTables.Facilities[msh.Component(4, 1)]
+	if !ok {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "unknown sending facility "+msh.Component(4, 1))
+	}
+
+	birthDate, err := hl7.ParseTime(pid.Field(7))
+	if err != nil {
+		return nil, errs.WrapErrWithMessage(codes.InvalidArgument, err, "PID-7 date of birth")
+	}
+
+	var (
+		orders   = make([]*hl7Order, 0, 1)
+		order    *hl7Order
+		parentID string
+	)
+
+	for _, segment := range message.Segments {
+		switch segment.Name {
+		case "OBR":
+			// Child orders continue the culture of their parent
+			parentID = segment.Component(26, 2)
+			if parentID != "" && order != nil {
+				continue
+			}
+			order, err = ingester.newOrder(msh, pid, segment, facility, birthDate)
+			if err != nil {
+				return nil, err
+			}
+			// Ward and patient class of the visit in PV1-3 and PV1-2
+			if pv1 != nil {
+				order.culturePB.Ward = pv1.Component(3, 1)
+				order.culturePB.PatientSetting = hl7PatientSettings[pv1.Field(2)]
+			}
+			orders = append(orders, order)
+		case "SPM":
+			if order != nil && segment.Component(4, 1) != "" {
+				order.culturePB.CultureSource = ingester.specimen(segment, 4)
+			}
+		case "OBX":
+			if order == nil {
+				return nil, errs.WrapMessage(codes.InvalidArgument, "OBX segment before OBR segment")
+			}
+			err = ingester.addObservation(order, segment, parentID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	resultOrders := make([]*hl7Order, 0, len(orders))
+
+	for _, order := range orders {
+		switch {
+		case !order.reported:
+			continue
+		case len(order.culturePB.CultureResults) > 0:
+		case isFinalStatus(order.culturePB.Status) || len(order.organisms) == 0:
+			continue
+		default:
+			// Organisms identified before susceptibility testing
+			for _, organism := range order.organisms {
+				order.culturePB.PathogensFound = appendUnique(order.culturePB.PathogensFound, organism.ID)
+			}
+			sort.Strings(order.culturePB.PathogensFound)
+		}
+		for _, cultureResult := range order.culturePB.CultureResults {
+			organism, err := order.organism(order.isolates[cultureResult])
+			if err != nil {
+				return nil, err
+			}
+			cultureResult.PathogenId = organism.ID
+			cultureResult.PathogenName = organism.Name
+			order.culturePB.PathogensFound = appendUnique(order.culturePB.PathogensFound, organism.ID)
+			order.culturePB.AntimicrobialsUsed = appendUnique(order.culturePB.AntimicrobialsUsed, cultureResult.AntimicrobialId)
+		}
+		resultOrders = append(resultOrders, order)
+	}
+
+	return resultOrders, nil
+}
+
+func (ingester *HL7Ingester) newOrder(
+	msh, pid, obr *hl7.Segment, facility *HL7Facility, birthDate time.Time,
+) (*hl7Order, error) {
+	var (
+		resultsTime time.Time
+		err         error
+	)
+	// Results report time, then observation time, then message time
+	for _, value := range []string{obr.Field(22), obr.Field(7), msh.Field(7)} {
+		if value == "" {
+			continue
+		}
+		resultsTime, err = hl7.ParseTime(value)
+		if err != nil {
+			return nil, errs.WrapErrWithMessage(codes.InvalidArgument, err, "results time")
+		}
+		break
+	}
+	if resultsTime.IsZero() {
+		return nil, errs.MissingField("results time")
+	}
+
+	// Specimen collection time is the OBR-7 observation time
+	var collectedTimestampSec int64
+	if obr.Field(7) != "" {
+		collectedTime, err := hl7.ParseTime(obr.Field(7))
+		if err != nil {
+			return nil, errs.WrapErrWithMessage(codes.InvalidArgument, err, "OBR-7 observation time")
+		}
+		collectedTimestampSec = collectedTime.Unix()
+	}
+
+	labTechID := obr.Component(34, 1)
+	if labTechID == "" {
+		labTechID = ingester.codeTables.DefaultLabTechID
+	}
+
+	cultureStatus, reported := hl7ResultStatuses[obr.Field(25)]
+
+	return &hl7Order{
+		culturePB: &culture.Culture{
+			LabTechId:           labTechID,
+			HospitalId:          facility.HospitalID,
+			CountyCode:          facility.CountyCode,
+			SubCountyCode:       facility.SubCountyCode,
+			PatientId:           pid.Component(3, 1),
+			PatientGender:       parseGender(pid.Field(8)),
+			PatientAge:          ageAt(birthDate, resultsTime),
+			CultureSource:       ingester.specimen(obr, 15),
+			ResultsTimestampSec: resultsTime.Unix(),
+			Status:              cultureStatus,
+
+			SpecimenCollectedTimestampSec: collectedTimestampSec,
+		},
+		organisms:         make(map[string]*HL7Code),
+		isolates:          make(map[*culture.LabTestResult]string),
+		fillerOrderNumber: obr.Component(3, 1),
+		reported:          reported,
+		corrected:         obr.Field(25) == hl7CorrectedStatus,
+	}, nil
+}
+
+// ageAt returns age in whole years. Ages under a year are rounded up to one year as in imports.
+func ageAt(birthDate, date time.Time) int32 {
+	age := date.Year() - birthDate.Year()
+	if date.Month() < birthDate.Month() || date.Month() == birthDate.Month() && date.Day() < birthDate.Day() {
+		age--
+	}
+	if age < 1 {
+		age = 1
+	}
+	return int32(age)
+}
+
+// specimen returns the name of the specimen source code in the field
+func (ingester *HL7Ingester) specimen(segment *hl7.Segment, position int) string {
+	code := segment.Component(position, 1)
+	if name, ok := ingester.codeTables.Specimens[code]; ok {
+		return name
+	}
+	if text := segment.Component(position, 2); text != "" {
+		return text
+	}
+	return code
+}
+
+func (ingester *HL7Ingester) isOrganismObservation(code string) bool {
+	for _, observation := range ingester.codeTables.OrganismObservations {
+		if observation == code {
+			return true
+		}
+	}
+	return false
+}
+
+// addObservation adds an organism or a susceptibility result to the order
+func (ingester *HL7Ingester) addObservation(order *hl7Order, obx *hl7.Segment, parentID string) error {
+	// Observations that could not be obtained or were deleted are skipped
+	switch obx.Field(11) {
+	case "X", "D":
+		return nil
+	}
+
+	code := obx.Component(3, 1)
+
+	if ingester.isOrganismObservation(code) {
+		organism, ok := ingester.codeTables.Organisms[obx.Component(5, 1)]
+		if !ok {
+			return errs.WrapMessage(codes.InvalidArgument, "unknown organism code "+obx.Component(5, 1))
+		}
+		order.organisms[obx.Field(4)] = organism
+		return nil
+	}
+
+	antimicrobial, ok := ingester.codeTables.Antimicrobials[code]
+	if !ok {
+		// Other observations such as gram stains are not results
+		return nil
+	}
+
+	// Structured numeric values have the comparator and number in separate components
+	value := obx.Field(5)
+	if obx.Field(2) == "SN" {
+		value = obx.Component(5, 1) + obx.Component(5, 2)
+	}
+
+	number, comparator, err := breakpoints.ParseMeasurement(value)
+	if err != nil {
+		return errs.WrapErrWithMessage(codes.InvalidArgument, err, "susceptibility of "+antimicrobial.Name)
+	}
+
+	unit := obx.Component(6, 1)
+	testMethod := culture.TestMethod(culture.TestMethod_value[antimicrobial.TestMethod])
+	if testMethod == culture.TestMethod_TEST_METHOD_UNSPECIFIED {
+		testMethod = culture.TestMethod_BROTH_MICRODILUTION
+		if strings.EqualFold(unit, "mm") {
+			testMethod = culture.TestMethod_DISK_DIFFUSION
+		}
+	}
+
+	// A culture has one test method
+	if len(order.culturePB.CultureResults) == 0 {
+		order.culturePB.TestMethod = testMethod
+	} else if order.culturePB.TestMethod != testMethod {
+		return errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf(
+			"results use test methods %s and %s", order.culturePB.TestMethod, testMethod,
+		))
+	}
+
+	cultureResult := &culture.LabTestResult{
+		AntimicrobialId:   antimicrobial.ID,
+		AntimicrobialName: antimicrobial.Name,
+	}
+	if measuresMIC(testMethod) {
+		cultureResult.Mic = &culture.MIC{Value: number, Comparator: importComparators[comparator], Unit: unit}
+	} else {
+		cultureResult.DiskDiameter = fmt.Sprint(number)
+	}
+
+	label, labeled := importLabels[strings.ToUpper(obx.Field(8))]
+	cultureResult.Label = label
+
+	isolateID := obx.Field(4)
+	if parentID != "" {
+		isolateID = parentID
+	}
+
+	order.culturePB.CultureResults = append(order.culturePB.CultureResults, cultureResult)
+	order.isolates[cultureResult] = isolateID
+	order.labeled = append(order.labeled, labeled)
+
+	return nil
+}
+
+// organism returns the organism of the isolate. Orders with one organism need not link results to it.
+func (order *hl7Order) organism(isolateID string) (*HL7Code, error) {
+	if organism, ok := order.organisms[isolateID]; ok {
+		return organism, nil
+	}
+	if len(order.organisms) == 1 {
+		for _, organism := range order.organisms {
+			return organism, nil
+		}
+	}
+	return nil, errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("no organism of isolate %q", isolateID))
+}
+
+// ServeMLLP receives messages framed with MLLP from connections of the listener until the context is done.
+// MLLP has no authentication so the listener must be bound to a loopback address. Remote senders connect
+// through a proxy that authenticates them, e.g a TLS tunnel with client certificates.
+func (ingester *HL7Ingester) ServeMLLP(ctx context.Context, listener net.Listener) error {
+	if !isLoopback(listener.Addr()) {
+		return errs.WrapMessage(
+			codes.FailedPrecondition, "mllp listener must be bound to a loopback address not "+listener.Addr().String(),
+		)
+	}
+
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errs.CtxCancelled(ctx) {
+				return nil
+			}
+			return err
+		}
+		go ingester.serveConn(ctx, conn)
+	}
+}
+
+func isLoopback(addr net.Addr) bool {
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		return addr.IP.IsLoopback()
+	case *net.UnixAddr:
+		return true
+	}
+	return false
+}
+
+func (ingester *HL7Ingester) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	// A malformed message must not stop the service
+	defer func() {
+		if err := recover(); err != nil {
+			ingester.capi.logger.Errorf("recovered from panic serving mllp connection from %s: %v", conn.RemoteAddr(), err)
+		}
+	}()
+
+	reader := bufio.NewReader(conn)
+	for {
+		conn.SetReadDeadline(time.Now().Add(mllpIdleTimeout))
+		_, err := reader.Peek(1)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				ingester.capi.logger.Warningf("failed to read mllp message from %s: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+
+		conn.SetReadDeadline(time.Now().Add(mllpReadTimeout))
+		data, err := hl7.ReadMLLP(reader, maxHL7MessageSize)
+		switch {
+		case errors.Is(err, hl7.ErrFrameTooLarge):
+			// The rest of the frame is not read so the connection is closed
+			conn.SetWriteDeadline(time.Now().Add(mllpWriteTimeout))
+			hl7.WriteMLLP(conn, hl7.NewACK(nil, hl7.AR, "message is larger than 1 MiB"))
+			return
+		case err != nil:
+			if !errors.Is(err, io.EOF) {
+				ingester.capi.logger.Warningf("failed to read mllp message from %s: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+
+		ack := ingester.Ingest(ctx, data)
+
+		conn.SetWriteDeadline(time.Now().Add(mllpWriteTimeout))
+		err = hl7.WriteMLLP(conn, ack)
+		if err != nil {
+			ingester.capi.logger.Warningf("failed to write mllp acknowledgment to %s: %v", conn.RemoteAddr(), err)
+			return
+		}
+	}
+}
+
+// ServeHTTP receives a message in the body of a POST request and responds with its acknowledgment
+func (ingester *HL7Ingester) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Authorize request
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	payload, err := ingester.capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
+	if err != nil {
+		code := http.StatusUnauthorized
+		if status.Code(err) == codes.PermissionDenied {
+			code = http.StatusForbidden
+		}
+		http.Error(w, status.Convert(err).Message(), code)
+		return
+	}
+
+	// Messages over the limit are rejected rather than truncated
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, maxHL7MessageSize+1))
+	if err != nil {
+		http.Error(w, "failed to read message", http.StatusBadRequest)
+		return
+	}
+	if len(data) > maxHL7MessageSize {
+		http.Error(w, "message is larger than 1 MiB", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	w.Header().Set("Content-Type", "x-application/hl7-v2+er7")
+	w.Write(ingester.ingest(ctx, data, payload))
+}
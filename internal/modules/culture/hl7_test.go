@@ -0,0 +1,284 @@
+package culture
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"github.com/Pallinder/go-randomdata"
+	authmocks "github.com/gidyon/antibug/internal/mocks/mocks"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/internal/pkg/hl7"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"time"
+)
+
+const (
+	hl7CodeTablesFile = "../../../configs/hl7/code-tables.json"
+	hl7SamplesDir     = "../../../configs/hl7/samples"
+)
+
+func readHL7Sample(name string) []byte {
+	data, err := ioutil.ReadFile(filepath.Join(hl7SamplesDir, name))
+	Expect(err).ShouldNot(HaveOccurred())
+	return data
+}
+
+func ackCode(ack []byte) string {
+	message, err := hl7.Parse(ack)
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(message.Segment("MSA")).ShouldNot(BeNil())
+	return message.Segment("MSA").Field(1)
+}
+
+var _ = Describe("Ingesting HL7 ORU^R01 messages #hl7", func() {
+	var (
+		ingester *HL7Ingester
+		ctx      context.Context
+	)
+
+	// orderCultures returns cultures of the first order of the message
+	orderCultures := func(data []byte) []*Culture {
+		message, err := hl7.Parse(data)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		orders, err := ingester.parseORU(message)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(orders).ShouldNot(BeEmpty())
+
+		culturesDB := make([]*Culture, 0)
+		err = CultureServer.sqlDB.Unscoped().
+			Find(&culturesDB, "idempotency_key=?", hl7OrderKey(message, orders[0], 0)).Error
+		Expect(err).ShouldNot(HaveOccurred())
+		return culturesDB
+	}
+
+	BeforeEach(func() {
+		codeTables, err := LoadHL7CodeTables(hl7CodeTablesFile)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		ingester, err = NewHL7Ingester(CultureAPI, codeTables)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	Describe("Ingesting malformed messages", func() {
+		It("should reject messages that are not HL7", func() {
+			Expect(ackCode(ingester.Ingest(ctx, []byte("hello")))).Should(Equal(hl7.AR))
+		})
+		It("should reject messages other than ORU^R01", func() {
+			message := "MSH|^~\\&|LIS|KNH|ANTIBUG|MOH|20200301103000||ADT^A01|MSG00003|P|2.5.1\rPID|1||PAT-1\r"
+			Expect(ackCode(ingester.Ingest(ctx, []byte(message)))).Should(Equal(hl7.AR))
+		})
+		It("should fail when sending facility is not in code tables", func() {
+			message := bytes.Replace(readHL7Sample("oru-r01-disk-diffusion.hl7"), []byte("|KNH|"), []byte("|UNKNOWN|"), 1)
+			Expect(ackCode(ingester.Ingest(ctx, message))).Should(Equal(hl7.AE))
+		})
+		It("should fail when organism code is not in code tables", func() {
+			message := bytes.Replace(readHL7Sample("oru-r01-disk-diffusion.hl7"), []byte("112283007"), []byte("1"), 1)
+			Expect(ackCode(ingester.Ingest(ctx, message))).Should(Equal(hl7.AE))
+		})
+	})
+
+	Describe("Ingesting well-formed messages", func() {
+		It("should map disk diffusion results to a culture", func() {
+			message, err := hl7.Parse(readHL7Sample("oru-r01-disk-diffusion.hl7"))
+			Expect(err).ShouldNot(HaveOccurred())
+
+			orders, err := ingester.parseORU(message)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(orders).Should(HaveLen(1))
+			Expect(orders[0].fillerOrderNumber).Should(Equal("FIL-1"))
+
+			culturesPB := []*culture.Culture{orders[0].culturePB}
+			Expect(culturesPB[0].HospitalId).Should(Equal("KNH"))
+			Expect(culturesPB[0].PatientGender).Should(Equal("female"))
+			Expect(culturesPB[0].CultureSource).Should(Equal("Blood"))
+			Expect(culturesPB[0].LabTechId).Should(Equal("TECH-7"))
+			Expect(culturesPB[0].Ward).Should(Equal("ICU"))
+			Expect(culturesPB[0].PatientSetting).Should(Equal(culture.PatientSetting_INPATIENT))
+			Expect(culturesPB[0].SpecimenCollectedTimestampSec).Should(
+				Equal(time.Date(2020, 2, 28, 9, 0, 0, 0, time.UTC).Unix()),
+			)
+			Expect(culturesPB[0].PathogensFound).Should(ConsistOf("eco"))
+			Expect(culturesPB[0].CultureResults).Should(HaveLen(3))
+			Expect(culturesPB[0].CultureResults[0].DiskDiameter).Should(Equal("18"))
+		})
+		It("should map orders with preliminary results to preliminary cultures", func() {
+			data := bytes.Replace(readHL7Sample("oru-r01-disk-diffusion.hl7"), []byte("|||F|||"), []byte("|||P|||"), 1)
+			message, err := hl7.Parse(data)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			orders, err := ingester.parseORU(message)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(orders).Should(HaveLen(1))
+			Expect(orders[0].culturePB.Status).Should(Equal(culture.CultureStatus_PRELIMINARY))
+			Expect(orders[0].culturePB.CultureResults).Should(HaveLen(3))
+		})
+		It("should map organisms identified before susceptibility testing to preliminary cultures", func() {
+			segments := bytes.Split(readHL7Sample("oru-r01-disk-diffusion.hl7"), []byte("\r"))
+			kept := make([][]byte, 0, len(segments))
+			for _, segment := range segments {
+				if !bytes.Contains(segment, []byte("[Susceptibility]")) {
+					kept = append(kept, segment)
+				}
+			}
+			preliminary := bytes.Replace(bytes.Join(kept, []byte("\r")), []byte("|||F|||"), []byte("|||P|||"), 1)
+
+			message, err := hl7.Parse(preliminary)
+			Expect(err).ShouldNot(HaveOccurred())
+			orders, err := ingester.parseORU(message)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(orders).Should(HaveLen(1))
+			Expect(orders[0].culturePB.PathogensFound).Should(ConsistOf("eco"))
+			Expect(orders[0].culturePB.CultureResults).Should(BeEmpty())
+
+			// Final results need susceptibility results
+			message, err = hl7.Parse(bytes.Join(kept, []byte("\r")))
+			Expect(err).ShouldNot(HaveOccurred())
+			orders, err = ingester.parseORU(message)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(orders).Should(BeEmpty())
+		})
+		It("should skip orders that were cancelled", func() {
+			data := bytes.Replace(readHL7Sample("oru-r01-disk-diffusion.hl7"), []byte("|||F|||"), []byte("|||X|||"), 1)
+			message, err := hl7.Parse(data)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			orders, err := ingester.parseORU(message)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(orders).Should(BeEmpty())
+		})
+		It("should store disk diffusion results and acknowledge", func() {
+			Expect(ackCode(ingester.Ingest(ctx, readHL7Sample("oru-r01-disk-diffusion.hl7")))).Should(Equal(hl7.AA))
+		})
+		It("should store MIC results of child orders and acknowledge", func() {
+			Expect(ackCode(ingester.Ingest(ctx, readHL7Sample("oru-r01-mic.hl7")))).Should(Equal(hl7.AA))
+		})
+		It("should skip cultures of re-sent messages", func() {
+			data := readHL7Sample("oru-r01-disk-diffusion.hl7")
+			Expect(ackCode(ingester.Ingest(ctx, data))).Should(Equal(hl7.AA))
+			Expect(ackCode(ingester.Ingest(ctx, data))).Should(Equal(hl7.AA))
+
+			Expect(orderCultures(data)).Should(HaveLen(1))
+		})
+		It("should replace preliminary results with final results of the order", func() {
+			data := readHL7Sample("oru-r01-disk-diffusion.hl7")
+			data = bytes.Replace(data, []byte("PAT-1001"), []byte("PAT-"+randomdata.RandStringRunes(10)), 1)
+			data = bytes.Replace(data, []byte("|FIL-1|"), []byte("|FIL-"+randomdata.RandStringRunes(10)+"|"), 1)
+			preliminary := bytes.Replace(data, []byte("|||F|||"), []byte("|||P|||"), 1)
+
+			cultureStatus := func() culture.CultureStatus {
+				culturesDB := orderCultures(data)
+				Expect(culturesDB).Should(HaveLen(1))
+				return culture.CultureStatus(culture.CultureStatus_value[culturesDB[0].Status])
+			}
+
+			Expect(ackCode(ingester.Ingest(ctx, preliminary))).Should(Equal(hl7.AA))
+			Expect(cultureStatus()).Should(Equal(culture.CultureStatus_PRELIMINARY))
+
+			Expect(ackCode(ingester.Ingest(ctx, data))).Should(Equal(hl7.AA))
+			Expect(cultureStatus()).Should(Equal(culture.CultureStatus_FINAL))
+
+			// Preliminary results arriving late do not replace final results
+			Expect(ackCode(ingester.Ingest(ctx, preliminary))).Should(Equal(hl7.AA))
+			Expect(cultureStatus()).Should(Equal(culture.CultureStatus_FINAL))
+		})
+		It("should amend cultures of orders with corrected results", func() {
+			data := readHL7Sample("oru-r01-disk-diffusion.hl7")
+			Expect(ackCode(ingester.Ingest(ctx, data))).Should(Equal(hl7.AA))
+
+			corrected := bytes.Replace(data, []byte("|||F|||"), []byte("|||C|||"), 1)
+			corrected = bytes.Replace(corrected, []byte("|MSG00001|"), []byte("|MSG00001C|"), 1)
+			corrected = bytes.Replace(corrected, []byte("|1|18|mm||R|"), []byte("|1|30|mm||S|"), 1)
+			Expect(ackCode(ingester.Ingest(ctx, corrected))).Should(Equal(hl7.AA))
+
+			culturesDB := orderCultures(corrected)
+			Expect(culturesDB).Should(HaveLen(1))
+
+			culturePB, err := getCulturePB(culturesDB[0])
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(culturePB.Status).Should(Equal(culture.CultureStatus_AMENDED))
+			Expect(culturePB.CultureResults[0].DiskDiameter).Should(Equal("30"))
+		})
+		It("should acknowledge messages received over http", func() {
+			req := httptest.NewRequest(http.MethodPost, "/api/antibug/cultures/hl7", bytes.NewReader(readHL7Sample("oru-r01-mic.hl7")))
+			w := httptest.NewRecorder()
+			ingester.ServeHTTP(w, req)
+			Expect(w.Code).Should(Equal(http.StatusOK))
+			Expect(ackCode(w.Body.Bytes())).Should(Equal(hl7.AA))
+		})
+		It("should acknowledge messages received over mllp", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			go ingester.ServeMLLP(ctx, listener)
+
+			conn, err := net.Dial("tcp", listener.Addr().String())
+			Expect(err).ShouldNot(HaveOccurred())
+			defer conn.Close()
+
+			Expect(hl7.WriteMLLP(conn, readHL7Sample("oru-r01-disk-diffusion.hl7"))).ShouldNot(HaveOccurred())
+			ack, err := hl7.ReadMLLP(bufio.NewReader(conn), maxHL7MessageSize)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ackCode(ack)).Should(Equal(hl7.AA))
+		})
+		It("should reject messages larger than the limit received over http", func() {
+			data := append(readHL7Sample("oru-r01-mic.hl7"), bytes.Repeat([]byte(" "), maxHL7MessageSize)...)
+			req := httptest.NewRequest(http.MethodPost, "/api/antibug/cultures/hl7", bytes.NewReader(data))
+			w := httptest.NewRecorder()
+			ingester.ServeHTTP(w, req)
+			Expect(w.Code).Should(Equal(http.StatusRequestEntityTooLarge))
+		})
+		It("should forbid senders without permission over http", func() {
+			authAPI := &authmocks.AuthAPIMock{}
+			authAPI.On("AuthorizeGroup", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, errs.PermissionDenied("AuthorizeGroup"))
+			server := *CultureServer
+			server.authAPI = authAPI
+			forbiddenIngester, err := NewHL7Ingester(&server, ingester.codeTables)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			req := httptest.NewRequest(http.MethodPost, "/api/antibug/cultures/hl7", bytes.NewReader(readHL7Sample("oru-r01-mic.hl7")))
+			w := httptest.NewRecorder()
+			forbiddenIngester.ServeHTTP(w, req)
+			Expect(w.Code).Should(Equal(http.StatusForbidden))
+		})
+		It("should reject messages larger than the limit received over mllp", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			go ingester.ServeMLLP(ctx, listener)
+
+			conn, err := net.Dial("tcp", listener.Addr().String())
+			Expect(err).ShouldNot(HaveOccurred())
+			defer conn.Close()
+
+			data := append(readHL7Sample("oru-r01-mic.hl7"), bytes.Repeat([]byte(" "), maxHL7MessageSize)...)
+			Expect(hl7.WriteMLLP(conn, data)).ShouldNot(HaveOccurred())
+			ack, err := hl7.ReadMLLP(bufio.NewReader(conn), maxHL7MessageSize)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(ackCode(ack)).Should(Equal(hl7.AR))
+		})
+		It("should not serve mllp on addresses other than loopback", func() {
+			listener, err := net.Listen("tcp", ":0")
+			Expect(err).ShouldNot(HaveOccurred())
+			defer listener.Close()
+
+			err = ingester.ServeMLLP(ctx, listener)
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+	})
+})
@@ -0,0 +1,287 @@
+package culture
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+const (
+	defaultImportBatchSize = 100
+	maxImportBatchSize     = 1000
+	// Reports of large files with many malformed rows are truncated
+	maxImportErrors = 1000
+)
+
+// importRow is a prepared culture and the row it was read from
+type importRow struct {
+	row       int64
+	culturePB *culture.Culture
+}
+
+// importReport collects outcomes of rows of an import
+type importReport struct {
+	*culture.ImportCulturesResponse
+}
+
+func (report *importReport) fail(row int64, err error) {
+	report.RowsFailed++
+	if len(report.Errors) < maxImportErrors {
+		report.Errors = append(report.Errors, &culture.ImportRowError{
+			Row:     row,
+			Message: status.Convert(err).Message(),
+		})
+	}
+}
+
+// chunkReader reads chunks of the file from the stream
+type chunkReader struct {
+	stream culture.CultureAPI_ImportCulturesServer
+	buffer []byte
+}
+
+func (reader *chunkReader) Read(p []byte) (int, error) {
+	for len(reader.buffer) == 0 {
+		importReq, err := reader.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if importReq.GetOptions() != nil {
+			return 0, errs.WrapMessage(codes.InvalidArgument, "import options must be the first message")
+		}
+		reader.buffer = importReq.GetChunk()
+	}
+	n := copy(p, reader.buffer)
+	reader.buffer = reader.buffer[n:]
+	return n, nil
+}
+
+func (capi *cultureAPIServer) ImportCultures(stream culture.CultureAPI_ImportCulturesServer) error {
+	// Request must not be nil
+	if stream == nil {
+		return errs.NilObject("ImportCulturesServer")
+	}
+
+	ctx := stream.Context()
+
+	// Authorize request
+	payload, err := capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
+	if err != nil {
+		return err
+	}
+
+	// The first message configures the import
+	importReq, err := stream.Recv()
+	if err != nil {
+		return errs.WrapErrWithMessage(codes.InvalidArgument, err, "failed to receive import options")
+	}
+
+	// Validation
+	options := importReq.GetOptions()
+	switch {
+	case options == nil:
+		err = errs.NilObject("import options")
+	case options.BatchSize < 0 || options.BatchSize > maxImportBatchSize:
+		err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("batch size must be between 0 and %d", maxImportBatchSize))
+	case culture.ImportFormat_name[int32(options.Format)] == "":
+		err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("unknown import format %d", options.Format))
+	}
+	if err != nil {
+		return err
+	}
+
+	batchSize := int(options.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultImportBatchSize
+	}
+
+	reader := csv.NewReader(&chunkReader{stream: stream, buffer: importReq.GetChunk()})
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	if options.Format == culture.ImportFormat_WHONET {
+		reader.Comma = '\t'
+		reader.LazyQuotes = true
+	}
+
+	header, err := reader.Read()
+	switch {
+	case err == nil:
+	case errors.Is(err, io.EOF):
+		return errs.MissingField("import file")
+	default:
+		return errs.WrapErrWithMessage(codes.InvalidArgument, err, "failed to read header")
+	}
+
+	parser, err := newImportParser(options.Format, options.Mapping, append([]string{}, header...))
+	if err != nil {
+		return err
+	}
+
+	var (
+		report = &importReport{&culture.ImportCulturesResponse{Errors: make([]*culture.ImportRowError, 0)}}
+		batch  = make([]*importRow, 0, batchSize)
+		row    = int64(1)
+	)
+
+	for {
+		if errs.CtxCancelled(ctx) {
+			return errs.CtxError(ctx, "importing cultures")
+		}
+
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		row++
+
+		if err != nil {
+			// Malformed rows are skipped, other errors fail the import
+			parseErr := &csv.ParseError{}
+			if !errors.As(err, &parseErr) {
+				return err
+			}
+			report.RowsRead++
+			report.fail(row, errs.WrapErrWithMessage(codes.InvalidArgument, err, "malformed row"))
+			continue
+		}
+
+		report.RowsRead++
+
+		culturePB, err := capi.prepareImportRow(parser, record)
+		if err == nil {
+			err = auth.AuthorizeFacility(payload, culturePB.HospitalId)
+		}
+		if err != nil {
+			report.fail(row, err)
+			continue
+		}
+
+		batch = append(batch, &importRow{row: row, culturePB: culturePB})
+		if len(batch) == batchSize {
+			capi.importBatch(ctx, batch, report, payload.ID, options.Format)
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		capi.importBatch(ctx, batch, report, payload.ID, options.Format)
+	}
+
+	return stream.SendAndClose(report.ImportCulturesResponse)
+}
+
+// prepareImportRow converts a record to a culture and prepares it the same way as created cultures
+func (capi *cultureAPIServer) prepareImportRow(parser *importParser, record []string) (*culture.Culture, error) {
+	culturePB, labeled, err := parser.parse(record)
+	if err != nil {
+		return nil, err
+	}
+
+	err = capi.prepareCulture(culturePB)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkLabels(culturePB, labeled)
+	if err != nil {
+		return nil, err
+	}
+
+	return culturePB, nil
+}
+
+// checkLabels checks that interpreted results without breakpoints had a label in the source.
+// Such results keep their label which would otherwise default to susceptible.
+func checkLabels(culturePB *culture.Culture, labeled []bool) error {
+	for index, cultureResult := range culturePB.CultureResults {
+		if cultureResult.BreakpointVersion == "" && !labeled[index] {
+			return errs.MissingField(fmt.Sprintf(
+				"label of %s against %s without breakpoints", cultureResult.PathogenName, cultureResult.AntimicrobialName,
+			))
+		}
+	}
+	return nil
+}
+
+// importBatch saves cultures of a batch in a transaction. Rows duplicating saved cultures or earlier rows
+// are reported as failed and skipped. Rows of a batch failing otherwise are all reported as failed.
+func (capi *cultureAPIServer) importBatch(
+	ctx context.Context, batch []*importRow, report *importReport, actorID string, format culture.ImportFormat,
+) {
+	// Duplicate rows are already reported
+	duplicates := make(map[int64]bool)
+	failBatch := func(err error) {
+		for _, row := range batch {
+			if !duplicates[row.row] {
+				report.fail(row.row, err)
+			}
+		}
+	}
+
+	tx := capi.sqlDB.Begin()
+	if tx.Error != nil {
+		failBatch(errs.SQLQueryFailed(tx.Error, "BEGIN"))
+		return
+	}
+
+	culturesPB := make([]*culture.Culture, 0, len(batch))
+	for _, row := range batch {
+		culturesPB = append(culturesPB, row.culturePB)
+	}
+	err := lockPatientCultures(tx, culturesPB...)
+	if err != nil {
+		tx.Rollback()
+		failBatch(err)
+		return
+	}
+
+	var (
+		imported   = make([]*importRow, 0, len(batch))
+		cultureIDs = make([]string, 0, len(batch))
+	)
+	for _, row := range batch {
+		// Duplicates are checked before saving so that they do not fail other rows of the batch
+		err := checkDuplicateCulture(tx, row.culturePB)
+		if status.Code(err) == codes.AlreadyExists {
+			duplicates[row.row] = true
+			report.fail(row.row, err)
+			continue
+		}
+		if err != nil {
+			tx.Rollback()
+			failBatch(err)
+			return
+		}
+
+		cultureDB, err := createCulture(
+			tx, row.culturePB, "", true, actorID,
+			fmt.Sprintf("imported from %s file row %d", format, row.row),
+		)
+		if err != nil {
+			tx.Rollback()
+			failBatch(err)
+			return
+		}
+		imported = append(imported, row)
+		cultureIDs = append(cultureIDs, fmt.Sprint(cultureDB.ID))
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		failBatch(errs.SQLQueryFailed(err, "COMMIT"))
+		return
+	}
+
+	report.RowsImported += int64(len(imported))
+
+	for index, row := range imported {
+		capi.publishChange(ctx, newChangeEvent(OperationCreate, cultureIDs[index], row.culturePB))
+	}
+}
@@ -0,0 +1,153 @@
+package culture
+
+import (
+	"context"
+	"fmt"
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+)
+
+// importStream is a client stream of an import file
+type importStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*culture.ImportCulturesRequest
+	response *culture.ImportCulturesResponse
+}
+
+func (stream *importStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *importStream) Recv() (*culture.ImportCulturesRequest, error) {
+	if len(stream.requests) == 0 {
+		return nil, io.EOF
+	}
+	importReq := stream.requests[0]
+	stream.requests = stream.requests[1:]
+	return importReq, nil
+}
+
+func (stream *importStream) SendAndClose(importRes *culture.ImportCulturesResponse) error {
+	stream.response = importRes
+	return nil
+}
+
+func newImportStream(options *culture.ImportOptions, chunks ...string) *importStream {
+	stream := &importStream{
+		ctx: context.Background(),
+		requests: []*culture.ImportCulturesRequest{
+			{Payload: &culture.ImportCulturesRequest_Options{Options: options}},
+		},
+	}
+	for _, chunk := range chunks {
+		stream.requests = append(stream.requests, &culture.ImportCulturesRequest{
+			Payload: &culture.ImportCulturesRequest_Chunk{Chunk: []byte(chunk)},
+		})
+	}
+	return stream
+}
+
+var _ = Describe("Importing cultures #import", func() {
+	var mapping *culture.ImportMapping
+
+	BeforeEach(func() {
+		mapping = &culture.ImportMapping{
+			Fields: map[string]string{
+				"hospital_id":    "Facility",
+				"patient_id":     "Patient",
+				"patient_gender": "Sex",
+				"patient_age":    "Age",
+				"culture_source": "Specimen",
+				"results_date":   "Date",
+				"pathogen_id":    "Organism",
+			},
+			Defaults: map[string]string{
+				"lab_tech_id":     LabTechID(),
+				"county_code":     CountyCode(),
+				"sub_county_code": SubCountyCode(),
+			},
+			ResultColumns: []*culture.ImportResultColumn{
+				{Column: "Ceftriaxone", AntimicrobialId: "CRO", AntimicrobialName: "Ceftriaxone"},
+				{Column: "Gentamicin", AntimicrobialId: "GEN", AntimicrobialName: "Gentamicin"},
+			},
+			PathogenNames: map[string]string{"eco": "Escherichia coli"},
+		}
+	})
+
+	Describe("Importing cultures with malformed request", func() {
+		It("should fail when import options are missing", func() {
+			stream := newImportStream(nil, "Facility\n")
+			err := CultureAPI.ImportCultures(stream)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+		It("should fail when mapped column is not in the file", func() {
+			stream := newImportStream(&culture.ImportOptions{Mapping: mapping}, "Facility,Patient\n")
+			err := CultureAPI.ImportCultures(stream)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+		It("should fail when batch size is negative", func() {
+			stream := newImportStream(&culture.ImportOptions{Mapping: mapping, BatchSize: -1})
+			err := CultureAPI.ImportCultures(stream)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Importing cultures with well-formed request", func() {
+		It("should import valid rows and report malformed rows", func() {
+			stream := newImportStream(
+				&culture.ImportOptions{Mapping: mapping, BatchSize: 1},
+				"Facility,Patient,Sex,Age,Specimen,Date,Organism,Ceftriaxone,Gentamicin\n",
+				"KNH,P-1,F,34,Urine,2020-03-01,eco,18,15 R\n",
+				"KNH,P-2,M,,Blood,2020-03-02,eco,25,20 S\n",
+				"KNH,P-3,M,50,Blood,2020-03-02,eco,wide,20 S\n",
+				"KNH,P-4,M,50,Blood,2020-03-03,eco,30,\n",
+			)
+			err := CultureAPI.ImportCultures(stream)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.response.RowsRead).Should(BeEquivalentTo(4))
+			Expect(stream.response.RowsImported).Should(BeEquivalentTo(2))
+			Expect(stream.response.RowsFailed).Should(BeEquivalentTo(2))
+			Expect(stream.response.Errors).Should(HaveLen(2))
+			Expect(stream.response.Errors[0].Row).Should(BeEquivalentTo(3))
+			Expect(stream.response.Errors[1].Row).Should(BeEquivalentTo(4))
+		})
+		It("should report duplicate rows without failing their batch", func() {
+			patientID := randomdata.RandStringRunes(12)
+			stream := newImportStream(
+				&culture.ImportOptions{Mapping: mapping, BatchSize: 10},
+				"Facility,Patient,Sex,Age,Specimen,Date,Organism,Ceftriaxone,Gentamicin\n",
+				fmt.Sprintf("KNH,%s,F,34,Urine,2020-03-05,eco,18,15 R\n", patientID),
+				fmt.Sprintf("KNH,%s,F,34,Blood,2020-03-05,eco,25,20 S\n", patientID),
+				fmt.Sprintf("KNH,%s,F,34,Urine,2020-03-05,eco,19,15 R\n", patientID),
+			)
+			err := CultureAPI.ImportCultures(stream)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.response.RowsImported).Should(BeEquivalentTo(2))
+			Expect(stream.response.RowsFailed).Should(BeEquivalentTo(1))
+			Expect(stream.response.Errors).Should(HaveLen(1))
+			Expect(stream.response.Errors[0].Row).Should(BeEquivalentTo(4))
+		})
+		It("should import WHONET files using WHONET column names", func() {
+			stream := newImportStream(
+				&culture.ImportOptions{
+					Format:  culture.ImportFormat_WHONET,
+					Mapping: &culture.ImportMapping{Defaults: mapping.Defaults},
+				},
+				"LABORATORY\tPATIENT_ID\tSEX\tAGE\tSPEC_TYPE\tSPEC_DATE\tORGANISM\tCRO_NM\tGEN_NM\n",
+				"KNH\tP-5\tf\t3m\tur\t2020-03-04\teco\t<=0.25\t>=16\n",
+			)
+			err := CultureAPI.ImportCultures(stream)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.response.RowsImported).Should(BeEquivalentTo(1))
+			Expect(stream.response.Errors).Should(BeEmpty())
+		})
+	})
+})
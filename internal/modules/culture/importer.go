@@ -0,0 +1,419 @@
+package culture
+
+import (
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/breakpoints"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fields of cultures that can be mapped to columns of an import file
+const (
+	fieldLabTechID     = "lab_tech_id"
+	fieldHospitalID    = "hospital_id"
+	fieldCountyCode    = "county_code"
+	fieldSubCountyCode = "sub_county_code"
+	fieldPatientID     = "patient_id"
+	fieldPatientGender = "patient_gender"
+	fieldPatientAge    = "patient_age"
+	fieldCultureSource = "culture_source"
+	fieldResultsDate   = "results_date"
+	fieldPathogenID    = "pathogen_id"
+	fieldPathogenName  = "pathogen_name"
+	fieldWard          = "ward"
+)
+
+var importFields = []string{
+	fieldLabTechID, fieldHospitalID, fieldCountyCode, fieldSubCountyCode, fieldPatientID, fieldPatientGender,
+	fieldPatientAge, fieldCultureSource, fieldResultsDate, fieldPathogenID, fieldPathogenName, fieldWard,
+}
+
+const defaultDateLayout = "2006-01-02"
+
+// whonetFields are the WHONET names of culture fields
+var whonetFields = map[string]string{
+	fieldHospitalID:    "LABORATORY",
+	fieldPatientID:     "PATIENT_ID",
+	fieldPatientGender: "SEX",
+	fieldPatientAge:    "AGE",
+	fieldCultureSource: "SPEC_TYPE",
+	fieldResultsDate:   "SPEC_DATE",
+	fieldPathogenID:    "ORGANISM",
+	fieldWard:          "WARD",
+}
+
+// whonetResultColumn matches WHONET antimicrobial columns e.g AMP_ND10, CIP_NM or VAN_NE
+var whonetResultColumn = regexp.MustCompile(`^([A-Z]{3})_N([DME])[0-9.]*$`)
+
+var whonetTestMethods = map[string]culture.TestMethod{
+	"D": culture.TestMethod_DISK_DIFFUSION,
+	"M": culture.TestMethod_BROTH_MICRODILUTION,
+	"E": culture.TestMethod_GRADIENT_STRIP,
+}
+
+// whonetAntimicrobials are names of common WHONET antimicrobial codes
+var whonetAntimicrobials = map[string]string{
+	"AMC": "Amoxicillin-clavulanic acid",
+	"AMK": "Amikacin",
+	"AMP": "Ampicillin",
+	"ATM": "Aztreonam",
+	"AZM": "Azithromycin",
+	"CAZ": "Ceftazidime",
+	"CHL": "Chloramphenicol",
+	"CIP": "Ciprofloxacin",
+	"CLI": "Clindamycin",
+	"COL": "Colistin",
+	"CRO": "Ceftriaxone",
+	"CTX": "Cefotaxime",
+	"CXM": "Cefuroxime",
+	"CZO": "Cefazolin",
+	"DOX": "Doxycycline",
+	"ERY": "Erythromycin",
+	"ETP": "Ertapenem",
+	"FEP": "Cefepime",
+	"FOX": "Cefoxitin",
+	"GEN": "Gentamicin",
+	"IPM": "Imipenem",
+	"LNZ": "Linezolid",
+	"LVX": "Levofloxacin",
+	"MEM": "Meropenem",
+	"NIT": "Nitrofurantoin",
+	"OXA": "Oxacillin",
+	"PEN": "Penicillin G",
+	"SAM": "Ampicillin-sulbactam",
+	"SXT": "Trimethoprim-sulfamethoxazole",
+	"TCY": "Tetracycline",
+	"TGC": "Tigecycline",
+	"TOB": "Tobramycin",
+	"TZP": "Piperacillin-tazobactam",
+	"VAN": "Vancomycin",
+}
+
+// whonetOrganisms are names of common WHONET organism codes
+var whonetOrganisms = map[string]string{
+	"aba": "Acinetobacter baumannii",
+	"ecl": "Enterobacter cloacae",
+	"eco": "Escherichia coli",
+	"efa": "Enterococcus faecalis",
+	"efm": "Enterococcus faecium",
+	"kpn": "Klebsiella pneumoniae",
+	"pae": "Pseudomonas aeruginosa",
+	"pmi": "Proteus mirabilis",
+	"sau": "Staphylococcus aureus",
+	"spn": "Streptococcus pneumoniae",
+}
+
+var importLabels = map[string]culture.Label{
+	"S":   culture.Label_SUSCEPTIBLE,
+	"SDD": culture.Label_DOSE_SUSCEPTIBLE,
+	"I":   culture.Label_INTERMEDIATE,
+	"R":   culture.Label_RESISTANT,
+}
+
+var importComparators = map[string]culture.Comparator{
+	"":   culture.Comparator_EQUAL,
+	"<":  culture.Comparator_LESS_THAN,
+	"<=": culture.Comparator_LESS_THAN_OR_EQUAL,
+	">":  culture.Comparator_GREATER_THAN,
+	">=": culture.Comparator_GREATER_THAN_OR_EQUAL,
+}
+
+// importResultColumn is a column of measurements at an index in the header
+type importResultColumn struct {
+	index int
+	*culture.ImportResultColumn
+}
+
+// importParser converts records of an import file to cultures
+type importParser struct {
+	fields        map[string]int
+	defaults      map[string]string
+	resultColumns []*importResultColumn
+	pathogenNames map[string]string
+	dateLayout    string
+}
+
+// newImportParser maps columns of the header using the mapping. WHONET files default to WHONET column names.
+func newImportParser(
+	format culture.ImportFormat, mapping *culture.ImportMapping, header []string,
+) (*importParser, error) {
+	var (
+		fields        = make(map[string]string, len(importFields))
+		resultColumns = mapping.GetResultColumns()
+		pathogenNames = make(map[string]string)
+		columns       = make(map[string]int, len(header))
+	)
+
+	for index, column := range header {
+		columns[strings.TrimSpace(column)] = index
+	}
+
+	if format == culture.ImportFormat_WHONET {
+		for field, column := range whonetFields {
+			fields[field] = column
+		}
+		for code, name := range whonetOrganisms {
+			pathogenNames[code] = name
+		}
+		// Antimicrobial columns are detected from their WHONET codes
+		if len(resultColumns) == 0 {
+			for _, column := range header {
+				column = strings.TrimSpace(column)
+				matches := whonetResultColumn.FindStringSubmatch(column)
+				if matches == nil {
+					continue
+				}
+				name, ok := whonetAntimicrobials[matches[1]]
+				if !ok {
+					name = matches[1]
+				}
+				resultColumns = append(resultColumns, &culture.ImportResultColumn{
+					Column:            column,
+					AntimicrobialId:   matches[1],
+					AntimicrobialName: name,
+					TestMethod:        whonetTestMethods[matches[2]],
+				})
+			}
+		}
+	}
+
+	for field, column := range mapping.GetFields() {
+		fields[field] = column
+	}
+	for code, name := range mapping.GetPathogenNames() {
+		pathogenNames[code] = name
+	}
+
+	parser := &importParser{
+		fields:        make(map[string]int, len(fields)),
+		defaults:      mapping.GetDefaults(),
+		resultColumns: make([]*importResultColumn, 0, len(resultColumns)),
+		pathogenNames: pathogenNames,
+		dateLayout:    mapping.GetDateLayout(),
+	}
+	if parser.dateLayout == "" {
+		parser.dateLayout = defaultDateLayout
+	}
+
+	for field := range fields {
+		if !isImportField(field) {
+			return nil, errs.WrapMessage(codes.InvalidArgument, "unknown import field "+field)
+		}
+	}
+	for field := range parser.defaults {
+		if !isImportField(field) {
+			return nil, errs.WrapMessage(codes.InvalidArgument, "unknown import field "+field)
+		}
+	}
+
+	for _, field := range importFields {
+		column, ok := fields[field]
+		if !ok {
+			continue
+		}
+		index, ok := columns[column]
+		if !ok {
+			// Only columns mapped explicitly without a default must be in the file
+			_, hasDefault := parser.defaults[field]
+			if _, mapped := mapping.GetFields()[field]; !mapped || hasDefault {
+				continue
+			}
+			return nil, errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("column %q of %s not found", column, field))
+		}
+		parser.fields[field] = index
+	}
+
+	for _, resultColumn := range resultColumns {
+		index, ok := columns[resultColumn.Column]
+		switch {
+		case !ok:
+			return nil, errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("result column %q not found", resultColumn.Column))
+		case resultColumn.AntimicrobialId == "":
+			return nil, errs.MissingField(fmt.Sprintf("antimicrobial id of result column %q", resultColumn.Column))
+		}
+		// Columns without a test method measure with the default one
+		if resultColumn.TestMethod == culture.TestMethod_TEST_METHOD_UNSPECIFIED {
+			resultColumn = proto.Clone(resultColumn).(*culture.ImportResultColumn)
+			resultColumn.TestMethod = defaultTestMethod
+		}
+		parser.resultColumns = append(parser.resultColumns, &importResultColumn{
+			index:              index,
+			ImportResultColumn: resultColumn,
+		})
+	}
+	if len(parser.resultColumns) == 0 {
+		return nil, errs.MissingField("result columns")
+	}
+
+	return parser, nil
+}
+
+func isImportField(field string) bool {
+	for _, importField := range importFields {
+		if field == importField {
+			return true
+		}
+	}
+	return false
+}
+
+// value returns the value of the field in the record or its default
+func (parser *importParser) value(record []string, field string) string {
+	if index, ok := parser.fields[field]; ok && index < len(record) {
+		if value := strings.TrimSpace(record[index]); value != "" {
+			return value
+		}
+	}
+	return parser.defaults[field]
+}
+
+// parseGender converts gender codes such as m, f or u to genders of cultures
+func parseGender(value string) string {
+	switch strings.ToLower(value) {
+	case "m", "male":
+		return "male"
+	case "f", "female":
+		return "female"
+	case "u", "unknown", "o", "other":
+		return "all"
+	}
+	return strings.ToLower(value)
+}
+
+// parseAge parses ages in years such as 45 or 45y. Ages in months, weeks or days are rounded up to years.
+func parseAge(value string) (int32, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	perYear := 1.0
+	switch {
+	case strings.HasSuffix(value, "y"):
+		value = strings.TrimSuffix(value, "y")
+	case strings.HasSuffix(value, "m"):
+		value, perYear = strings.TrimSuffix(value, "m"), 12
+	case strings.HasSuffix(value, "w"):
+		value, perYear = strings.TrimSuffix(value, "w"), 52
+	case strings.HasSuffix(value, "d"):
+		value, perYear = strings.TrimSuffix(value, "d"), 365
+	}
+	age, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || age < 0 {
+		return 0, fmt.Errorf("malformed patient age %q", value)
+	}
+	return int32(math.Ceil(age / perYear)), nil
+}
+
+// parseImportResult parses a measurement optionally followed by a label e.g "18", "18 mm R" or "<=0.5 S"
+func parseImportResult(
+	value string, testMethod culture.TestMethod,
+) (result *culture.LabTestResult, labeled bool, err error) {
+	result = &culture.LabTestResult{}
+
+	fields := strings.Fields(value)
+	if len(fields) > 1 {
+		if label, ok := importLabels[strings.ToUpper(fields[len(fields)-1])]; ok {
+			result.Label, labeled = label, true
+			value = strings.Join(fields[:len(fields)-1], " ")
+		}
+	}
+
+	number, comparator, err := breakpoints.ParseMeasurement(value)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if measuresMIC(testMethod) {
+		result.Mic = &culture.MIC{
+			Value:      number,
+			Comparator: importComparators[comparator],
+			Unit:       "mg/L",
+		}
+	} else {
+		result.DiskDiameter = strings.TrimSpace(value)
+	}
+
+	return result, labeled, nil
+}
+
+// parse converts a record to a culture of one isolate. Labeled reports results whose label was in the file.
+func (parser *importParser) parse(record []string) (culturePB *culture.Culture, labeled []bool, err error) {
+	pathogenID := parser.value(record, fieldPathogenID)
+	pathogenName := parser.value(record, fieldPathogenName)
+	if name, ok := parser.pathogenNames[pathogenID]; ok {
+		pathogenName = name
+	}
+	if pathogenName == "" {
+		pathogenName = pathogenID
+	}
+
+	culturePB = &culture.Culture{
+		LabTechId:      parser.value(record, fieldLabTechID),
+		HospitalId:     parser.value(record, fieldHospitalID),
+		CountyCode:     parser.value(record, fieldCountyCode),
+		SubCountyCode:  parser.value(record, fieldSubCountyCode),
+		PatientId:      parser.value(record, fieldPatientID),
+		PatientGender:  parseGender(parser.value(record, fieldPatientGender)),
+		CultureSource:  parser.value(record, fieldCultureSource),
+		Ward:           parser.value(record, fieldWard),
+		CultureResults: make([]*culture.LabTestResult, 0, len(parser.resultColumns)),
+	}
+
+	if age := parser.value(record, fieldPatientAge); age != "" {
+		culturePB.PatientAge, err = parseAge(age)
+		if err != nil {
+			return nil, nil, errs.WrapErrWithMessage(codes.InvalidArgument, err, "patient age")
+		}
+	}
+
+	if date := parser.value(record, fieldResultsDate); date != "" {
+		resultsDate, err := time.Parse(parser.dateLayout, date)
+		if err != nil {
+			return nil, nil, errs.WrapErrWithMessage(codes.InvalidArgument, err, "results date")
+		}
+		culturePB.ResultsTimestampSec = resultsDate.Unix()
+	}
+
+	if pathogenID != "" {
+		culturePB.PathogensFound = []string{pathogenID}
+	}
+
+	methodSet := false
+	for _, resultColumn := range parser.resultColumns {
+		if resultColumn.index >= len(record) || strings.TrimSpace(record[resultColumn.index]) == "" {
+			continue
+		}
+
+		// A culture has one test method
+		switch {
+		case !methodSet:
+			culturePB.TestMethod, methodSet = resultColumn.TestMethod, true
+		case culturePB.TestMethod != resultColumn.TestMethod:
+			return nil, nil, errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf(
+				"results use test methods %s and %s", culturePB.TestMethod, resultColumn.TestMethod,
+			))
+		}
+
+		cultureResult, ok, err := parseImportResult(record[resultColumn.index], resultColumn.TestMethod)
+		if err != nil {
+			return nil, nil, errs.WrapErrWithMessage(codes.InvalidArgument, err, "column "+resultColumn.Column)
+		}
+		cultureResult.PathogenId = pathogenID
+		cultureResult.PathogenName = pathogenName
+		cultureResult.AntimicrobialId = resultColumn.AntimicrobialId
+		cultureResult.AntimicrobialName = resultColumn.AntimicrobialName
+		if cultureResult.AntimicrobialName == "" {
+			cultureResult.AntimicrobialName = resultColumn.AntimicrobialId
+		}
+
+		culturePB.AntimicrobialsUsed = append(culturePB.AntimicrobialsUsed, resultColumn.AntimicrobialId)
+		culturePB.CultureResults = append(culturePB.CultureResults, cultureResult)
+		labeled = append(labeled, ok)
+	}
+
+	return culturePB, labeled, nil
+}
@@ -0,0 +1,135 @@
+package culture
+
+import (
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/breakpoints"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"strings"
+)
+
+// micUnits are the accepted units of MIC values. They are all equivalent to mg/L.
+var micUnits = map[string]bool{
+	"mg/l":  true,
+	"ug/ml": true,
+	"µg/ml": true,
+	"μg/ml": true,
+}
+
+var micComparators = map[culture.Comparator]string{
+	culture.Comparator_EQUAL:                 "",
+	culture.Comparator_LESS_THAN:             "<",
+	culture.Comparator_LESS_THAN_OR_EQUAL:    "<=",
+	culture.Comparator_GREATER_THAN:          ">",
+	culture.Comparator_GREATER_THAN_OR_EQUAL: ">=",
+}
+
+// defaultTestMethod is the test method of new cultures that do not give one
+const defaultTestMethod = culture.TestMethod_DISK_DIFFUSION
+
+// measuresMIC checks whether the test method measures MICs rather than zone diameters.
+// An unspecified test method is the default disk diffusion.
+func measuresMIC(testMethod culture.TestMethod) bool {
+	return testMethod != culture.TestMethod_DISK_DIFFUSION && testMethod != culture.TestMethod_TEST_METHOD_UNSPECIFIED
+}
+
+// validateResults checks that each result carries the measurement of the culture test method
+func validateResults(culturePB *culture.Culture) error {
+	if _, ok := culture.TestMethod_name[int32(culturePB.GetTestMethod())]; !ok {
+		return errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("unknown test method %d", culturePB.GetTestMethod()))
+	}
+	if culturePB.GetTestMethod() == culture.TestMethod_TEST_METHOD_UNSPECIFIED {
+		return errs.MissingField("test method")
+	}
+
+	for index, cultureResult := range culturePB.GetCultureResults() {
+		var err error
+		if measuresMIC(culturePB.GetTestMethod()) {
+			mic := cultureResult.GetMic()
+			switch {
+			case mic == nil:
+				err = errs.MissingField(fmt.Sprintf("culture result %d mic", index))
+			case mic.Value <= 0:
+				err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("culture result %d mic must be positive", index))
+			case !micUnits[strings.ToLower(strings.TrimSpace(mic.Unit))]:
+				err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("culture result %d mic unit %q not supported", index, mic.Unit))
+			}
+		} else {
+			switch {
+			case cultureResult.GetDiskDiameter() == "":
+				err = errs.MissingField(fmt.Sprintf("culture result %d disk diameter", index))
+			case cultureResult.GetMic() != nil:
+				err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("culture result %d mic not measured by disk diffusion", index))
+			default:
+				_, _, err = breakpoints.ParseMeasurement(cultureResult.GetDiskDiameter())
+				if err != nil {
+					err = errs.WrapErrWithMessage(codes.InvalidArgument, err, fmt.Sprintf("culture result %d disk diameter", index))
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// interpretResults labels results from their disk diameter or MIC using breakpoint tables.
+// Labels entered by the lab technician are kept in reported label and disagreements are flagged.
+// Reported labels of results flagged with a disagreement are kept when results are interpreted again.
+// Results without breakpoints keep the entered label.
+func (capi *cultureAPIServer) interpretResults(culturePB *culture.Culture) error {
+	for _, cultureResult := range culturePB.GetCultureResults() {
+		version := cultureResult.BreakpointVersion
+		if version != "" && !capi.breakpoints.HasVersion(version) {
+			return errs.WrapMessage(codes.InvalidArgument, "unknown breakpoint version "+version)
+		}
+
+		var (
+			interpretation *breakpoints.Interpretation
+			ok             bool
+			err            error
+		)
+
+		if measuresMIC(culturePB.TestMethod) {
+			interpretation, ok, err = capi.breakpoints.InterpretMIC(
+				version,
+				cultureResult.PathogenName,
+				cultureResult.AntimicrobialName,
+				cultureResult.GetMic().GetValue(),
+				micComparators[cultureResult.GetMic().GetComparator()],
+			)
+		} else {
+			var diameter float64
+			diameter, _, err = breakpoints.ParseMeasurement(cultureResult.DiskDiameter)
+			if err == nil {
+				interpretation, ok, err = capi.breakpoints.InterpretDisk(
+					version, cultureResult.PathogenName, cultureResult.AntimicrobialName, diameter,
+				)
+			}
+		}
+		if err != nil {
+			return errs.WrapErrWithMessage(codes.InvalidArgument, err, "failed to interpret result")
+		}
+
+		// Results that disagreed keep the label reported by the lab when they are checked again,
+		// for example when restored or amended. The label of other results is the reported one.
+		if !cultureResult.LabelDisagreement {
+			cultureResult.ReportedLabel = cultureResult.Label
+		}
+		cultureResult.Label = cultureResult.ReportedLabel
+		cultureResult.LabelDisagreement = false
+		cultureResult.BreakpointVersion = ""
+
+		if !ok {
+			continue
+		}
+
+		cultureResult.Label = interpretation.Label
+		cultureResult.LabelDisagreement = cultureResult.ReportedLabel != interpretation.Label
+		cultureResult.BreakpointVersion = interpretation.Version
+	}
+	return nil
+}
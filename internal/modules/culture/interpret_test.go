@@ -0,0 +1,96 @@
+package culture
+
+import (
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Interpreting results from breakpoints #interpret", func() {
+	var culturePB *culture.Culture
+
+	BeforeEach(func() {
+		culturePB = &culture.Culture{
+			CultureResults: []*culture.LabTestResult{
+				{
+					PathogenName:      "Escherichia coli",
+					AntimicrobialName: "Ceftriaxone",
+					DiskDiameter:      "18 mm",
+					Label:             culture.Label_SUSCEPTIBLE,
+				},
+			},
+		}
+	})
+
+	It("should compute the label and flag disagreement with the reported label", func() {
+		Expect(CultureServer.interpretResults(culturePB)).ShouldNot(HaveOccurred())
+		cultureResult := culturePB.CultureResults[0]
+		Expect(cultureResult.Label).Should(Equal(culture.Label_RESISTANT))
+		Expect(cultureResult.ReportedLabel).Should(Equal(culture.Label_SUSCEPTIBLE))
+		Expect(cultureResult.LabelDisagreement).Should(BeTrue())
+		Expect(cultureResult.BreakpointVersion).Should(Equal(defaultBreakpoints))
+	})
+
+	It("should keep the disagreement when results are interpreted again", func() {
+		Expect(CultureServer.interpretResults(culturePB)).ShouldNot(HaveOccurred())
+		Expect(CultureServer.interpretResults(culturePB)).ShouldNot(HaveOccurred())
+		cultureResult := culturePB.CultureResults[0]
+		Expect(cultureResult.Label).Should(Equal(culture.Label_RESISTANT))
+		Expect(cultureResult.ReportedLabel).Should(Equal(culture.Label_SUSCEPTIBLE))
+		Expect(cultureResult.LabelDisagreement).Should(BeTrue())
+	})
+
+	It("should interpret MIC of MIC based test methods", func() {
+		culturePB.TestMethod = culture.TestMethod_BROTH_MICRODILUTION
+		culturePB.CultureResults[0].Mic = &culture.MIC{
+			Value:      0.5,
+			Comparator: culture.Comparator_LESS_THAN_OR_EQUAL,
+			Unit:       "mg/L",
+		}
+		Expect(CultureServer.interpretResults(culturePB)).ShouldNot(HaveOccurred())
+		Expect(culturePB.CultureResults[0].Label).Should(Equal(culture.Label_SUSCEPTIBLE))
+		Expect(culturePB.CultureResults[0].LabelDisagreement).Should(BeFalse())
+	})
+
+	It("should keep the reported label when off-scale MIC is inconclusive", func() {
+		culturePB.TestMethod = culture.TestMethod_VITEK
+		culturePB.CultureResults[0].Mic = &culture.MIC{
+			Value:      64,
+			Comparator: culture.Comparator_LESS_THAN_OR_EQUAL,
+			Unit:       "ug/mL",
+		}
+		Expect(CultureServer.interpretResults(culturePB)).ShouldNot(HaveOccurred())
+		Expect(culturePB.CultureResults[0].Label).Should(Equal(culture.Label_SUSCEPTIBLE))
+		Expect(culturePB.CultureResults[0].BreakpointVersion).Should(BeEmpty())
+	})
+
+	It("should interpret with the requested breakpoint version", func() {
+		culturePB.CultureResults[0].DiskDiameter = "23"
+		culturePB.CultureResults[0].BreakpointVersion = "EUCAST v10.0"
+		Expect(CultureServer.interpretResults(culturePB)).ShouldNot(HaveOccurred())
+		Expect(culturePB.CultureResults[0].Label).Should(Equal(culture.Label_DOSE_SUSCEPTIBLE))
+		Expect(culturePB.CultureResults[0].BreakpointVersion).Should(Equal("EUCAST v10.0"))
+	})
+
+	It("should keep the reported label when there are no breakpoints", func() {
+		culturePB.CultureResults[0].PathogenName = "Candida albicans"
+		culturePB.CultureResults[0].Label = culture.Label_INTERMEDIATE
+		Expect(CultureServer.interpretResults(culturePB)).ShouldNot(HaveOccurred())
+		Expect(culturePB.CultureResults[0].Label).Should(Equal(culture.Label_INTERMEDIATE))
+		Expect(culturePB.CultureResults[0].BreakpointVersion).Should(BeEmpty())
+	})
+
+	It("should fail when breakpoint version is unknown", func() {
+		culturePB.CultureResults[0].BreakpointVersion = "CLSI M100-ED1"
+		err := CultureServer.interpretResults(culturePB)
+		Expect(err).Should(HaveOccurred())
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+
+	It("should fail when measurement is malformed", func() {
+		culturePB.CultureResults[0].DiskDiameter = "wide"
+		err := CultureServer.interpretResults(culturePB)
+		Expect(err).Should(HaveOccurred())
+		Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+	})
+})
@@ -2,6 +2,7 @@ package culture
 
 import (
 	"context"
+	"github.com/Pallinder/go-randomdata"
 	"github.com/gidyon/antibug/pkg/api/culture"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -21,8 +22,7 @@ var _ = Describe("Listing cultures #list", func() {
 			endTimestamp = time.Now().Unix()
 		}
 		listReq = &culture.ListCulturesRequest{
-			PageSize:  10,
-			PageToken: 1,
+			PageSize: 10,
 			Filter: &culture.ListCultureFilter{
 				DateFilter: &culture.DateFilter{
 					StartTimestampSec: time.Now().Unix() * (3 / 4),
@@ -59,12 +59,19 @@ var _ = Describe("Listing cultures #list", func() {
 		})
 
 		Describe("Calling list", func() {
-			It("should succeed even when page token is weird as default will be used", func() {
-				listReq.PageToken = -1000
+			It("should fail when page token is malformed", func() {
+				listReq.PageToken = "-1000"
 				listRes, err := CultureAPI.ListCultures(ctx, listReq)
-				Expect(err).ToNot(HaveOccurred())
-				Expect(status.Code(err)).To(Equal(codes.OK))
-				Expect(listRes).ToNot(BeNil())
+				Expect(err).To(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(listRes).To(BeNil())
+			})
+			It("should fail when sort is unknown", func() {
+				listReq.Sort = culture.CultureSort(99)
+				listRes, err := CultureAPI.ListCultures(ctx, listReq)
+				Expect(err).To(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(listRes).To(BeNil())
 			})
 			It("should succeed when page size is weird as default will be used", func() {
 				listReq.PageSize = -100
@@ -241,4 +248,81 @@ var _ = Describe("Listing cultures #list", func() {
 			})
 		})
 	})
+
+	Describe("Paging through cultures of a hospital", func() {
+		var (
+			hospitalID string
+			timestamps []int64
+		)
+
+		BeforeEach(func() {
+			hospitalID = randomdata.RandStringRunes(20)
+			timestamps = nil
+			for i := 0; i < 5; i++ {
+				culturePB := FakeCulture()
+				culturePB.HospitalId = hospitalID
+				culturePB.ResultsTimestampSec = time.Now().Unix() - int64(i%3)*3600
+				_, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB, AllowDuplicate: true})
+				Expect(err).ShouldNot(HaveOccurred())
+				timestamps = append(timestamps, culturePB.ResultsTimestampSec)
+			}
+			listReq = &culture.ListCulturesRequest{
+				PageSize:     2,
+				IncludeTotal: true,
+				Filter: &culture.ListCultureFilter{
+					ListTarget: culture.ListTarget_HOSPITAL,
+					TargetIds:  []string{hospitalID},
+				},
+			}
+		})
+
+		// listAll follows page tokens until the last page
+		listAll := func() []*culture.Culture {
+			culturesPB := make([]*culture.Culture, 0)
+			for pages := 0; pages < 10; pages++ {
+				listRes, err := CultureAPI.ListCultures(ctx, listReq)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(listRes.TotalCount).Should(Equal(int64(len(timestamps))))
+				culturesPB = append(culturesPB, listRes.Cultures...)
+				if listRes.NextPageToken == "" {
+					return culturesPB
+				}
+				Expect(listRes.Cultures).Should(HaveLen(int(listReq.PageSize)))
+				listReq.PageToken = listRes.NextPageToken
+			}
+			Fail("page tokens did not reach the last page")
+			return nil
+		}
+
+		It("should list every culture once with latest results first", func() {
+			culturesPB := listAll()
+			Expect(culturesPB).Should(HaveLen(len(timestamps)))
+
+			seen := make(map[string]bool)
+			for i, culturePB := range culturesPB {
+				Expect(seen[culturePB.CultureId]).Should(BeFalse())
+				seen[culturePB.CultureId] = true
+				if i > 0 {
+					Expect(culturePB.ResultsTimestampSec).Should(BeNumerically("<=", culturesPB[i-1].ResultsTimestampSec))
+				}
+			}
+		})
+		It("should list cultures with earliest results first", func() {
+			listReq.Sort = culture.CultureSort_RESULTS_OLDEST_FIRST
+			culturesPB := listAll()
+			Expect(culturesPB).Should(HaveLen(len(timestamps)))
+			for i := 1; i < len(culturesPB); i++ {
+				Expect(culturesPB[i].ResultsTimestampSec).Should(BeNumerically(">=", culturesPB[i-1].ResultsTimestampSec))
+			}
+		})
+		It("should fail when page token is of another sort", func() {
+			listRes, err := CultureAPI.ListCultures(ctx, listReq)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			listReq.PageToken = listRes.NextPageToken
+			listReq.Sort = culture.CultureSort_RESULTS_OLDEST_FIRST
+			_, err = CultureAPI.ListCultures(ctx, listReq)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
 })
@@ -2,9 +2,11 @@ package culture
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/gidyon/antibug/internal/pkg/errs"
 	"github.com/gidyon/antibug/pkg/api/culture"
 	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
 )
 
 const culturesTable = "cultures"
@@ -15,7 +17,7 @@ type Culture struct {
 	HospitalID          string `gorm:"type:varchar(50);not null"`
 	CountyCode          string `gorm:"type:int(11);not null"`
 	SubCountyCode       string `gorm:"type:int(11);not null"`
-	PatientID           string `gorm:"type:varchar(50);not null"`
+	PatientID           string `gorm:"type:varchar(50);not null;index"`
 	PatientGender       string `gorm:"type:enum('male','female','all');not null;default:'all'"`
 	PatientAge          int32  `gorm:"type:tinyint(4);not null"`
 	CultureSource       string `gorm:"type:varchar(50);not null"`
@@ -26,7 +28,17 @@ type Culture struct {
 	AntimicrobialsIndex string `gorm:"type:varchar(50);not null"`
 	Editors             []byte `gorm:"type:json;not null"`
 	CultureResults      []byte `gorm:"type:json;not null"`
-	ResultsTimestampSec int64  `gorm:"type:bigint(20);not null"`
+	Classifications     []byte `gorm:"type:json"`
+	ResultsTimestampSec int64  `gorm:"type:bigint(20);not null;index"`
+	Status              string `gorm:"type:varchar(20);not null;default:'FINAL'"`
+	Ward                string `gorm:"type:varchar(50);not null;default:''"`
+	Department          string `gorm:"type:varchar(30);not null;default:'DEPARTMENT_UNSPECIFIED'"`
+	PatientSetting      string `gorm:"type:varchar(30);not null;default:'SETTING_UNSPECIFIED'"`
+	SpecimenType        string `gorm:"type:varchar(30);not null;default:'SPECIMEN_UNSPECIFIED'"`
+	// Zero when the collection time is unknown
+	SpecimenCollectedTimestampSec int64 `gorm:"type:bigint(20);not null;default:0"`
+	// Key of the request that created the culture
+	IdempotencyKey *string `gorm:"type:varchar(100);unique_index"`
 	gorm.Model
 }
 
@@ -56,6 +68,13 @@ func getCultureDB(culturePB *culture.Culture) (*Culture, error) {
 		CultureSource:       culturePB.CultureSource,
 		TestMethod:          culturePB.TestMethod.String(),
 		ResultsTimestampSec: culturePB.ResultsTimestampSec,
+		Status:              culturePB.Status.String(),
+		Ward:                culturePB.Ward,
+		Department:          culturePB.Department.String(),
+		PatientSetting:      culturePB.PatientSetting.String(),
+		SpecimenType:        culturePB.SpecimenType.String(),
+
+		SpecimenCollectedTimestampSec: culturePB.SpecimenCollectedTimestampSec,
 	}
 
 	var (
@@ -90,6 +109,15 @@ func getCultureDB(culturePB *culture.Culture) (*Culture, error) {
 		cultureDB.CultureResults = data
 	}
 
+	// Marshal isolate classifications
+	if len(culturePB.IsolateClassifications) > 0 {
+		data, err = json.Marshal(culturePB.IsolateClassifications)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "isolate classifications")
+		}
+		cultureDB.Classifications = data
+	}
+
 	// Marshal editors
 	if len(culturePB.Editors) > 0 {
 		data, err = json.Marshal(culturePB.Editors)
@@ -112,7 +140,33 @@ func getCulturePB(cultureDB *Culture) (*culture.Culture, error) {
 		return nil, errs.NilObject("culture db")
 	}
 
+	testMethod, ok := culture.TestMethod_value[cultureDB.TestMethod]
+	if !ok {
+		return nil, errs.WrapMessage(codes.Internal, "unknown test method "+cultureDB.TestMethod)
+	}
+
+	status, ok := culture.CultureStatus_value[cultureDB.Status]
+	if !ok {
+		return nil, errs.WrapMessage(codes.Internal, "unknown culture status "+cultureDB.Status)
+	}
+
+	department, ok := culture.Department_value[cultureDB.Department]
+	if !ok {
+		return nil, errs.WrapMessage(codes.Internal, "unknown department "+cultureDB.Department)
+	}
+
+	patientSetting, ok := culture.PatientSetting_value[cultureDB.PatientSetting]
+	if !ok {
+		return nil, errs.WrapMessage(codes.Internal, "unknown patient setting "+cultureDB.PatientSetting)
+	}
+
+	specimenType, ok := culture.SpecimenType_value[cultureDB.SpecimenType]
+	if !ok {
+		return nil, errs.WrapMessage(codes.Internal, "unknown specimen type "+cultureDB.SpecimenType)
+	}
+
 	culturePB := &culture.Culture{
+		CultureId:           fmt.Sprint(cultureDB.ID),
 		LabTechId:           cultureDB.LabTechID,
 		HospitalId:          cultureDB.HospitalID,
 		CountyCode:          cultureDB.CountyCode,
@@ -121,8 +175,15 @@ func getCulturePB(cultureDB *Culture) (*culture.Culture, error) {
 		PatientGender:       cultureDB.PatientGender,
 		PatientAge:          cultureDB.PatientAge,
 		CultureSource:       cultureDB.CultureSource,
-		TestMethod:          culture.TestMethod(culture.TestMethod_value[cultureDB.TestMethod]),
+		TestMethod:          culture.TestMethod(testMethod),
 		ResultsTimestampSec: cultureDB.ResultsTimestampSec,
+		Status:              culture.CultureStatus(status),
+		Ward:                cultureDB.Ward,
+		Department:          culture.Department(department),
+		PatientSetting:      culture.PatientSetting(patientSetting),
+		SpecimenType:        culture.SpecimenType(specimenType),
+
+		SpecimenCollectedTimestampSec: cultureDB.SpecimenCollectedTimestampSec,
 	}
 
 	var err error
@@ -150,6 +211,16 @@ func getCulturePB(cultureDB *Culture) (*culture.Culture, error) {
 		}
 	}
 
+	// Unmarshal isolate classifications. Cultures saved before classification are classified on read.
+	if len(cultureDB.Classifications) > 0 {
+		err = json.Unmarshal(cultureDB.Classifications, &culturePB.IsolateClassifications)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "isolate classifications")
+		}
+	} else {
+		culturePB.IsolateClassifications = ClassifyIsolates(culturePB)
+	}
+
 	// Unmarshal editors
 	if len(cultureDB.Editors) > 0 {
 		err = json.Unmarshal(cultureDB.Editors, &culturePB.Editors)
@@ -0,0 +1,287 @@
+package culture
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/jinzhu/gorm"
+	"strings"
+	"time"
+)
+
+const (
+	patientIdentitiesTable = "patient_identities"
+	revisionsBatchSize     = 500
+	pseudonymPrefix        = "psn_"
+	// Bytes of the keyed hash kept in pseudonyms
+	pseudonymBytes = 16
+)
+
+// PatientIdentity is the patient id behind a pseudonym. It is only read to re-identify patients.
+type PatientIdentity struct {
+	Pseudonym string `gorm:"primary_key;type:varchar(50)"`
+	PatientID string `gorm:"type:varchar(50);not null"`
+	CreatedAt time.Time
+}
+
+// TableName ...
+func (*PatientIdentity) TableName() string {
+	return patientIdentitiesTable
+}
+
+// pseudonymize returns the keyed hash of a patient id. The same patient always gets the same pseudonym
+// so that isolates of a patient can still be told apart.
+func pseudonymize(key []byte, patientID string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.TrimSpace(patientID)))
+	return pseudonymPrefix + hex.EncodeToString(mac.Sum(nil)[:pseudonymBytes])
+}
+
+// isPseudonym checks whether a patient id has the format of pseudonyms
+func isPseudonym(patientID string) bool {
+	if len(patientID) != len(pseudonymPrefix)+2*pseudonymBytes || !strings.HasPrefix(patientID, pseudonymPrefix) {
+		return false
+	}
+	_, err := hex.DecodeString(strings.TrimPrefix(patientID, pseudonymPrefix))
+	return err == nil
+}
+
+// savePatientIdentity records the patient id behind a pseudonym
+func savePatientIdentity(db *gorm.DB, pseudonym, patientID string) error {
+	err := db.Exec(
+		"INSERT INTO "+patientIdentitiesTable+" (pseudonym, patient_id, created_at) VALUES (?, ?, ?) "+
+			"ON DUPLICATE KEY UPDATE pseudonym=pseudonym",
+		pseudonym, strings.TrimSpace(patientID), time.Now(),
+	).Error
+	if err != nil {
+		return errs.SQLQueryFailed(err, "UPSERT")
+	}
+	return nil
+}
+
+// pseudonymizePatient replaces the patient id of a culture from a request with its pseudonym. Ids that look
+// like pseudonyms are hashed too, so that clients cannot save cultures under pseudonyms of other patients.
+func (capi *cultureAPIServer) pseudonymizePatient(culturePB *culture.Culture) error {
+	pseudonym := pseudonymize(capi.pseudonymKey, culturePB.PatientId)
+
+	err := savePatientIdentity(capi.sqlDB, pseudonym, culturePB.PatientId)
+	if err != nil {
+		return err
+	}
+
+	culturePB.PatientId = pseudonym
+	return nil
+}
+
+// pseudonymizeStoredPatient replaces the patient id of a stored culture, such as the culture of a restored
+// revision, with its pseudonym. Pseudonyms are kept and only ids saved before pseudonymisation are hashed.
+func (capi *cultureAPIServer) pseudonymizeStoredPatient(culturePB *culture.Culture) error {
+	if isPseudonym(culturePB.PatientId) {
+		return nil
+	}
+	return capi.pseudonymizePatient(culturePB)
+}
+
+// pseudonymizeCultures replaces patient ids of cultures and their revisions saved before pseudonymisation
+func pseudonymizeCultures(db *gorm.DB, key []byte) error {
+	patientIDs := make([]string, 0)
+	err := db.Unscoped().Table(culturesTable).Pluck("DISTINCT patient_id", &patientIDs).Error
+	if err != nil {
+		return errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	for _, patientID := range patientIDs {
+		if isPseudonym(patientID) {
+			continue
+		}
+
+		pseudonym := pseudonymize(key, patientID)
+
+		tx := db.Begin()
+		if tx.Error != nil {
+			return errs.SQLQueryFailed(tx.Error, "BEGIN")
+		}
+
+		err = savePatientIdentity(tx, pseudonym, patientID)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		err = tx.Unscoped().Table(culturesTable).Where("patient_id=?", patientID).
+			UpdateColumn("patient_id", pseudonym).Error
+		if err != nil {
+			tx.Rollback()
+			return errs.SQLQueryFailed(err, "UPDATE")
+		}
+
+		err = tx.Commit().Error
+		if err != nil {
+			return errs.SQLQueryFailed(err, "COMMIT")
+		}
+	}
+
+	return pseudonymizeRevisions(db, key)
+}
+
+// pseudonymizeRevisions replaces patient ids in snapshots and changes of revisions saved before pseudonymisation.
+// Revisions whose snapshot has a pseudonym were saved after pseudonymisation.
+func pseudonymizeRevisions(db *gorm.DB, key []byte) error {
+	var lastID uint
+	for {
+		revisionsDB := make([]*CultureRevision, 0, revisionsBatchSize)
+		err := db.Where("id>?", lastID).
+			Where("LEFT(JSON_UNQUOTE(JSON_EXTRACT(snapshot, '$.patient_id')), ?)<>?", len(pseudonymPrefix), pseudonymPrefix).
+			Order("id").Limit(revisionsBatchSize).Find(&revisionsDB).Error
+		if err != nil {
+			return errs.SQLQueryFailed(err, "SELECT")
+		}
+		if len(revisionsDB) == 0 {
+			return nil
+		}
+
+		tx := db.Begin()
+		if tx.Error != nil {
+			return errs.SQLQueryFailed(tx.Error, "BEGIN")
+		}
+
+		for _, revisionDB := range revisionsDB {
+			lastID = revisionDB.ID
+			err = pseudonymizeRevision(tx, key, revisionDB)
+			if err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+
+		err = tx.Commit().Error
+		if err != nil {
+			return errs.SQLQueryFailed(err, "COMMIT")
+		}
+	}
+}
+
+// pseudonymizeRevision replaces patient ids in the snapshot and patient id changes of the revision
+func pseudonymizeRevision(tx *gorm.DB, key []byte, revisionDB *CultureRevision) error {
+	snapshot := make(map[string]json.RawMessage)
+	err := json.Unmarshal(revisionDB.Snapshot, &snapshot)
+	if err != nil {
+		return errs.FromJSONUnMarshal(err, "culture")
+	}
+	if patientID, ok := snapshot["patient_id"]; ok {
+		pseudonym, err := pseudonymizeJSON(tx, key, string(patientID))
+		if err != nil {
+			return err
+		}
+		snapshot["patient_id"] = json.RawMessage(pseudonym)
+	}
+	snapshotData, err := json.Marshal(snapshot)
+	if err != nil {
+		return errs.FromJSONMarshal(err, "culture")
+	}
+
+	columns := map[string]interface{}{"snapshot": snapshotData}
+
+	if len(revisionDB.Changes) > 0 {
+		changes := make([]*culture.FieldChange, 0)
+		err = json.Unmarshal(revisionDB.Changes, &changes)
+		if err != nil {
+			return errs.FromJSONUnMarshal(err, "changes")
+		}
+		for _, change := range changes {
+			if change.Field != "patient_id" {
+				continue
+			}
+			change.OldValue, err = pseudonymizeJSON(tx, key, change.OldValue)
+			if err != nil {
+				return err
+			}
+			change.NewValue, err = pseudonymizeJSON(tx, key, change.NewValue)
+			if err != nil {
+				return err
+			}
+		}
+		columns["changes"], err = json.Marshal(changes)
+		if err != nil {
+			return errs.FromJSONMarshal(err, "changes")
+		}
+	}
+
+	err = tx.Table(revisionsTable).Where("id=?", revisionDB.ID).UpdateColumns(columns).Error
+	if err != nil {
+		return errs.SQLQueryFailed(err, "UPDATE")
+	}
+
+	return nil
+}
+
+// pseudonymizeJSON replaces the patient id of a JSON string with the JSON string of its pseudonym
+func pseudonymizeJSON(tx *gorm.DB, key []byte, value string) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+
+	var patientID string
+	err := json.Unmarshal([]byte(value), &patientID)
+	if err != nil {
+		return "", errs.FromJSONUnMarshal(err, "patient id")
+	}
+	if patientID == "" || isPseudonym(patientID) {
+		return value, nil
+	}
+
+	pseudonym := pseudonymize(key, patientID)
+	err = savePatientIdentity(tx, pseudonym, patientID)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(pseudonym)
+	if err != nil {
+		return "", errs.FromJSONMarshal(err, "patient id")
+	}
+	return string(data), nil
+}
+
+func (capi *cultureAPIServer) ReidentifyPatient(
+	ctx context.Context, reidentifyReq *culture.ReidentifyPatientRequest,
+) (*culture.PatientIdentity, error) {
+	// Request must not be nil
+	if reidentifyReq == nil {
+		return nil, errs.NilObject("ReidentifyPatientRequest")
+	}
+
+	// Authorize request
+	payload, err := capi.authAPI.AuthorizeGroup(ctx, auth.DataSteward)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	if reidentifyReq.Pseudonym == "" {
+		return nil, errs.MissingField("pseudonym")
+	}
+
+	identityDB := &PatientIdentity{}
+	err = capi.sqlDB.First(identityDB, "pseudonym=?", reidentifyReq.Pseudonym).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.NotFound("patient", reidentifyReq.Pseudonym)
+	default:
+		return nil, errs.SQLQueryFailed(err, "GET")
+	}
+
+	capi.logger.Infof("patient %s was re-identified by %s", identityDB.Pseudonym, payload.ID)
+
+	return &culture.PatientIdentity{
+		Pseudonym: identityDB.Pseudonym,
+		PatientId: identityDB.PatientID,
+	}, nil
+}
@@ -0,0 +1,168 @@
+package culture
+
+import (
+	"context"
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Pseudonymising patients #pseudonym", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("Re-identifying patients with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			identityRes, err := CultureAPI.ReidentifyPatient(ctx, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(identityRes).To(BeNil())
+		})
+		It("should fail when pseudonym is missing", func() {
+			identityRes, err := CultureAPI.ReidentifyPatient(ctx, &culture.ReidentifyPatientRequest{})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(identityRes).To(BeNil())
+		})
+		It("should fail when pseudonym does not exist", func() {
+			identityRes, err := CultureAPI.ReidentifyPatient(ctx, &culture.ReidentifyPatientRequest{Pseudonym: "psn_unknown"})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			Expect(identityRes).To(BeNil())
+		})
+	})
+
+	Describe("Saving cultures of a patient", func() {
+		var (
+			patientID string
+			cultureID string
+		)
+
+		BeforeEach(func() {
+			patientID = "raw-" + randomdata.RandStringRunes(10)
+			culturePB := FakeCulture()
+			culturePB.PatientId = patientID
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+			cultureID = createRes.CultureId
+		})
+
+		getPseudonym := func(cultureID string) string {
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			return getRes.PatientId
+		}
+
+		It("should store a pseudonym instead of the patient id", func() {
+			pseudonym := getPseudonym(cultureID)
+			Expect(pseudonym).ShouldNot(Equal(patientID))
+			Expect(isPseudonym(pseudonym)).Should(BeTrue())
+		})
+		It("should give cultures of the same patient the same pseudonym", func() {
+			culturePB := FakeCulture()
+			culturePB.PatientId = patientID
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getPseudonym(createRes.CultureId)).Should(Equal(getPseudonym(cultureID)))
+		})
+		It("should pseudonymise patient ids of updates", func() {
+			_, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{PatientId: patientID + "-corrected"},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(isPseudonym(getPseudonym(cultureID))).Should(BeTrue())
+		})
+		It("should hash patient ids of new cultures that look like pseudonyms", func() {
+			pseudonym := getPseudonym(cultureID)
+			culturePB := FakeCulture()
+			culturePB.PatientId = pseudonym
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getPseudonym(createRes.CultureId)).ShouldNot(Equal(pseudonym))
+			Expect(isPseudonym(getPseudonym(createRes.CultureId))).Should(BeTrue())
+		})
+		It("should keep the pseudonym of the culture sent back in updates", func() {
+			pseudonym := getPseudonym(cultureID)
+			_, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{PatientId: pseudonym, PatientAge: 40},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getPseudonym(cultureID)).Should(Equal(pseudonym))
+		})
+		It("should hash pseudonyms of other patients sent in updates", func() {
+			culturePB := FakeCulture()
+			culturePB.PatientId = "raw-" + randomdata.RandStringRunes(10)
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+			otherPseudonym := getPseudonym(createRes.CultureId)
+
+			_, err = CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{PatientId: otherPseudonym},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getPseudonym(cultureID)).ShouldNot(Equal(otherPseudonym))
+		})
+		It("should list cultures by pseudonym only", func() {
+			listReq := &culture.ListCulturesRequest{
+				Filter: &culture.ListCultureFilter{ListTarget: culture.ListTarget_PATIENT, TargetIds: []string{patientID}},
+			}
+			listRes, err := CultureAPI.ListCultures(ctx, listReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Cultures).Should(BeEmpty())
+
+			listReq.Filter.TargetIds = []string{getPseudonym(cultureID)}
+			listRes, err = CultureAPI.ListCultures(ctx, listReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Cultures).ShouldNot(BeEmpty())
+		})
+		Describe("Revisions saved before pseudonymisation", func() {
+			// BeforeEach saves raw patient ids in the culture and the revision, as before pseudonymisation
+			BeforeEach(func() {
+				err := CultureServer.sqlDB.Unscoped().Table(culturesTable).Where("id=?", cultureID).
+					UpdateColumn("patient_id", patientID).Error
+				Expect(err).ShouldNot(HaveOccurred())
+
+				err = CultureServer.sqlDB.Exec(
+					"UPDATE "+revisionsTable+" SET snapshot=JSON_SET(snapshot, '$.patient_id', ?) WHERE culture_id=?",
+					patientID, cultureID,
+				).Error
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+
+			It("should pseudonymise patient ids of revisions", func() {
+				err := pseudonymizeCultures(CultureServer.sqlDB, CultureServer.pseudonymKey)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				revisionRes, err := CultureAPI.GetCultureRevision(ctx, &culture.GetCultureRevisionRequest{
+					CultureId: cultureID, RevisionNumber: 1,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(revisionRes.Culture.PatientId).Should(Equal(getPseudonym(cultureID)))
+				Expect(isPseudonym(revisionRes.Culture.PatientId)).Should(BeTrue())
+			})
+
+			It("should pseudonymise patient ids of restored revisions", func() {
+				restoreRes, err := CultureAPI.RestoreCultureRevision(ctx, &culture.RestoreCultureRevisionRequest{
+					CultureId: cultureID, RevisionNumber: 1,
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(isPseudonym(restoreRes.PatientId)).Should(BeTrue())
+				Expect(getPseudonym(cultureID)).Should(Equal(restoreRes.PatientId))
+			})
+		})
+		It("should re-identify the patient of a pseudonym", func() {
+			identityRes, err := CultureAPI.ReidentifyPatient(ctx, &culture.ReidentifyPatientRequest{
+				Pseudonym: getPseudonym(cultureID),
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(identityRes.PatientId).Should(Equal(patientID))
+		})
+	})
+})
@@ -0,0 +1,445 @@
+package culture
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gidyon/antibug/internal/modules"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const revisionsTable = "culture_revisions"
+
+// CultureRevision is an immutable record of a change to a culture. Revisions are only ever inserted.
+type CultureRevision struct {
+	ID               uint   `gorm:"primary_key"`
+	CultureID        uint   `gorm:"unique_index:idx_culture_revision;not null"`
+	RevisionNumber   int32  `gorm:"unique_index:idx_culture_revision;type:int(11);not null"`
+	Operation        string `gorm:"type:varchar(20);not null"`
+	ActorID          string `gorm:"type:varchar(50);not null"`
+	Reason           string `gorm:"type:varchar(500)"`
+	Changes          []byte `gorm:"type:json"`
+	Snapshot         []byte `gorm:"type:json;not null"`
+	RestoredRevision int32  `gorm:"type:int(11)"`
+	CreatedAt        time.Time
+}
+
+// TableName ...
+func (*CultureRevision) TableName() string {
+	return revisionsTable
+}
+
+// Defaults are emitted so that changes from zero values such as SUSCEPTIBLE labels are recorded
+var revisionMarshaler = &jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+
+// cultureFields flattens a culture into JSON values keyed by field. Results are keyed by their
+// pathogen and antimicrobial. Editors are left out as they are the actors of revisions.
+func cultureFields(culturePB *culture.Culture) (map[string]string, error) {
+	fields := make(map[string]string)
+	if culturePB == nil {
+		return fields, nil
+	}
+
+	addFields := func(prefix, data string) error {
+		raw := make(map[string]json.RawMessage)
+		err := json.Unmarshal([]byte(data), &raw)
+		if err != nil {
+			return errs.FromJSONUnMarshal(err, "culture")
+		}
+		for field, value := range raw {
+			fields[prefix+field] = string(value)
+		}
+		return nil
+	}
+
+	copyPB := *culturePB
+	copyPB.CultureId, copyPB.Editors, copyPB.CultureResults = "", nil, nil
+
+	data, err := revisionMarshaler.MarshalToString(&copyPB)
+	if err != nil {
+		return nil, errs.FromJSONMarshal(err, "culture")
+	}
+	err = addFields("", data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cultureResult := range culturePB.CultureResults {
+		data, err = revisionMarshaler.MarshalToString(cultureResult)
+		if err != nil {
+			return nil, errs.FromJSONMarshal(err, "culture result")
+		}
+		prefix := fmt.Sprintf("culture_results[%s/%s].", cultureResult.PathogenId, cultureResult.AntimicrobialId)
+		err = addFields(prefix, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return fields, nil
+}
+
+// diffCultures returns the fields that differ between two cultures sorted by field
+func diffCultures(oldCulturePB, newCulturePB *culture.Culture) ([]*culture.FieldChange, error) {
+	oldFields, err := cultureFields(oldCulturePB)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := cultureFields(newCulturePB)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*culture.FieldChange, 0)
+	for field, oldValue := range oldFields {
+		if newValue := newFields[field]; newValue != oldValue {
+			changes = append(changes, &culture.FieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	for field, newValue := range newFields {
+		if _, ok := oldFields[field]; !ok {
+			changes = append(changes, &culture.FieldChange{Field: field, NewValue: newValue})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes, nil
+}
+
+// saveRevision records a change to a culture in the transaction. The old culture is nil for
+// creates and the new culture is nil for deletes.
+func saveRevision(
+	tx *gorm.DB,
+	operation culture.RevisionOperation,
+	cultureID uint,
+	oldCulturePB, newCulturePB *culture.Culture,
+	actorID, reason string,
+	restoredRevision int32,
+) error {
+	changes, err := diffCultures(oldCulturePB, newCulturePB)
+	if err != nil {
+		return err
+	}
+
+	changesData, err := json.Marshal(changes)
+	if err != nil {
+		return errs.FromJSONMarshal(err, "changes")
+	}
+
+	snapshotPB := newCulturePB
+	if snapshotPB == nil {
+		snapshotPB = oldCulturePB
+	}
+	snapshot, err := revisionMarshaler.MarshalToString(snapshotPB)
+	if err != nil {
+		return errs.FromJSONMarshal(err, "culture")
+	}
+
+	// Revisions are numbered per culture
+	var lastRevision int32
+	err = tx.Table(revisionsTable).Where("culture_id=?", cultureID).
+		Select("COALESCE(MAX(revision_number), 0)").Row().Scan(&lastRevision)
+	if err != nil {
+		return errs.SQLQueryFailed(err, "SELECT")
+	}
+
+	err = tx.Create(&CultureRevision{
+		CultureID:        cultureID,
+		RevisionNumber:   lastRevision + 1,
+		Operation:        operation.String(),
+		ActorID:          actorID,
+		Reason:           reason,
+		Changes:          changesData,
+		Snapshot:         []byte(snapshot),
+		RestoredRevision: restoredRevision,
+	}).Error
+	if err != nil {
+		return errs.SQLQueryFailed(err, "SAVE")
+	}
+
+	return nil
+}
+
+func getCultureRevisionPB(revisionDB *CultureRevision) (*culture.CultureRevision, error) {
+	operation, ok := culture.RevisionOperation_value[revisionDB.Operation]
+	if !ok {
+		return nil, errs.WrapMessage(codes.Internal, "unknown revision operation "+revisionDB.Operation)
+	}
+
+	revisionPB := &culture.CultureRevision{
+		CultureId:        fmt.Sprint(revisionDB.CultureID),
+		RevisionNumber:   revisionDB.RevisionNumber,
+		Operation:        culture.RevisionOperation(operation),
+		ActorId:          revisionDB.ActorID,
+		Reason:           revisionDB.Reason,
+		TimestampSec:     revisionDB.CreatedAt.Unix(),
+		RestoredRevision: revisionDB.RestoredRevision,
+		Culture:          &culture.Culture{},
+	}
+
+	if len(revisionDB.Changes) > 0 {
+		err := json.Unmarshal(revisionDB.Changes, &revisionPB.Changes)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "changes")
+		}
+	}
+
+	err := jsonpb.Unmarshal(bytes.NewReader(revisionDB.Snapshot), revisionPB.Culture)
+	if err != nil {
+		return nil, errs.FromJSONUnMarshal(err, "culture")
+	}
+	revisionPB.Culture.CultureId = revisionPB.CultureId
+
+	return revisionPB, nil
+}
+
+// revisionKeyset orders listed revisions of a culture, latest first
+var revisionKeyset = modules.Keyset{Column: "revision_number", Descending: true}
+
+func (capi *cultureAPIServer) ListCultureRevisions(
+	ctx context.Context, listReq *culture.ListCultureRevisionsRequest,
+) (*culture.CultureRevisions, error) {
+	// Request must not be nil
+	if listReq == nil {
+		return nil, errs.NilObject("ListCultureRevisionsRequest")
+	}
+
+	// Authenticate request
+	err := capi.authAPI.AuthenticateRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	if listReq.CultureId == "" {
+		return nil, errs.MissingField("culture id")
+	}
+
+	page, err := modules.NewPage(revisionKeyset, listReq.PageToken, listReq.PageSize, capi.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionsDB := make([]*CultureRevision, 0, page.Size+1)
+	err = page.Apply(capi.sqlDB.Where("culture_id=?", listReq.CultureId)).Find(&revisionsDB).Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "LIST")
+	}
+
+	var nextPageToken string
+	if page.HasNext(len(revisionsDB)) {
+		revisionsDB = revisionsDB[:page.Size]
+		last := revisionsDB[page.Size-1]
+		nextPageToken = page.NextToken(last.RevisionNumber, last.ID)
+	}
+
+	revisionsPB := make([]*culture.CultureRevision, 0, len(revisionsDB))
+	for _, revisionDB := range revisionsDB {
+		revisionPB, err := getCultureRevisionPB(revisionDB)
+		if err != nil {
+			return nil, err
+		}
+		revisionsPB = append(revisionsPB, revisionPB)
+	}
+
+	return &culture.CultureRevisions{
+		Revisions:     revisionsPB,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (capi *cultureAPIServer) getRevision(cultureID string, revisionNumber int32) (*culture.CultureRevision, error) {
+	revisionDB := &CultureRevision{}
+	err := capi.sqlDB.First(revisionDB, "culture_id=? AND revision_number=?", cultureID, revisionNumber).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.NotFound("culture revision", cultureID+"/"+strconv.Itoa(int(revisionNumber)))
+	default:
+		return nil, errs.SQLQueryFailed(err, "GET")
+	}
+
+	return getCultureRevisionPB(revisionDB)
+}
+
+func (capi *cultureAPIServer) GetCultureRevision(
+	ctx context.Context, getReq *culture.GetCultureRevisionRequest,
+) (*culture.CultureRevision, error) {
+	// Request must not be nil
+	if getReq == nil {
+		return nil, errs.NilObject("GetCultureRevisionRequest")
+	}
+
+	// Authenticate request
+	err := capi.authAPI.AuthenticateRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case getReq.CultureId == "":
+		err = errs.MissingField("culture id")
+	case getReq.RevisionNumber <= 0:
+		err = errs.MissingField("revision number")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return capi.getRevision(getReq.CultureId, getReq.RevisionNumber)
+}
+
+func (capi *cultureAPIServer) RestoreCultureRevision(
+	ctx context.Context, restoreReq *culture.RestoreCultureRevisionRequest,
+) (*culture.Culture, error) {
+	// Request must not be nil
+	if restoreReq == nil {
+		return nil, errs.NilObject("RestoreCultureRevisionRequest")
+	}
+
+	// Authorize request
+	payload, err := capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case restoreReq.CultureId == "":
+		err = errs.MissingField("culture id")
+	case restoreReq.RevisionNumber <= 0:
+		err = errs.MissingField("revision number")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	revisionPB, err := capi.getRevision(restoreReq.CultureId, restoreReq.RevisionNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save culture, its rollups and the revision in a transaction
+	tx := capi.sqlDB.Begin()
+	if tx.Error != nil {
+		return nil, errs.SQLQueryFailed(tx.Error, "BEGIN")
+	}
+
+	// Deleted cultures are restored too
+	currentDB := &Culture{}
+	err = tx.Unscoped().Set("gorm:query_option", "FOR UPDATE").First(currentDB, "id=?", restoreReq.CultureId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		tx.Rollback()
+		return nil, errs.NotFound("culture", restoreReq.CultureId)
+	default:
+		tx.Rollback()
+		return nil, errs.SQLQueryFailed(err, "GET")
+	}
+
+	deleted := currentDB.DeletedAt != nil
+
+	currentPB, err := getCulturePB(currentDB)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	restoredPB := revisionPB.Culture
+
+	// The actor must be authorized for the current and restored facility
+	err = auth.AuthorizeFacility(payload, currentPB.HospitalId, restoredPB.HospitalId)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Status changes through transitions only. Restoring final results is an amendment.
+	restoredPB.Status = currentPB.Status
+	if isFinalStatus(restoredPB.Status) {
+		restoredPB.Status = culture.CultureStatus_AMENDED
+	}
+
+	// Results are checked against current catalogues, breakpoints and expert rules
+	err = capi.checkCulture(restoredPB)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Revisions saved before pseudonymisation may have raw patient ids
+	err = capi.pseudonymizeStoredPatient(restoredPB)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	restoredPB.Editors = append(currentPB.Editors, payload.ID)
+
+	restoredDB, err := getCultureDB(restoredPB)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	restoredDB.Model = currentDB.Model
+	restoredDB.IdempotencyKey = currentDB.IdempotencyKey
+	restoredDB.DeletedAt = nil
+
+	err = tx.Unscoped().Save(restoredDB).Error
+	if err != nil {
+		tx.Rollback()
+		return nil, errs.SQLQueryFailed(err, "UPDATE")
+	}
+
+	// Results of deleted cultures were already removed from rollups
+	if !deleted {
+		err = UpdateRollups(tx, currentPB, -1)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	err = UpdateRollups(tx, restoredPB, 1)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = saveRevision(
+		tx, culture.RevisionOperation_RESTORED, currentDB.ID, currentPB, restoredPB,
+		payload.ID, restoreReq.Reason, restoreReq.RevisionNumber,
+	)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "COMMIT")
+	}
+
+	if deleted {
+		capi.publishChange(ctx, newChangeEvent(OperationCreate, restoreReq.CultureId, restoredPB))
+	} else {
+		capi.publishChange(ctx, newChangeEvent(OperationUpdate, restoreReq.CultureId, currentPB, restoredPB))
+	}
+
+	return restoredPB, nil
+}
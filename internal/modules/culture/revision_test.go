@@ -0,0 +1,241 @@
+package culture
+
+import (
+	"context"
+	"github.com/gidyon/antibug/internal/mocks"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Culture revisions #revision", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("Calling revision RPCs with malformed request", func() {
+		It("should fail when list request is nil", func() {
+			listRes, err := CultureAPI.ListCultureRevisions(ctx, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(listRes).To(BeNil())
+		})
+		It("should fail when culture id is missing", func() {
+			listRes, err := CultureAPI.ListCultureRevisions(ctx, &culture.ListCultureRevisionsRequest{})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(listRes).To(BeNil())
+		})
+		It("should fail when revision number is missing", func() {
+			getRes, err := CultureAPI.GetCultureRevision(ctx, &culture.GetCultureRevisionRequest{CultureId: "1"})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(getRes).To(BeNil())
+		})
+		It("should fail when revision does not exist", func() {
+			getRes, err := CultureAPI.GetCultureRevision(ctx, &culture.GetCultureRevisionRequest{
+				CultureId: "0", RevisionNumber: 1,
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			Expect(getRes).To(BeNil())
+		})
+		It("should fail when restoring without culture id", func() {
+			restoreRes, err := CultureAPI.RestoreCultureRevision(ctx, &culture.RestoreCultureRevisionRequest{RevisionNumber: 1})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(restoreRes).To(BeNil())
+		})
+	})
+
+	Describe("Calling revision RPCs with well-formed request", func() {
+		var (
+			cultureID  string
+			patientAge int32
+		)
+
+		BeforeEach(func() {
+			culturePB := FakeCulture()
+			culturePB.PatientAge = 30
+			patientAge = culturePB.PatientAge
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+			cultureID = createRes.CultureId
+		})
+
+		listRevisions := func() []*culture.CultureRevision {
+			listRes, err := CultureAPI.ListCultureRevisions(ctx, &culture.ListCultureRevisionsRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			return listRes.Revisions
+		}
+
+		It("should record a revision when a culture is created", func() {
+			revisions := listRevisions()
+			Expect(revisions).Should(HaveLen(1))
+			Expect(revisions[0].Operation).Should(Equal(culture.RevisionOperation_CREATED))
+			Expect(revisions[0].RevisionNumber).Should(BeEquivalentTo(1))
+			Expect(revisions[0].Culture.PatientAge).Should(Equal(patientAge))
+		})
+
+		It("should record changed fields and reason when a culture is updated", func() {
+			_, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{PatientAge: patientAge + 1},
+				Reason:    "age was mistyped",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			revisions := listRevisions()
+			Expect(revisions).Should(HaveLen(2))
+			Expect(revisions[0].Operation).Should(Equal(culture.RevisionOperation_UPDATED))
+			Expect(revisions[0].ActorId).Should(Equal(mocks.AdminPayload.ID))
+			Expect(revisions[0].Reason).Should(Equal("age was mistyped"))
+			Expect(revisions[0].Changes).Should(HaveLen(1))
+			Expect(revisions[0].Changes[0].Field).Should(Equal("patient_age"))
+			Expect(revisions[0].Changes[0].OldValue).Should(Equal("30"))
+			Expect(revisions[0].Changes[0].NewValue).Should(Equal("31"))
+		})
+
+		It("should page revisions latest first", func() {
+			for age := patientAge + 1; age <= patientAge+3; age++ {
+				_, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+					CultureId: cultureID,
+					Culture:   &culture.Culture{PatientAge: age},
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+
+			revisionNumbers := make([]int32, 0)
+			listReq := &culture.ListCultureRevisionsRequest{CultureId: cultureID, PageSize: 3}
+			for pages := 0; ; pages++ {
+				Expect(pages).Should(BeNumerically("<", 2))
+				listRes, err := CultureAPI.ListCultureRevisions(ctx, listReq)
+				Expect(err).ShouldNot(HaveOccurred())
+				for _, revision := range listRes.Revisions {
+					revisionNumbers = append(revisionNumbers, revision.RevisionNumber)
+				}
+				if listRes.NextPageToken == "" {
+					break
+				}
+				listReq.PageToken = listRes.NextPageToken
+			}
+			Expect(revisionNumbers).Should(Equal([]int32{4, 3, 2, 1}))
+		})
+
+		It("should fail when page token is malformed", func() {
+			listRes, err := CultureAPI.ListCultureRevisions(ctx, &culture.ListCultureRevisionsRequest{
+				CultureId: cultureID, PageToken: "not a token",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(listRes).To(BeNil())
+		})
+
+		It("should restore a previous revision", func() {
+			_, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{PatientAge: patientAge + 10},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			restoreRes, err := CultureAPI.RestoreCultureRevision(ctx, &culture.RestoreCultureRevisionRequest{
+				CultureId: cultureID, RevisionNumber: 1, Reason: "update was wrong",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(restoreRes.PatientAge).Should(Equal(patientAge))
+
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.PatientAge).Should(Equal(patientAge))
+
+			revisionRes, err := CultureAPI.GetCultureRevision(ctx, &culture.GetCultureRevisionRequest{
+				CultureId: cultureID, RevisionNumber: 3,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(revisionRes.Operation).Should(Equal(culture.RevisionOperation_RESTORED))
+			Expect(revisionRes.RestoredRevision).Should(BeEquivalentTo(1))
+		})
+
+		It("should keep disagreements of reported labels when updating and restoring", func() {
+			culturePB := FakeCulture()
+			culturePB.PathogensFound = []string{"eco"}
+			culturePB.AntimicrobialsUsed = []string{"CRO"}
+			culturePB.CultureResults = []*culture.LabTestResult{
+				{PathogenId: "eco", AntimicrobialId: "CRO", DiskDiameter: "18 mm", Label: culture.Label_SUSCEPTIBLE},
+			}
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			expectDisagreement := func(culturePB *culture.Culture) {
+				Expect(culturePB.CultureResults).Should(HaveLen(1))
+				Expect(culturePB.CultureResults[0].Label).Should(Equal(culture.Label_RESISTANT))
+				Expect(culturePB.CultureResults[0].ReportedLabel).Should(Equal(culture.Label_SUSCEPTIBLE))
+				Expect(culturePB.CultureResults[0].LabelDisagreement).Should(BeTrue())
+			}
+
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: createRes.CultureId})
+			Expect(err).ShouldNot(HaveOccurred())
+			expectDisagreement(getRes)
+
+			// Results sent back as read are interpreted again
+			_, err = CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: createRes.CultureId,
+				Culture:   &culture.Culture{CultureResults: getRes.CultureResults},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			getRes, err = CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: createRes.CultureId})
+			Expect(err).ShouldNot(HaveOccurred())
+			expectDisagreement(getRes)
+
+			restoreRes, err := CultureAPI.RestoreCultureRevision(ctx, &culture.RestoreCultureRevisionRequest{
+				CultureId: createRes.CultureId, RevisionNumber: 1,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			expectDisagreement(restoreRes)
+		})
+
+		It("should amend final cultures when restoring revisions in other statuses", func() {
+			culturePB := FakeCulture()
+			culturePB.Status = culture.CultureStatus_PRELIMINARY
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = CultureAPI.TransitionCulture(ctx, &culture.TransitionCultureRequest{
+				CultureId: createRes.CultureId, Status: culture.CultureStatus_FINAL,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			restoreRes, err := CultureAPI.RestoreCultureRevision(ctx, &culture.RestoreCultureRevisionRequest{
+				CultureId: createRes.CultureId, RevisionNumber: 1,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(restoreRes.Status).Should(Equal(culture.CultureStatus_AMENDED))
+			Expect(restoreRes.IsolateClassifications).ShouldNot(BeEmpty())
+
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: createRes.CultureId})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Status).Should(Equal(culture.CultureStatus_AMENDED))
+		})
+
+		It("should record deletes and restore deleted cultures", func() {
+			_, err := CultureAPI.DeleteCulture(ctx, &culture.DeleteCultureRequest{CultureId: cultureID, Reason: "duplicate"})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			revisions := listRevisions()
+			Expect(revisions).Should(HaveLen(2))
+			Expect(revisions[0].Operation).Should(Equal(culture.RevisionOperation_DELETED))
+			Expect(revisions[0].Reason).Should(Equal("duplicate"))
+
+			_, err = CultureAPI.RestoreCultureRevision(ctx, &culture.RestoreCultureRevisionRequest{
+				CultureId: cultureID, RevisionNumber: 1,
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,312 @@
+package culture
+
+import (
+	"context"
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/jinzhu/gorm"
+)
+
+// RollupsTable is the table containing pre-aggregated susceptibility results
+const RollupsTable = "susceptibility_rollups"
+
+// Tables of rollups being rebuilt and replaced by rebuilt rollups
+const (
+	rollupsRebuildTable  = RollupsTable + "_rebuild"
+	rollupsReplacedTable = RollupsTable + "_replaced"
+)
+
+const (
+	rollupLocksTable = "rollup_locks"
+	rollupsLockName  = "rollups"
+)
+
+// RollupLock is a row that culture writes lock for share while changing rollups, and rebuilds lock
+// exclusively while replacing rollups
+type RollupLock struct {
+	Name string `gorm:"primary_key;type:varchar(50)"`
+}
+
+// TableName ...
+func (*RollupLock) TableName() string {
+	return rollupLocksTable
+}
+
+// initRollupLock creates the lock of rollups if it does not exist
+func initRollupLock(db *gorm.DB) error {
+	err := db.Exec("INSERT IGNORE INTO "+rollupLocksTable+" (name) VALUES (?)", rollupsLockName).Error
+	if err != nil {
+		return errs.SQLQueryFailed(err, "INSERT")
+	}
+	return nil
+}
+
+const secondsInDay = 24 * 60 * 60
+
+// Rollup is the number of results of a pathogen against an antimicrobial with a label, for cultures
+// on the same day, facility, ward, specimen type, gender and age. Rollups with an empty antimicrobial count isolates of
+// the pathogen and are labeled by resistance class. Ages are in one year bands, same as patient age of cultures.
+type Rollup struct {
+	Day               int64   `gorm:"primary_key;auto_increment:false;type:bigint(20)"`
+	HospitalID        string  `gorm:"primary_key;type:varchar(50)"`
+	CountyCode        string  `gorm:"primary_key;type:int(11)"`
+	SubCountyCode     string  `gorm:"primary_key;type:int(11)"`
+	PatientGender     string  `gorm:"primary_key;type:varchar(10)"`
+	AgeBand           int32   `gorm:"primary_key;auto_increment:false;type:tinyint(4)"`
+	Ward              string  `gorm:"primary_key;type:varchar(50)"`
+	Department        string  `gorm:"primary_key;type:varchar(30)"`
+	PatientSetting    string  `gorm:"primary_key;type:varchar(30)"`
+	SpecimenType      string  `gorm:"primary_key;type:varchar(30)"`
+	PathogenID        string  `gorm:"primary_key;type:varchar(50)"`
+	AntimicrobialID   string  `gorm:"primary_key;type:varchar(50)"`
+	Label             string  `gorm:"primary_key;type:varchar(30)"`
+	PathogenName      string  `gorm:"type:varchar(50);not null"`
+	AntimicrobialName string  `gorm:"type:varchar(50);not null"`
+	Isolates          int64   `gorm:"type:bigint(20);not null"`
+	ScoreSum          float64 `gorm:"type:double;not null"`
+}
+
+// TableName ...
+func (*Rollup) TableName() string {
+	return RollupsTable
+}
+
+// RollupDay returns the start of the day in UTC containing the timestamp
+func RollupDay(timestampSec int64) int64 {
+	return timestampSec - timestampSec%secondsInDay
+}
+
+func rollupKey(rollup *Rollup) string {
+	return rollup.PathogenID + "/" + rollup.AntimicrobialID + "/" + rollup.Label
+}
+
+// getRollups returns the rollups contributed by results of a culture
+func getRollups(culturePB *culture.Culture) []*Rollup {
+	rollups := make([]*Rollup, 0, len(culturePB.GetCultureResults())+len(culturePB.GetPathogensFound()))
+	rollupsMap := make(map[string]*Rollup, cap(rollups))
+
+	add := func(pathogenID, pathogenName, antimicrobialID, antimicrobialName, label string, score float32) {
+		rollup := &Rollup{
+			Day:               RollupDay(culturePB.ResultsTimestampSec),
+			HospitalID:        culturePB.HospitalId,
+			CountyCode:        culturePB.CountyCode,
+			SubCountyCode:     culturePB.SubCountyCode,
+			PatientGender:     culturePB.PatientGender,
+			AgeBand:           culturePB.PatientAge,
+			Ward:              culturePB.Ward,
+			Department:        culturePB.Department.String(),
+			PatientSetting:    culturePB.PatientSetting.String(),
+			SpecimenType:      culturePB.SpecimenType.String(),
+			PathogenID:        pathogenID,
+			PathogenName:      pathogenName,
+			AntimicrobialID:   antimicrobialID,
+			AntimicrobialName: antimicrobialName,
+			Label:             label,
+		}
+		if existing, ok := rollupsMap[rollupKey(rollup)]; ok {
+			rollup = existing
+		} else {
+			rollupsMap[rollupKey(rollup)] = rollup
+			rollups = append(rollups, rollup)
+		}
+		rollup.Isolates++
+		rollup.ScoreSum += float64(score)
+	}
+
+	resistanceClasses := make(map[string]culture.ResistanceClass, len(culturePB.GetIsolateClassifications()))
+	for _, classification := range culturePB.GetIsolateClassifications() {
+		resistanceClasses[classification.PathogenId] = classification.ResistanceClass
+	}
+
+	// A culture is one isolate of each pathogen with results
+	isolates := make(map[string]bool, len(culturePB.GetPathogensFound()))
+	for _, cultureResult := range culturePB.GetCultureResults() {
+		if !isolates[cultureResult.PathogenId] {
+			isolates[cultureResult.PathogenId] = true
+			resistanceClass := resistanceClasses[cultureResult.PathogenId].String()
+			add(cultureResult.PathogenId, cultureResult.PathogenName, "", "", resistanceClass, 0)
+		}
+		add(
+			cultureResult.PathogenId,
+			cultureResult.PathogenName,
+			cultureResult.AntimicrobialId,
+			cultureResult.AntimicrobialName,
+			cultureResult.Label.String(),
+			cultureResult.SusceptibilityScore,
+		)
+	}
+
+	return rollups
+}
+
+// UpdateRollups adds results of the culture to rollups. Use a negative delta to remove them.
+// Results that are not final are not counted. Changes wait for rebuilds of rollups to finish.
+func UpdateRollups(db *gorm.DB, culturePB *culture.Culture, delta int64) error {
+	if culturePB == nil {
+		return errs.NilObject("culture pb")
+	}
+
+	if !isFinalStatus(culturePB.Status) {
+		return nil
+	}
+
+	err := db.Exec(
+		"SELECT name FROM "+rollupLocksTable+" WHERE name=? LOCK IN SHARE MODE", rollupsLockName,
+	).Error
+	if err != nil {
+		return errs.SQLQueryFailed(err, "LOCK")
+	}
+
+	return addRollups(db, RollupsTable, culturePB, delta)
+}
+
+// addRollups adds results of the culture to rollups in the table
+func addRollups(db *gorm.DB, table string, culturePB *culture.Culture, delta int64) error {
+	for _, rollup := range getRollups(culturePB) {
+		err := db.Exec(
+			`INSERT INTO `+table+` (day, hospital_id, county_code, sub_county_code, patient_gender, age_band,
+			ward, department, patient_setting, specimen_type,
+			pathogen_id, antimicrobial_id, label, pathogen_name, antimicrobial_name, isolates, score_sum)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE isolates=isolates+VALUES(isolates), score_sum=score_sum+VALUES(score_sum),
+			pathogen_name=VALUES(pathogen_name), antimicrobial_name=VALUES(antimicrobial_name)`,
+			rollup.Day, rollup.HospitalID, rollup.CountyCode, rollup.SubCountyCode, rollup.PatientGender, rollup.AgeBand,
+			rollup.Ward, rollup.Department, rollup.PatientSetting, rollup.SpecimenType,
+			rollup.PathogenID, rollup.AntimicrobialID, rollup.Label, rollup.PathogenName, rollup.AntimicrobialName,
+			rollup.Isolates*delta, rollup.ScoreSum*float64(delta),
+		).Error
+		if err != nil {
+			return errs.SQLQueryFailed(err, "UPSERT")
+		}
+	}
+
+	return nil
+}
+
+// RebuildRollups recomputes rollups from the cultures table, reading cultures in batches. Rollups are built
+// in a new table so that its key has the dimensions of rollups added since the rollups table was created.
+// The new table replaces the rollups table atomically, and culture writes wait until it is replaced.
+func RebuildRollups(ctx context.Context, db *gorm.DB, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+
+	err := db.AutoMigrate(&Culture{}, &Rollup{}, &RollupLock{}).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to perform automigration: %v", err)
+	}
+
+	err = initRollupLock(db)
+	if err != nil {
+		return 0, err
+	}
+
+	// Culture writes hold the lock for share until they commit, so results of all committed
+	// writes are in cultures read after the lock is taken
+	lockTx := db.Begin()
+	if lockTx.Error != nil {
+		return 0, errs.SQLQueryFailed(lockTx.Error, "BEGIN")
+	}
+
+	err = lockTx.Exec("SELECT name FROM "+rollupLocksTable+" WHERE name=? FOR UPDATE", rollupsLockName).Error
+	if err != nil {
+		lockTx.Rollback()
+		return 0, errs.SQLQueryFailed(err, "LOCK")
+	}
+
+	count, err := buildRollups(ctx, db, rollupsRebuildTable, batchSize)
+	if err != nil {
+		lockTx.Rollback()
+		return count, err
+	}
+
+	// Reads see either the old or the rebuilt rollups
+	err = db.Exec(
+		"RENAME TABLE " + RollupsTable + " TO " + rollupsReplacedTable + ", " +
+			rollupsRebuildTable + " TO " + RollupsTable,
+	).Error
+	if err != nil {
+		lockTx.Rollback()
+		return count, errs.SQLQueryFailed(err, "RENAME")
+	}
+
+	err = db.DropTableIfExists(rollupsReplacedTable).Error
+	if err != nil {
+		lockTx.Rollback()
+		return count, errs.SQLQueryFailed(err, "DROP")
+	}
+
+	err = lockTx.Commit().Error
+	if err != nil {
+		return count, errs.SQLQueryFailed(err, "COMMIT")
+	}
+
+	return count, nil
+}
+
+// buildRollups creates the table and adds results of all cultures to it
+func buildRollups(ctx context.Context, db *gorm.DB, table string, batchSize int) (int, error) {
+	err := db.DropTableIfExists(table).Error
+	if err != nil {
+		return 0, errs.SQLQueryFailed(err, "DROP")
+	}
+
+	err = db.Table(table).CreateTable(&Rollup{}).Error
+	if err != nil {
+		return 0, errs.SQLQueryFailed(err, "CREATE")
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return 0, errs.SQLQueryFailed(tx.Error, "BEGIN")
+	}
+
+	var (
+		lastID uint
+		count  int
+	)
+
+	for {
+		if errs.CtxCancelled(ctx) {
+			tx.Rollback()
+			return count, errs.CtxError(ctx, "rebuilding rollups")
+		}
+
+		culturesDB := make([]*Culture, 0, batchSize)
+		err = tx.Where("id>?", lastID).Order("id").Limit(batchSize).Find(&culturesDB).Error
+		if err != nil {
+			tx.Rollback()
+			return count, errs.SQLQueryFailed(err, "SELECT")
+		}
+		if len(culturesDB) == 0 {
+			break
+		}
+
+		for _, cultureDB := range culturesDB {
+			culturePB, err := getCulturePB(cultureDB)
+			if err != nil {
+				tx.Rollback()
+				return count, err
+			}
+
+			if isFinalStatus(culturePB.Status) {
+				err = addRollups(tx, table, culturePB, 1)
+				if err != nil {
+					tx.Rollback()
+					return count, err
+				}
+			}
+
+			lastID = cultureDB.ID
+			count++
+		}
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		return count, errs.SQLQueryFailed(err, "COMMIT")
+	}
+
+	return count, nil
+}
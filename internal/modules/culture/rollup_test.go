@@ -0,0 +1,150 @@
+package culture
+
+import (
+	"context"
+	"github.com/Pallinder/go-randomdata"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"sync"
+)
+
+var _ = Describe("Susceptibility rollups #rollup", func() {
+	It("should round timestamps to the start of the day", func() {
+		Expect(RollupDay(1577923200 + 3600)).Should(Equal(int64(1577923200)))
+		Expect(RollupDay(1577923200)).Should(Equal(int64(1577923200)))
+	})
+
+	It("should count one isolate per pathogen and one result per antimicrobial", func() {
+		culturePB := &culture.Culture{
+			HospitalId:          "hospital",
+			CountyCode:          "1",
+			SubCountyCode:       "10",
+			PatientGender:       "female",
+			PatientAge:          30,
+			ResultsTimestampSec: 1577923200 + 3600,
+			CultureResults: []*culture.LabTestResult{
+				{PathogenId: "ecoli", AntimicrobialId: "amoxicillin", Label: culture.Label_RESISTANT, SusceptibilityScore: 2},
+				{PathogenId: "ecoli", AntimicrobialId: "ceftriaxone", Label: culture.Label_SUSCEPTIBLE, SusceptibilityScore: 8},
+				{PathogenId: "kleb", AntimicrobialId: "amoxicillin", Label: culture.Label_RESISTANT, SusceptibilityScore: 1},
+			},
+		}
+
+		rollups := getRollups(culturePB)
+		Expect(rollups).Should(HaveLen(5))
+
+		isolates := 0
+		for _, rollup := range rollups {
+			Expect(rollup.Day).Should(Equal(int64(1577923200)))
+			Expect(rollup.AgeBand).Should(Equal(int32(30)))
+			Expect(rollup.Isolates).Should(Equal(int64(1)))
+			if rollup.AntimicrobialID == "" {
+				isolates++
+				Expect(rollup.Label).Should(Equal(culture.ResistanceClass_UNCLASSIFIED.String()))
+			}
+		}
+		Expect(isolates).Should(Equal(2))
+	})
+
+	It("should aggregate results by ward and specimen", func() {
+		culturePB := &culture.Culture{
+			HospitalId:     "hospital",
+			Ward:           "Ward 4B",
+			Department:     culture.Department_INTENSIVE_CARE,
+			PatientSetting: culture.PatientSetting_INPATIENT,
+			SpecimenType:   culture.SpecimenType_BLOOD,
+			CultureResults: []*culture.LabTestResult{
+				{PathogenId: "ecoli", AntimicrobialId: "amoxicillin", Label: culture.Label_RESISTANT},
+			},
+		}
+
+		for _, rollup := range getRollups(culturePB) {
+			Expect(rollup.Ward).Should(Equal("Ward 4B"))
+			Expect(rollup.Department).Should(Equal("INTENSIVE_CARE"))
+			Expect(rollup.PatientSetting).Should(Equal("INPATIENT"))
+			Expect(rollup.SpecimenType).Should(Equal("BLOOD"))
+		}
+	})
+
+	It("should add and remove results of a culture", func() {
+		culturePB := FakeCulture()
+		culturePB.HospitalId = randomdata.RandStringRunes(20)
+
+		rollupsCount := func() int64 {
+			var isolates struct{ Total int64 }
+			err := CultureServer.sqlDB.Table(RollupsTable).Select("SUM(isolates) AS total").
+				Where("hospital_id=?", culturePB.HospitalId).Scan(&isolates).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			return isolates.Total
+		}
+
+		Expect(UpdateRollups(CultureServer.sqlDB, culturePB, 1)).ShouldNot(HaveOccurred())
+		Expect(rollupsCount()).Should(BeNumerically(">", 0))
+
+		Expect(UpdateRollups(CultureServer.sqlDB, culturePB, -1)).ShouldNot(HaveOccurred())
+		Expect(rollupsCount()).Should(BeZero())
+	})
+
+	Describe("Rollups of cultures", func() {
+		var (
+			culturePB *culture.Culture
+			cultureID string
+			ctx       context.Context
+		)
+
+		rollupsCount := func() int64 {
+			var isolates struct{ Total int64 }
+			err := CultureServer.sqlDB.Table(RollupsTable).Select("SUM(isolates) AS total").
+				Where("hospital_id=?", culturePB.HospitalId).Scan(&isolates).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			return isolates.Total
+		}
+
+		expectedCount := func() int64 {
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			var isolates int64
+			for _, rollup := range getRollups(getRes) {
+				isolates += rollup.Isolates
+			}
+			return isolates
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			culturePB = FakeCulture()
+			culturePB.HospitalId = randomdata.RandStringRunes(20)
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+			cultureID = createRes.CultureId
+		})
+
+		It("should move results of concurrent updates in rollups once", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func(age int32) {
+					defer GinkgoRecover()
+					defer wg.Done()
+					_, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+						CultureId: cultureID,
+						Culture:   &culture.Culture{PatientAge: age},
+					})
+					Expect(err).ShouldNot(HaveOccurred())
+				}(int32(20 + i))
+			}
+			wg.Wait()
+
+			Expect(rollupsCount()).Should(Equal(expectedCount()))
+		})
+
+		It("should rebuild rollups from cultures", func() {
+			_, err := RebuildRollups(context.Background(), CultureServer.sqlDB, 10)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rollupsCount()).Should(Equal(expectedCount()))
+
+			// Culture writes keep changing rollups after rebuilds
+			_, err = CultureAPI.DeleteCulture(ctx, &culture.DeleteCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rollupsCount()).Should(BeZero())
+		})
+	})
+})
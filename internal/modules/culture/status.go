@@ -0,0 +1,189 @@
+package culture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Groups that handle specimens in the lab. Only they may move cultures through the lab workflow
+// or amend final results, even when other groups may change cultures.
+var labGroups = []string{auth.LabTechnician, auth.Admin}
+
+// Groups that may move cultures to a status, keyed by current and next status.
+// Reopening final results withdraws them from antibiograms and is reserved to admins.
+var cultureTransitions = map[culture.CultureStatus]map[culture.CultureStatus][]string{
+	culture.CultureStatus_RECEIVED: {
+		culture.CultureStatus_GROWTH_DETECTED: labGroups,
+		culture.CultureStatus_PRELIMINARY:     labGroups,
+		culture.CultureStatus_FINAL:           labGroups,
+	},
+	culture.CultureStatus_GROWTH_DETECTED: {
+		culture.CultureStatus_PRELIMINARY: labGroups,
+		culture.CultureStatus_FINAL:       labGroups,
+	},
+	culture.CultureStatus_PRELIMINARY: {
+		culture.CultureStatus_FINAL: labGroups,
+	},
+	culture.CultureStatus_FINAL: {
+		culture.CultureStatus_AMENDED:     labGroups,
+		culture.CultureStatus_PRELIMINARY: {auth.Admin},
+	},
+	culture.CultureStatus_AMENDED: {
+		culture.CultureStatus_PRELIMINARY: {auth.Admin},
+	},
+}
+
+// FinalStatuses are names of statuses of cultures with final results. Antibiograms use only final results.
+var FinalStatuses = []string{culture.CultureStatus_FINAL.String(), culture.CultureStatus_AMENDED.String()}
+
+// isFinalStatus checks whether results of cultures in the status are final
+func isFinalStatus(status culture.CultureStatus) bool {
+	return status == culture.CultureStatus_FINAL || status == culture.CultureStatus_AMENDED
+}
+
+// organismsIdentified checks whether organisms of cultures in the status are identified
+func organismsIdentified(status culture.CultureStatus) bool {
+	return status == culture.CultureStatus_PRELIMINARY || isFinalStatus(status)
+}
+
+// authorizeTransition checks that the actor may move a culture between the statuses
+func authorizeTransition(payload *auth.Payload, from, to culture.CultureStatus) error {
+	groups, ok := cultureTransitions[from][to]
+	if !ok {
+		return errs.WrapMessage(
+			codes.FailedPrecondition, fmt.Sprintf("culture cannot move from %s to %s", from, to),
+		)
+	}
+	for _, group := range groups {
+		if group == payload.Group {
+			return nil
+		}
+	}
+	return errs.PermissionDenied(fmt.Sprintf("move culture to %s", to))
+}
+
+func (capi *cultureAPIServer) TransitionCulture(
+	ctx context.Context, transitionReq *culture.TransitionCultureRequest,
+) (*culture.Culture, error) {
+	// Request must not be nil
+	if transitionReq == nil {
+		return nil, errs.NilObject("TransitionCultureRequest")
+	}
+
+	// Authorize request
+	payload, err := capi.authAPI.AuthorizeGroup(ctx, authorizedGroups...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case transitionReq.CultureId == "":
+		err = errs.MissingField("culture id")
+	case culture.CultureStatus_name[int32(transitionReq.Status)] == "":
+		err = errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("unknown culture status %d", transitionReq.Status))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Update status and move results in rollups in a transaction
+	tx := capi.sqlDB.Begin()
+	if tx.Error != nil {
+		return nil, errs.SQLQueryFailed(tx.Error, "BEGIN")
+	}
+
+	oldCulturePB, culturePB, err := transitionCulture(tx, transitionReq, payload)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit().Error
+	if err != nil {
+		return nil, errs.SQLQueryFailed(err, "COMMIT")
+	}
+
+	capi.publishChange(ctx, newChangeEvent(OperationUpdate, transitionReq.CultureId, oldCulturePB, culturePB))
+
+	return culturePB, nil
+}
+
+// transitionCulture moves the culture locked in the transaction to the status of the request. It returns
+// the culture before and after the transition.
+func transitionCulture(
+	tx *gorm.DB, transitionReq *culture.TransitionCultureRequest, payload *auth.Payload,
+) (*culture.Culture, *culture.Culture, error) {
+	cultureDB, err := lockCulture(tx, transitionReq.CultureId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	oldCulturePB, err := getCulturePB(cultureDB)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = auth.AuthorizeFacility(payload, oldCulturePB.HospitalId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = authorizeTransition(payload, oldCulturePB.Status, transitionReq.Status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Cultures must have the results required in the next status
+	culturePB, err := getCulturePB(cultureDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	culturePB.Status = transitionReq.Status
+	culturePB.Editors = append(culturePB.Editors, payload.ID)
+
+	err = validateCulture(culturePB)
+	if err != nil {
+		return nil, nil, errs.WrapMessage(codes.FailedPrecondition, status.Convert(err).Message())
+	}
+
+	editors, err := json.Marshal(culturePB.Editors)
+	if err != nil {
+		return nil, nil, errs.FromJSONMarshal(err, "editors")
+	}
+
+	err = tx.Table(culturesTable).Where("id=?", transitionReq.CultureId).Updates(map[string]interface{}{
+		"status":  culturePB.Status.String(),
+		"editors": editors,
+	}).Error
+	if err != nil {
+		return nil, nil, errs.SQLQueryFailed(err, "UPDATE")
+	}
+
+	err = UpdateRollups(tx, oldCulturePB, -1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = UpdateRollups(tx, culturePB, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = saveRevision(
+		tx, culture.RevisionOperation_UPDATED, cultureDB.ID, oldCulturePB, culturePB,
+		payload.ID, transitionReq.Reason, 0,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return oldCulturePB, culturePB, nil
+}
@@ -0,0 +1,230 @@
+package culture
+
+import (
+	"context"
+	"fmt"
+	"github.com/Pallinder/go-randomdata"
+	authmocks "github.com/gidyon/antibug/internal/mocks/mocks"
+	"github.com/gidyon/antibug/internal/pkg/auth"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Moving cultures through specimen statuses #status", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	// createInStatus creates the culture in the status
+	createInStatus := func(culturePB *culture.Culture, cultureStatus culture.CultureStatus) string {
+		culturePB.Status = cultureStatus
+		createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+		Expect(err).ShouldNot(HaveOccurred())
+		return createRes.CultureId
+	}
+
+	transition := func(cultureID string, cultureStatus culture.CultureStatus) (*culture.Culture, error) {
+		return CultureAPI.TransitionCulture(ctx, &culture.TransitionCultureRequest{
+			CultureId: cultureID, Status: cultureStatus,
+		})
+	}
+
+	Describe("Moving cultures with malformed request", func() {
+		It("should fail when the request is nil", func() {
+			transitionRes, err := CultureAPI.TransitionCulture(ctx, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(transitionRes).To(BeNil())
+		})
+		It("should fail when culture id is missing", func() {
+			transitionRes, err := transition("", culture.CultureStatus_FINAL)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(transitionRes).To(BeNil())
+		})
+		It("should fail when status is unknown", func() {
+			transitionRes, err := transition("1", culture.CultureStatus(99))
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(transitionRes).To(BeNil())
+		})
+		It("should fail when culture does not exist", func() {
+			transitionRes, err := transition("0", culture.CultureStatus_FINAL)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			Expect(transitionRes).To(BeNil())
+		})
+	})
+
+	Describe("Creating cultures in a status", func() {
+		It("should create received cultures without organisms and results", func() {
+			culturePB := FakeCulture()
+			culturePB.PatientId = "received-" + randomdata.RandStringRunes(10)
+			culturePB.PathogensFound = nil
+			culturePB.AntimicrobialsUsed = nil
+			culturePB.CultureResults = nil
+			cultureID := createInStatus(culturePB, culture.CultureStatus_RECEIVED)
+
+			transitionRes, err := transition(cultureID, culture.CultureStatus_FINAL)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			Expect(transitionRes).To(BeNil())
+		})
+		It("should fail to create preliminary cultures without organisms", func() {
+			culturePB := FakeCulture()
+			culturePB.Status = culture.CultureStatus_PRELIMINARY
+			culturePB.PathogensFound = nil
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(createRes).To(BeNil())
+		})
+		It("should fail to create amended cultures", func() {
+			culturePB := FakeCulture()
+			culturePB.Status = culture.CultureStatus_AMENDED
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(createRes).To(BeNil())
+		})
+	})
+
+	Describe("Moving preliminary cultures", func() {
+		var (
+			culturePB *culture.Culture
+			cultureID string
+		)
+
+		rollupsCount := func() int64 {
+			var isolates struct{ Total int64 }
+			err := CultureServer.sqlDB.Table(RollupsTable).Select("SUM(isolates) AS total").
+				Where("hospital_id=?", culturePB.HospitalId).Scan(&isolates).Error
+			Expect(err).ShouldNot(HaveOccurred())
+			return isolates.Total
+		}
+
+		BeforeEach(func() {
+			culturePB = FakeCulture()
+			culturePB.HospitalId = randomdata.RandStringRunes(20)
+			cultureID = createInStatus(culturePB, culture.CultureStatus_PRELIMINARY)
+		})
+
+		It("should not count preliminary results in rollups", func() {
+			Expect(rollupsCount()).Should(BeZero())
+		})
+		It("should count results in rollups once final", func() {
+			transitionRes, err := transition(cultureID, culture.CultureStatus_FINAL)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(transitionRes.Status).Should(Equal(culture.CultureStatus_FINAL))
+			Expect(rollupsCount()).Should(BeNumerically(">", 0))
+		})
+		It("should fail to move cultures back to an earlier status", func() {
+			transitionRes, err := transition(cultureID, culture.CultureStatus_RECEIVED)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			Expect(transitionRes).To(BeNil())
+		})
+		It("should amend final cultures when they are updated", func() {
+			_, err := transition(cultureID, culture.CultureStatus_FINAL)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{PatientAge: 50},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getRes.Status).Should(Equal(culture.CultureStatus_AMENDED))
+			Expect(rollupsCount()).Should(BeNumerically(">", 0))
+		})
+		It("should let only admins reopen final cultures", func() {
+			_, err := transition(cultureID, culture.CultureStatus_FINAL)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			authAPI := &authmocks.AuthAPIMock{}
+			authAPI.On("AuthorizeGroup", mock.Anything, mock.Anything, mock.Anything).Return(&auth.Payload{
+				ID: "labtech-9", Group: auth.LabTechnician, FacilityIDs: []string{culturePB.HospitalId},
+			}, nil)
+			server := *CultureServer
+			server.authAPI = authAPI
+
+			transitionRes, err := server.TransitionCulture(ctx, &culture.TransitionCultureRequest{
+				CultureId: cultureID, Status: culture.CultureStatus_PRELIMINARY,
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(transitionRes).To(BeNil())
+
+			_, err = transition(cultureID, culture.CultureStatus_PRELIMINARY)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rollupsCount()).Should(BeZero())
+		})
+		It("should list cultures by status", func() {
+			listReq := &culture.ListCulturesRequest{
+				Filter: &culture.ListCultureFilter{
+					ListTarget: culture.ListTarget_HOSPITAL,
+					TargetIds:  []string{culturePB.HospitalId},
+					Statuses:   []culture.CultureStatus{culture.CultureStatus_FINAL, culture.CultureStatus_AMENDED},
+				},
+			}
+			listRes, err := CultureAPI.ListCultures(ctx, listReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Cultures).Should(BeEmpty())
+
+			listReq.Filter.Statuses = []culture.CultureStatus{culture.CultureStatus_PRELIMINARY}
+			listRes, err = CultureAPI.ListCultures(ctx, listReq)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(listRes.Cultures).Should(HaveLen(1))
+		})
+	})
+
+	Describe("Moving cultures by groups outside the lab", func() {
+		transitions := []struct{ from, to culture.CultureStatus }{
+			{culture.CultureStatus_RECEIVED, culture.CultureStatus_GROWTH_DETECTED},
+			{culture.CultureStatus_RECEIVED, culture.CultureStatus_PRELIMINARY},
+			{culture.CultureStatus_RECEIVED, culture.CultureStatus_FINAL},
+			{culture.CultureStatus_GROWTH_DETECTED, culture.CultureStatus_PRELIMINARY},
+			{culture.CultureStatus_GROWTH_DETECTED, culture.CultureStatus_FINAL},
+			{culture.CultureStatus_PRELIMINARY, culture.CultureStatus_FINAL},
+			{culture.CultureStatus_FINAL, culture.CultureStatus_AMENDED},
+		}
+
+		for _, t := range transitions {
+			from, to := t.from, t.to
+
+			It(fmt.Sprintf("should fail to move culture from %s to %s", from, to), func() {
+				culturePB := FakeCulture()
+				if from == culture.CultureStatus_RECEIVED {
+					culturePB.PathogensFound = nil
+					culturePB.AntimicrobialsUsed = nil
+					culturePB.CultureResults = nil
+				}
+				cultureID := createInStatus(culturePB, from)
+
+				authAPI := &authmocks.AuthAPIMock{}
+				authAPI.On("AuthorizeGroup", mock.Anything, mock.Anything, mock.Anything).Return(&auth.Payload{
+					ID: "physician-9", Group: auth.Physician, FacilityIDs: []string{culturePB.HospitalId},
+				}, nil)
+				server := *CultureServer
+				server.authAPI = authAPI
+
+				transitionRes, err := server.TransitionCulture(ctx, &culture.TransitionCultureRequest{
+					CultureId: cultureID, Status: to,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(transitionRes).To(BeNil())
+
+				getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(getRes.Status).Should(Equal(from))
+			})
+		}
+	})
+})
@@ -39,13 +39,6 @@ var _ = Describe("Updating Culture Resource #update", func() {
 			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
 			Expect(updateRes).To(BeNil())
 		})
-		It("should fail when editor id is missing", func() {
-			updateReq.EditorId = ""
-			updateRes, err := CultureAPI.UpdateCulture(ctx, updateReq)
-			Expect(err).To(HaveOccurred())
-			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
-			Expect(updateRes).To(BeNil())
-		})
 		It("should fail when culture id is missing", func() {
 			updateReq.CultureId = ""
 			updateRes, err := CultureAPI.UpdateCulture(ctx, updateReq)
@@ -107,4 +100,63 @@ var _ = Describe("Updating Culture Resource #update", func() {
 			})
 		})
 	})
+
+	Describe("Updating the test method of a culture", func() {
+		var (
+			culturePB *culture.Culture
+			cultureID string
+		)
+
+		BeforeEach(func() {
+			culturePB = FakeCulture()
+			culturePB.TestMethod = culture.TestMethod_PHOENIX
+			for _, cultureResult := range culturePB.CultureResults {
+				cultureResult.DiskDiameter = ""
+				cultureResult.Mic = &culture.MIC{Value: 4, Comparator: culture.Comparator_EQUAL, Unit: "mg/L"}
+			}
+			createRes, err := CultureAPI.CreateCulture(ctx, &culture.CreateCultureRequest{Culture: culturePB})
+			Expect(err).ShouldNot(HaveOccurred())
+			cultureID = createRes.CultureId
+		})
+
+		getTestMethod := func() culture.TestMethod {
+			getRes, err := CultureAPI.GetCulture(ctx, &culture.GetCultureRequest{CultureId: cultureID})
+			Expect(err).ShouldNot(HaveOccurred())
+			return getRes.TestMethod
+		}
+
+		It("should keep the test method when it is not given", func() {
+			_, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{PatientAge: 40},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getTestMethod()).Should(Equal(culture.TestMethod_PHOENIX))
+		})
+		It("should change the test method to disk diffusion", func() {
+			for _, cultureResult := range culturePB.CultureResults {
+				cultureResult.Mic = nil
+				cultureResult.DiskDiameter = DiskDiameter()
+			}
+			_, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture: &culture.Culture{
+					TestMethod:     culture.TestMethod_DISK_DIFFUSION,
+					CultureResults: culturePB.CultureResults,
+				},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(getTestMethod()).Should(Equal(culture.TestMethod_DISK_DIFFUSION))
+		})
+		It("should fail to change the test method without results", func() {
+			updateRes, err := CultureAPI.UpdateCulture(ctx, &culture.UpdateCultureRequest{
+				CultureId: cultureID,
+				Culture:   &culture.Culture{TestMethod: culture.TestMethod_DISK_DIFFUSION},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(updateRes).To(BeNil())
+			Expect(getTestMethod()).Should(Equal(culture.TestMethod_PHOENIX))
+		})
+	})
 })
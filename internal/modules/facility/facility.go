@@ -8,8 +8,10 @@ import (
 	"github.com/gidyon/antibug/internal/pkg/auth"
 	"github.com/gidyon/antibug/internal/pkg/errs"
 	"github.com/gidyon/antibug/pkg/api/facility"
+	"github.com/go-redis/redis"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
 	"strings"
 )
@@ -21,14 +23,18 @@ type facilityAPIServer struct {
 	counties    []*facility.County
 	subCounties []*facility.SubCounty
 	data        map[string]*facility.SubCounty
+	maxPageSize int32
 }
 
 // Options contains parameters to new facility API
 type Options struct {
 	SQLDB            *gorm.DB
+	RedisDB          *redis.Client
 	Logger           grpclog.LoggerV2
 	JWTSigningKey    string
 	CountiesDataFile string
+	// Largest page of listed facilities. Zero is defaultMaxPageSize.
+	MaxPageSize int32
 }
 
 // NewFacilityAPI creates a new facility API server
@@ -39,16 +45,20 @@ func NewFacilityAPI(ctx context.Context, opt *Options) (facility.FacilityAPIServ
 		err = errs.NilObject("Context")
 	case opt.SQLDB == nil:
 		err = errs.NilObject("SqlDB")
+	case opt.RedisDB == nil:
+		err = errs.NilObject("RedisClient")
 	case opt.Logger == nil:
 		err = errs.NilObject("Logger")
 	case opt.JWTSigningKey == "":
 		err = errs.MissingField("JWTSigning Key")
+	case opt.MaxPageSize < 0:
+		err = errs.WrapMessage(codes.InvalidArgument, "max page size must not be negative")
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	authAPI, err := auth.NewAPI(opt.JWTSigningKey)
+	authAPI, err := auth.NewAPI(opt.JWTSigningKey, opt.RedisDB)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +70,10 @@ func NewFacilityAPI(ctx context.Context, opt *Options) (facility.FacilityAPIServ
 		counties:    make([]*facility.County, 0),
 		subCounties: make([]*facility.SubCounty, 0),
 		data:        make(map[string]*facility.SubCounty, 0),
+		maxPageSize: opt.MaxPageSize,
+	}
+	if fapi.maxPageSize == 0 {
+		fapi.maxPageSize = defaultMaxPageSize
 	}
 
 	// Perform auto migration
@@ -209,19 +223,13 @@ func (fapi *facilityAPIServer) GetFacility(
 	return facilityPB, nil
 }
 
-const defaultPageSize = 50
+// defaultMaxPageSize is the largest page of listed facilities unless configured
+const defaultMaxPageSize = 50
 
-func normalizePageSixe(pageToken, pageSize int32) (int, int) {
-	if pageToken <= 0 {
-		pageToken = 0
-	}
-	if pageSize <= 0 {
-		pageSize = defaultPageSize
-	}
-	if pageSize > defaultPageSize {
-		pageSize = defaultPageSize
-	}
-	return int(pageToken), int(pageSize)
+// facilityKeysets are orders of listed facilities
+var facilityKeysets = map[facility.FacilitySort]modules.Keyset{
+	facility.FacilitySort_SORT_BY_ID:   {},
+	facility.FacilitySort_SORT_BY_NAME: {Column: "facility_name"},
 }
 
 func (fapi *facilityAPIServer) ListFacilities(
@@ -238,16 +246,38 @@ func (fapi *facilityAPIServer) ListFacilities(
 		return nil, err
 	}
 
-	// Normalize page
-	pageToken, pageSize := normalizePageSixe(listReq.PageToken, listReq.PageSize)
+	// Validation
+	keyset, ok := facilityKeysets[listReq.Sort]
+	if !ok {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "unknown sort "+listReq.Sort.String())
+	}
 
-	facilitiesDB := make([]*Facility, 0, pageSize)
-	err = fapi.sqlDB.Order("created_at DESC").Limit(pageSize).Order("id, created_at ASC").
-		Where("id>?", pageToken).Find(&facilitiesDB).Error
+	var totalCount int64
+	if listReq.IncludeTotal {
+		err = fapi.sqlDB.Model(&Facility{}).Count(&totalCount).Error
+		if err != nil {
+			return nil, errs.SQLQueryFailed(err, "COUNT")
+		}
+	}
+
+	page, err := modules.NewPage(keyset, listReq.PageToken, listReq.PageSize, fapi.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	facilitiesDB := make([]*Facility, 0, page.Size+1)
+	err = page.Apply(fapi.sqlDB).Find(&facilitiesDB).Error
 	if err != nil {
 		return nil, errs.SQLQueryFailed(err, "LIST")
 	}
 
+	var nextPageToken string
+	if page.HasNext(len(facilitiesDB)) {
+		facilitiesDB = facilitiesDB[:page.Size]
+		last := facilitiesDB[page.Size-1]
+		nextPageToken = page.NextToken(last.FacilityName, last.ID)
+	}
+
 	facilitiesPB := make([]*facility.Facility, 0, len(facilitiesDB))
 
 	for _, facilityDB := range facilitiesDB {
@@ -256,12 +286,12 @@ func (fapi *facilityAPIServer) ListFacilities(
 			return nil, err
 		}
 		facilitiesPB = append(facilitiesPB, facilityPB)
-		pageToken = int(facilityDB.ID)
 	}
 
 	return &facility.Facilities{
 		Facilities:    facilitiesPB,
-		NextPageToken: int32(pageToken),
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
 	}, nil
 }
 
@@ -286,14 +316,16 @@ func (fapi *facilityAPIServer) SearchFacilities(
 		}, nil
 	}
 
-	pageToken, pageSize := normalizePageSixe(searchReq.PageToken, searchReq.PageSize)
+	page, err := modules.NewPage(modules.Keyset{}, searchReq.PageToken, searchReq.PageSize, fapi.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
 
 	parsedQuery := modules.ParseQuery(searchReq.Query, " facilities", "facilities")
 
-	facilitiesDB := make([]*Facility, 0, pageSize)
+	facilitiesDB := make([]*Facility, 0, page.Size+1)
 
-	err = fapi.sqlDB.Unscoped().Limit(pageSize).Order("id, created_at ASC").
-		Where("id>?", pageToken).
+	err = page.Apply(fapi.sqlDB.Unscoped()).
 		Find(&facilitiesDB, "MATCH(facility_name) AGAINST(? IN BOOLEAN MODE)", parsedQuery).Error
 	switch {
 	case err == nil:
@@ -301,6 +333,12 @@ func (fapi *facilityAPIServer) SearchFacilities(
 		return nil, errs.SQLQueryFailed(err, "SELECT")
 	}
 
+	var nextPageToken string
+	if page.HasNext(len(facilitiesDB)) {
+		facilitiesDB = facilitiesDB[:page.Size]
+		nextPageToken = page.NextToken(nil, facilitiesDB[page.Size-1].ID)
+	}
+
 	// Populate response
 	facilitiesPB := make([]*facility.Facility, 0, len(facilitiesDB))
 
@@ -310,11 +348,10 @@ func (fapi *facilityAPIServer) SearchFacilities(
 			return nil, err
 		}
 		facilitiesPB = append(facilitiesPB, facilityPB)
-		pageToken = int(facilityDB.ID)
 	}
 
 	return &facility.Facilities{
-		NextPageToken: int32(pageToken),
+		NextPageToken: nextPageToken,
 		Facilities:    facilitiesPB,
 	}, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/gidyon/antibug/internal/mocks"
 	"github.com/gidyon/antibug/pkg/api/facility"
 	"github.com/gidyon/micros"
+	"github.com/go-redis/redis"
 
 	// Imports mysql driver
 	_ "github.com/go-sql-driver/mysql"
@@ -31,6 +32,7 @@ const (
 	dbName         = "antibug"
 	dbAddressLocal = "localhost:3306"
 	dbAddressAws   = "3.21.234.210:30810"
+	redisAddress   = "localhost:6379"
 )
 
 func initDB() (*gorm.DB, error) {
@@ -45,10 +47,15 @@ var _ = BeforeSuite(func() {
 	db, err := initDB()
 	Expect(err).ShouldNot(HaveOccurred())
 
+	redisDB := redis.NewClient(&redis.Options{
+		Addr: redisAddress,
+	})
+
 	db.LogMode(true)
 
 	opt := &Options{
 		SQLDB:         db,
+		RedisDB:       redisDB,
 		Logger:        micros.NewLogger("facility_app"),
 		JWTSigningKey: randomdata.RandStringRunes(32),
 	}
@@ -70,6 +77,11 @@ var _ = BeforeSuite(func() {
 	Expect(err).Should(HaveOccurred())
 
 	opt.SQLDB = db
+	opt.RedisDB = nil
+	_, err = NewFacilityAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.RedisDB = redisDB
 	opt.Logger = nil
 	_, err = NewFacilityAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
@@ -14,9 +14,7 @@ var _ = Describe("List Facilities #list", func() {
 	)
 
 	BeforeEach(func() {
-		listReq = &facility.ListFacilitiesRequest{
-			PageToken: 0,
-		}
+		listReq = &facility.ListFacilitiesRequest{}
 		ctx = context.Background()
 	})
 
@@ -31,12 +29,12 @@ var _ = Describe("List Facilities #list", func() {
 	})
 
 	When("Listing facilities with weird request payload", func() {
-		It("should succeed even when page token is weird", func() {
-			listReq.PageToken = int32(-45)
+		It("should fail when page token is malformed", func() {
+			listReq.PageToken = "-45"
 			listRes, err := FacilityAPI.ListFacilities(context.Background(), listReq)
-			Expect(err).ToNot(HaveOccurred())
-			Expect(status.Code(err)).To(Equal(codes.OK))
-			Expect(listRes).ToNot(BeNil())
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(listRes).To(BeNil())
 		})
 	})
 
@@ -61,13 +59,37 @@ var _ = Describe("List Facilities #list", func() {
 			Expect(len(listRes.Facilities)).ShouldNot(BeZero())
 		})
 
-		It("should list facilities even when page token is large", func() {
-			listReq.PageToken = 3000
+		It("should list the next page after the page token", func() {
+			listReq.PageSize = 1
+			listReq.IncludeTotal = true
 			listRes, err := FacilityAPI.ListFacilities(ctx, listReq)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(status.Code(err)).To(Equal(codes.OK))
-			Expect(listRes).ToNot(BeNil())
-			Expect(len(listRes.Facilities)).Should(BeZero())
+			Expect(listRes.TotalCount).Should(BeNumerically(">=", len(listRes.Facilities)))
+			if listRes.TotalCount == 1 {
+				Expect(listRes.NextPageToken).Should(BeEmpty())
+				return
+			}
+
+			listReq.PageToken = listRes.NextPageToken
+			nextRes, err := FacilityAPI.ListFacilities(ctx, listReq)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextRes.Facilities).Should(HaveLen(1))
+			Expect(nextRes.Facilities[0].FacilityId).ShouldNot(Equal(listRes.Facilities[0].FacilityId))
+		})
+
+		It("should fail when page token is of another sort", func() {
+			listReq.PageSize = 1
+			listReq.Sort = facility.FacilitySort_SORT_BY_NAME
+			listRes, err := FacilityAPI.ListFacilities(ctx, listReq)
+			Expect(err).ToNot(HaveOccurred())
+			if listRes.NextPageToken == "" {
+				return
+			}
+
+			listReq.PageToken = listRes.NextPageToken
+			listReq.Sort = 0
+			_, err = FacilityAPI.ListFacilities(ctx, listReq)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
 		})
 	})
 })
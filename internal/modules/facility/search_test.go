@@ -15,8 +15,7 @@ var _ = Describe("Search Facilities #search", func() {
 
 	BeforeEach(func() {
 		searchReq = &facility.SearchFacilitiesRequest{
-			Query:     "Burnpaper",
-			PageToken: 0,
+			Query: "Burnpaper",
 		}
 		ctx = context.Background()
 	})
@@ -32,12 +31,12 @@ var _ = Describe("Search Facilities #search", func() {
 	})
 
 	When("Searching facilities with weird request payload", func() {
-		It("should succeed when page token is weird", func() {
-			searchReq.PageToken = int32(-45)
+		It("should fail when page token is malformed", func() {
+			searchReq.PageToken = "-45"
 			searchRes, err := FacilityAPI.SearchFacilities(context.Background(), searchReq)
-			Expect(err).ToNot(HaveOccurred())
-			Expect(status.Code(err)).To(Equal(codes.OK))
-			Expect(searchRes).ToNot(BeNil())
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(searchRes).To(BeNil())
 		})
 		It("should return empty results when query is empty", func() {
 			searchReq.Query = ""
@@ -77,13 +76,12 @@ var _ = Describe("Search Facilities #search", func() {
 				Expect(len(searchRes.Facilities)).ShouldNot(BeZero())
 			})
 
-			It("should search facilities even when page token is large", func() {
-				searchReq.PageToken = 300
+			It("should return no page token on the last page", func() {
 				searchRes, err := FacilityAPI.SearchFacilities(ctx, searchReq)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(status.Code(err)).To(Equal(codes.OK))
 				Expect(searchRes).ToNot(BeNil())
-				Expect(len(searchRes.Facilities)).Should(BeZero())
+				Expect(searchRes.NextPageToken).Should(BeEmpty())
 			})
 		})
 	})
@@ -0,0 +1,138 @@
+package modules
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
+	"strconv"
+)
+
+// Keyset orders rows by a column and then by id, which breaks ties between rows with equal values.
+// Rows are ordered by id alone when the column is empty.
+type Keyset struct {
+	Column     string
+	Descending bool
+}
+
+func (keyset Keyset) String() string {
+	if keyset.Descending {
+		return keyset.Column + " desc"
+	}
+	return keyset.Column + " asc"
+}
+
+// pageCursor is the position of the last row of a page
+type pageCursor struct {
+	Keyset string      `json:"k"`
+	Value  interface{} `json:"v,omitempty"`
+	ID     uint        `json:"i"`
+}
+
+// Page is a page of rows after the cursor of a page token
+type Page struct {
+	Keyset Keyset
+	Size   int
+	cursor *pageCursor
+}
+
+// NewPage parses the page token of the keyset. Page size defaults to defaultPageSize and is capped at maxPageSize.
+func NewPage(keyset Keyset, pageToken string, pageSize, maxPageSize int32) (*Page, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	page := &Page{Keyset: keyset, Size: int(pageSize)}
+	if pageToken == "" {
+		return page, nil
+	}
+
+	bs, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "malformed page token")
+	}
+
+	// Numbers are kept as written so that timestamps and ids do not lose precision
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.UseNumber()
+
+	page.cursor = &pageCursor{}
+	err = decoder.Decode(page.cursor)
+	switch {
+	case err != nil:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "malformed page token")
+	case page.cursor.Keyset != keyset.String():
+		return nil, errs.WrapMessage(codes.InvalidArgument, "page token is of another sort order")
+	}
+
+	return page, nil
+}
+
+// Apply orders the query and limits it to rows after the cursor. One more row than the page size is
+// read so that HasNext knows whether another page follows.
+func (page *Page) Apply(db *gorm.DB) *gorm.DB {
+	operator, direction := ">", "ASC"
+	if page.Keyset.Descending {
+		operator, direction = "<", "DESC"
+	}
+
+	if page.cursor != nil {
+		if page.Keyset.Column == "" {
+			db = db.Where(fmt.Sprintf("id %s ?", operator), page.cursor.ID)
+		} else {
+			db = db.Where(
+				fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?)", page.Keyset.Column, operator),
+				page.cursor.Value, page.cursor.Value, page.cursor.ID,
+			)
+		}
+	}
+
+	if page.Keyset.Column != "" {
+		db = db.Order(page.Keyset.Column + " " + direction)
+	}
+
+	return db.Order("id " + direction).Limit(page.Size + 1)
+}
+
+// HasNext reports whether rows read by Apply are followed by another page. The extra row must then be dropped.
+func (page *Page) HasNext(rows int) bool {
+	return rows > page.Size
+}
+
+// NextToken is the page token of rows after the last row of the page, with the column value and id
+func (page *Page) NextToken(value interface{}, id uint) string {
+	cursor := &pageCursor{Keyset: page.Keyset.String(), ID: id}
+	if page.Keyset.Column != "" {
+		cursor.Value = value
+	}
+	// Cursors only have strings and numbers
+	bs, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+// Next is the page of rows after the last row of the page, with the column value and id
+func (page *Page) Next(value interface{}, id uint) *Page {
+	return &Page{
+		Keyset: page.Keyset,
+		Size:   page.Size,
+		cursor: &pageCursor{Keyset: page.Keyset.String(), Value: value, ID: id},
+	}
+}
+
+// ParseMaxPageSize parses a maximum page size setting. Empty settings are zero.
+func ParseMaxPageSize(value string) (int32, error) {
+	if value == "" {
+		return 0, nil
+	}
+	maxPageSize, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || maxPageSize < 0 {
+		return 0, fmt.Errorf("malformed maximum page size %q", value)
+	}
+	return int32(maxPageSize), nil
+}
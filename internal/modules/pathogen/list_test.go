@@ -15,8 +15,7 @@ var _ = Describe("List Pathogens #list", func() {
 
 	BeforeEach(func() {
 		listReq = &pathogen.ListPathogensRequest{
-			View:      pathogen.PathogenView_LIST,
-			PageToken: 0,
+			View: pathogen.PathogenView_LIST,
 		}
 		ctx = context.Background()
 	})
@@ -32,12 +31,12 @@ var _ = Describe("List Pathogens #list", func() {
 	})
 
 	When("Listing pathogens with weird request payload", func() {
-		It("should succeed when page token is weird", func() {
-			listReq.PageToken = int32(-45)
+		It("should fail when page token is malformed", func() {
+			listReq.PageToken = "-45"
 			listRes, err := PathogenAPI.ListPathogens(ctx, listReq)
-			Expect(err).ToNot(HaveOccurred())
-			Expect(status.Code(err)).To(Equal(codes.OK))
-			Expect(listRes).ToNot(BeNil())
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(listRes).To(BeNil())
 		})
 	})
 
@@ -62,13 +61,37 @@ var _ = Describe("List Pathogens #list", func() {
 			Expect(len(listRes.Pathogens)).ShouldNot(BeZero())
 		})
 
-		It("should list pathogens even when page token is large", func() {
-			listReq.PageToken = 3000
+		It("should list the next page after the page token", func() {
+			listReq.PageSize = 1
+			listReq.IncludeTotal = true
 			listRes, err := PathogenAPI.ListPathogens(ctx, listReq)
 			Expect(err).ToNot(HaveOccurred())
-			Expect(status.Code(err)).To(Equal(codes.OK))
-			Expect(listRes).ToNot(BeNil())
-			Expect(len(listRes.Pathogens)).Should(BeZero())
+			Expect(listRes.TotalCount).Should(BeNumerically(">=", len(listRes.Pathogens)))
+			if listRes.TotalCount == 1 {
+				Expect(listRes.NextPageToken).Should(BeEmpty())
+				return
+			}
+
+			listReq.PageToken = listRes.NextPageToken
+			nextRes, err := PathogenAPI.ListPathogens(ctx, listReq)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextRes.Pathogens).Should(HaveLen(1))
+			Expect(nextRes.Pathogens[0].PathogenId).ShouldNot(Equal(listRes.Pathogens[0].PathogenId))
+		})
+
+		It("should fail when page token is of another sort", func() {
+			listReq.PageSize = 1
+			listReq.Sort = pathogen.PathogenSort_SORT_BY_NAME
+			listRes, err := PathogenAPI.ListPathogens(ctx, listReq)
+			Expect(err).ToNot(HaveOccurred())
+			if listRes.NextPageToken == "" {
+				return
+			}
+
+			listReq.PageToken = listRes.NextPageToken
+			listReq.Sort = 0
+			_, err = PathogenAPI.ListPathogens(ctx, listReq)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
 		})
 	})
 })
@@ -8,8 +8,10 @@ import (
 	"github.com/gidyon/antibug/internal/pkg/auth"
 	"github.com/gidyon/antibug/internal/pkg/errs"
 	"github.com/gidyon/antibug/pkg/api/pathogen"
+	"github.com/go-redis/redis"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jinzhu/gorm"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
 	"strings"
 )
@@ -20,16 +22,20 @@ var (
 )
 
 type pathogenAPIServer struct {
-	sqlDB   *gorm.DB
-	logger  grpclog.LoggerV2
-	authAPI auth.Interface
+	sqlDB       *gorm.DB
+	logger      grpclog.LoggerV2
+	authAPI     auth.Interface
+	maxPageSize int32
 }
 
 // Options contains parameters for NewPathogenAPI
 type Options struct {
 	SQLDB         *gorm.DB
+	RedisDB       *redis.Client
 	Logger        grpclog.LoggerV2
 	JWTSigningKey string
+	// Largest page of listed pathogens. Zero is defaultMaxPageSize.
+	MaxPageSize int32
 }
 
 // NewPathogenAPI creates a new pathogen API server
@@ -39,26 +45,34 @@ func NewPathogenAPI(ctx context.Context, opt *Options) (pathogen.PathogenAPIServ
 	switch {
 	case opt.SQLDB == nil:
 		err = errs.NilObject("SqlDB")
+	case opt.RedisDB == nil:
+		err = errs.NilObject("RedisClient")
 	case opt.Logger == nil:
 		err = errs.NilObject("Logger")
 	case opt.JWTSigningKey == "":
 		err = errs.MissingField("JWTSigning Key")
 	case ctx == nil:
 		err = errs.NilObject("Context")
+	case opt.MaxPageSize < 0:
+		err = errs.WrapMessage(codes.InvalidArgument, "max page size must not be negative")
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	authAPI, err := auth.NewAPI(opt.JWTSigningKey)
+	authAPI, err := auth.NewAPI(opt.JWTSigningKey, opt.RedisDB)
 	if err != nil {
 		return nil, err
 	}
 
 	papi := &pathogenAPIServer{
-		sqlDB:   opt.SQLDB,
-		logger:  opt.Logger,
-		authAPI: authAPI,
+		sqlDB:       opt.SQLDB,
+		logger:      opt.Logger,
+		authAPI:     authAPI,
+		maxPageSize: opt.MaxPageSize,
+	}
+	if papi.maxPageSize == 0 {
+		papi.maxPageSize = defaultMaxPageSize
 	}
 
 	// Perform auto migration
@@ -198,19 +212,13 @@ func (papi *pathogenAPIServer) DeletePathogen(
 	return &empty.Empty{}, nil
 }
 
-const defaultPageSize = 50
+// defaultMaxPageSize is the largest page of listed pathogens unless configured
+const defaultMaxPageSize = 50
 
-func normalizePageSize(pageToken, pageSize int32) (int, int) {
-	if pageToken <= 0 {
-		pageToken = 0
-	}
-	if pageSize <= 0 {
-		pageSize = defaultPageSize
-	}
-	if pageSize > defaultPageSize {
-		pageSize = defaultPageSize
-	}
-	return int(pageToken), int(pageSize)
+// pathogenKeysets are orders of listed pathogens
+var pathogenKeysets = map[pathogen.PathogenSort]modules.Keyset{
+	pathogen.PathogenSort_SORT_BY_ID:   {},
+	pathogen.PathogenSort_SORT_BY_NAME: {Column: "pathogen_name"},
 }
 
 func (papi *pathogenAPIServer) ListPathogens(
@@ -227,17 +235,38 @@ func (papi *pathogenAPIServer) ListPathogens(
 		return nil, err
 	}
 
-	// Normalize page
-	pageToken, pageSize := normalizePageSize(listReq.PageToken, listReq.PageSize)
+	// Validation
+	keyset, ok := pathogenKeysets[listReq.Sort]
+	if !ok {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "unknown sort "+listReq.Sort.String())
+	}
 
-	pathogensDB := make([]*Pathogen, 0, pageSize)
-	err = papi.sqlDB.Order("id, created_at ASC").
-		Where("id>?", pageToken).Limit(pageSize).
-		Find(&pathogensDB).Error
+	var totalCount int64
+	if listReq.IncludeTotal {
+		err = papi.sqlDB.Model(&Pathogen{}).Count(&totalCount).Error
+		if err != nil {
+			return nil, errs.SQLQueryFailed(err, "COUNT")
+		}
+	}
+
+	page, err := modules.NewPage(keyset, listReq.PageToken, listReq.PageSize, papi.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	pathogensDB := make([]*Pathogen, 0, page.Size+1)
+	err = page.Apply(papi.sqlDB).Find(&pathogensDB).Error
 	if err != nil {
 		return nil, errs.SQLQueryFailed(err, "LIST")
 	}
 
+	var nextPageToken string
+	if page.HasNext(len(pathogensDB)) {
+		pathogensDB = pathogensDB[:page.Size]
+		last := pathogensDB[page.Size-1]
+		nextPageToken = page.NextToken(last.PathogenName, last.ID)
+	}
+
 	pathogensPB := make([]*pathogen.Pathogen, 0, len(pathogensDB))
 
 	for _, pathogenDB := range pathogensDB {
@@ -246,12 +275,12 @@ func (papi *pathogenAPIServer) ListPathogens(
 			return nil, err
 		}
 		pathogensPB = append(pathogensPB, getPathogenView(pathogenPB, listReq.View))
-		pageToken = int(pathogenDB.ID)
 	}
 
 	return &pathogen.Pathogens{
 		Pathogens:     pathogensPB,
-		NextPageToken: int32(pageToken),
+		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
 	}, nil
 }
 
@@ -276,15 +305,16 @@ func (papi *pathogenAPIServer) SearchPathogens(
 		}, nil
 	}
 
-	// Normalize page
-	pageToken, pageSize := normalizePageSize(searchReq.PageToken, searchReq.PageSize)
+	page, err := modules.NewPage(modules.Keyset{}, searchReq.PageToken, searchReq.PageSize, papi.maxPageSize)
+	if err != nil {
+		return nil, err
+	}
 
 	parsedQuery := modules.ParseQuery(searchReq.Query, " pathogens", "pathogen")
 
-	pathogensDB := make([]*Pathogen, 0, pageSize)
+	pathogensDB := make([]*Pathogen, 0, page.Size+1)
 
-	err = papi.sqlDB.Unscoped().Limit(pageSize).Order("id, created_at ASC").
-		Where("id>?", pageToken).
+	err = page.Apply(papi.sqlDB.Unscoped()).
 		Find(&pathogensDB, "MATCH(pathogen_name) AGAINST(? IN BOOLEAN MODE)", parsedQuery).Error
 	switch {
 	case err == nil:
@@ -292,6 +322,12 @@ func (papi *pathogenAPIServer) SearchPathogens(
 		return nil, errs.SQLQueryFailed(err, "SELECT")
 	}
 
+	var nextPageToken string
+	if page.HasNext(len(pathogensDB)) {
+		pathogensDB = pathogensDB[:page.Size]
+		nextPageToken = page.NextToken(nil, pathogensDB[page.Size-1].ID)
+	}
+
 	// Populate response
 	pathogensPB := make([]*pathogen.Pathogen, 0, len(pathogensDB))
 
@@ -304,7 +340,7 @@ func (papi *pathogenAPIServer) SearchPathogens(
 	}
 
 	return &pathogen.Pathogens{
-		NextPageToken: int32(pageToken),
+		NextPageToken: nextPageToken,
 		Pathogens:     pathogensPB,
 	}, nil
 }
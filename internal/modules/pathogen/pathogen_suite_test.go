@@ -7,6 +7,7 @@ import (
 	"github.com/gidyon/antibug/internal/mocks"
 	"github.com/gidyon/antibug/pkg/api/pathogen"
 	"github.com/gidyon/micros"
+	"github.com/go-redis/redis"
 	"math/rand"
 	"time"
 
@@ -33,6 +34,7 @@ const (
 	dbName         = "antibug"
 	dbAddressAws   = "3.21.234.210:30810"
 	dbAddressLocal = "localhost"
+	redisAddress   = "localhost:6379"
 )
 
 func initDB() (*gorm.DB, error) {
@@ -49,8 +51,13 @@ var _ = BeforeSuite(func() {
 	db, err := initDB()
 	Expect(err).ShouldNot(HaveOccurred())
 
+	redisDB := redis.NewClient(&redis.Options{
+		Addr: redisAddress,
+	})
+
 	opt := &Options{
 		SQLDB:         db,
+		RedisDB:       redisDB,
 		Logger:        micros.NewLogger("pathogen_app"),
 		JWTSigningKey: randomdata.RandStringRunes(32),
 	}
@@ -72,6 +79,11 @@ var _ = BeforeSuite(func() {
 	Expect(err).Should(HaveOccurred())
 
 	opt.SQLDB = db
+	opt.RedisDB = nil
+	_, err = NewPathogenAPI(ctx, opt)
+	Expect(err).Should(HaveOccurred())
+
+	opt.RedisDB = redisDB
 	opt.Logger = nil
 	_, err = NewPathogenAPI(ctx, opt)
 	Expect(err).Should(HaveOccurred())
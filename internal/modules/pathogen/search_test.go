@@ -15,8 +15,7 @@ var _ = Describe("Search Pathogens #search", func() {
 
 	BeforeEach(func() {
 		searchReq = &pathogen.SearchPathogensRequest{
-			Query:     "Burnpaper",
-			PageToken: 0,
+			Query: "Burnpaper",
 		}
 		ctx = context.Background()
 	})
@@ -32,12 +31,12 @@ var _ = Describe("Search Pathogens #search", func() {
 	})
 
 	When("Searching pathogens with weird request payload", func() {
-		It("should succeed when page token is weird", func() {
-			searchReq.PageToken = int32(-45)
+		It("should fail when page token is malformed", func() {
+			searchReq.PageToken = "-45"
 			searchRes, err := PathogenAPI.SearchPathogens(context.Background(), searchReq)
-			Expect(err).ToNot(HaveOccurred())
-			Expect(status.Code(err)).To(Equal(codes.OK))
-			Expect(searchRes).ToNot(BeNil())
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(searchRes).To(BeNil())
 		})
 	})
 
@@ -69,13 +68,12 @@ var _ = Describe("Search Pathogens #search", func() {
 				Expect(len(searchRes.Pathogens)).ShouldNot(BeZero())
 			})
 
-			It("should search pathogens even when page token is large", func() {
-				searchReq.PageToken = 300
+			It("should return no page token on the last page", func() {
 				searchRes, err := PathogenAPI.SearchPathogens(ctx, searchReq)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(status.Code(err)).To(Equal(codes.OK))
 				Expect(searchRes).ToNot(BeNil())
-				Expect(len(searchRes.Pathogens)).Should(BeZero())
+				Expect(searchRes.NextPageToken).Should(BeEmpty())
 			})
 		})
 
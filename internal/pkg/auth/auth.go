@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"github.com/Pallinder/go-randomdata"
 	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/go-redis/redis"
 	"google.golang.org/grpc/codes"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"google.golang.org/grpc/metadata"
@@ -25,6 +27,8 @@ const (
 	LabTechnician = "LAB_TECHNICIAN"
 	// Admin ...
 	Admin = "ADMIN"
+	// DataSteward may re-identify pseudonymised patients
+	DataSteward = "DATA_STEWARD"
 	// Super Admin ...
 )
 
@@ -35,15 +39,32 @@ type Interface interface {
 	AuthorizeGroup(ctx context.Context, allowedGroups ...string) (*Payload, error)
 	AuthorizeStrict(ctx context.Context, actorID string, allowedGroups ...string) (*Payload, error)
 	GenToken(context.Context, *Payload, int64) (string, error)
+	StartSession(ctx context.Context, payload *Payload) (*Tokens, error)
+	RefreshSession(ctx context.Context, refreshToken string, getPayload PayloadFunc) (*Tokens, error)
+	EndSession(ctx context.Context, sessionID string) error
+	RevokeSessions(ctx context.Context, actorID string) error
 }
 
 type authAPI struct {
-	signingKey string
+	signingKey  string
+	redisClient *redis.Client
 }
 
-// NewAPI creates new auth API with given signing key
-func NewAPI(signingKey string) (Interface, error) {
-	api := &authAPI{signingKey}
+// NewAPI creates new auth API with given signing key. Sessions and revoked tokens are kept in redis.
+func NewAPI(signingKey string, redisClient *redis.Client) (Interface, error) {
+	// Validation
+	var err error
+	switch {
+	case signingKey == "":
+		err = errs.MissingField("JWT SigningKey")
+	case redisClient == nil:
+		err = errs.NilObject("RedisClient")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	api := &authAPI{signingKey: signingKey, redisClient: redisClient}
 	return api, nil
 }
 
@@ -122,8 +143,12 @@ func (api *authAPI) AddMD(ctx context.Context, actorID, group string) context.Co
 	return addTokenMD(ctx, token)
 }
 
-// ParseToken parses a jwt token and return claims or error if token is invalid
+// ParseToken parses a jwt token and return claims or error if token is invalid or revoked
 func (api *authAPI) ParseToken(tokenString string) (claims *Claims, err error) {
+	return api.parseToken(context.Background(), tokenString)
+}
+
+func (api *authAPI) parseToken(ctx context.Context, tokenString string) (claims *Claims, err error) {
 	// Handling any panic is good trust me!
 	defer func() {
 		if err2 := recover(); err2 != nil {
@@ -135,7 +160,7 @@ func (api *authAPI) ParseToken(tokenString string) (claims *Claims, err error) {
 		tokenString,
 		&Claims{},
 		func(token *jwt.Token) (interface{}, error) {
-			return api.signingKey, nil
+			return []byte(api.signingKey), nil
 		},
 	)
 	if err != nil {
@@ -147,6 +172,12 @@ func (api *authAPI) ParseToken(tokenString string) (claims *Claims, err error) {
 	if !ok || !token.Valid {
 		return nil, status.Error(codes.Unauthenticated, "the token is not valid")
 	}
+
+	err = api.checkRevoked(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
 	return claims, nil
 }
 
@@ -159,7 +190,7 @@ func (api *authAPI) ParseFromCtx(ctx context.Context) (*Claims, error) {
 		)
 	}
 
-	return api.ParseToken(token)
+	return api.parseToken(ctx, token)
 }
 
 func addTokenMD(ctx context.Context, token string) context.Context {
@@ -168,6 +199,31 @@ func addTokenMD(ctx context.Context, token string) context.Context {
 	)
 }
 
+// AuthorizeFacility checks that the actor holds a job at each facility. Admins are authorized for all facilities.
+func AuthorizeFacility(payload *Payload, facilityIDs ...string) error {
+	if payload == nil {
+		return status.Error(codes.Unauthenticated, "missing token payload")
+	}
+	if payload.Group == Admin {
+		return nil
+	}
+	for _, facilityID := range facilityIDs {
+		if !containsString(payload.FacilityIDs, facilityID) {
+			return status.Errorf(codes.PermissionDenied, "permission denied for facility %s", facilityID)
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func matchGroup(claimGroup string, allowedGroups []string) error {
 	for _, group := range allowedGroups {
 		if claimGroup == group {
@@ -187,14 +243,17 @@ func (api *authAPI) genToken(
 		}
 	}()
 
+	issuedAt := time.Now()
 	token := jwt.NewWithClaims(signingMethod, Claims{
 		Payload: payload,
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: expires,
+			IssuedAt:  issuedAt.Unix(),
 			Issuer:    "umrs",
 		},
+		IssuedAtMs: unixMilli(issuedAt),
 	})
 
 	// Generate the token
-	return token.SignedString(api.signingKey)
+	return token.SignedString([]byte(api.signingKey))
 }
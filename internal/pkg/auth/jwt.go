@@ -9,7 +9,8 @@ import (
 var (
 	signingKey                      = []byte(os.Getenv("JWT_TOKEN"))
 	signingMethod jwt.SigningMethod = jwt.SigningMethodHS256
-	defaultAPI                      = &authAPI{string(signingKey)}
+	// The default API has no redis to check revocations so it rejects tokens
+	defaultAPI = &authAPI{signingKey: string(signingKey)}
 )
 
 // Payload contains jwt payload
@@ -21,12 +22,18 @@ type Payload struct {
 	EmailAddress string
 	Group        string
 	Label        string
+	// Facilities where the actor holds a job. They are read from account jobs at login.
+	FacilityIDs []string
+	// Session of the token. Tokens of a session are revoked when the session ends.
+	SessionID string
 }
 
 // Claims contains JWT claims information
 type Claims struct {
 	*Payload
 	jwt.StandardClaims
+	// Issue time in milliseconds to compare with revocations
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
 }
 
 // AuthenticateRequest authenticates a request whether it contains valid jwt in metadata
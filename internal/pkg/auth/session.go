@@ -0,0 +1,324 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"github.com/gidyon/antibug/internal/pkg/errs"
+	"github.com/go-redis/redis"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Lifetimes of tokens of sessions
+const (
+	// AccessTokenTTL is how long access tokens are valid. They are short-lived and renewed with refresh tokens.
+	AccessTokenTTL = 15 * time.Minute
+	// RefreshTokenTTL is how long a session lasts without being refreshed
+	RefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// Keys of sessions and revocations are namespaced and versioned
+const sessionsPrefix = "auth:v1:"
+
+func sessionKey(sessionID string) string {
+	return sessionsPrefix + "sessions:" + sessionID
+}
+
+func actorSessionsKey(actorID string) string {
+	return sessionsPrefix + "actors:" + actorID + ":sessions"
+}
+
+func revokedSessionKey(sessionID string) string {
+	return sessionsPrefix + "revoked:sessions:" + sessionID
+}
+
+func revokedActorKey(actorID string) string {
+	return sessionsPrefix + "revoked:actors:" + actorID
+}
+
+// Tokens are tokens of a session. A refresh token can only be used once to get new tokens of the session.
+type Tokens struct {
+	SessionID    string
+	AccessToken  string
+	RefreshToken string
+	// Unix time when the access token expires
+	ExpiresAt int64
+	// Payload of the access token
+	Payload *Payload
+}
+
+// PayloadFunc returns the current payload of an actor whose session is refreshed. Errors stop the refresh,
+// e.g when the actor is no longer active.
+type PayloadFunc func(ctx context.Context, actorID string) (*Payload, error)
+
+var (
+	errSessionEnded   = status.Error(codes.Unauthenticated, "session has expired or has been revoked")
+	errRefreshReused  = status.Error(codes.Unauthenticated, "refresh token has already been used; session has been revoked")
+	errRefreshInvalid = status.Error(codes.Unauthenticated, "malformed refresh token")
+)
+
+// Refresh tokens are the session id and a secret. Only the hash of the secret is kept.
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+func splitRefreshToken(refreshToken string) (sessionID, secret string, ok bool) {
+	parts := strings.SplitN(refreshToken, ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// genTokens generates tokens of the session. It returns the hash of the refresh token secret to be saved.
+func (api *authAPI) genTokens(ctx context.Context, sessionID string, payload *Payload) (*Tokens, string, error) {
+	bs := make([]byte, 32)
+	_, err := rand.Read(bs)
+	if err != nil {
+		return nil, "", errs.FailedToGenToken(err)
+	}
+	secret := base64.RawURLEncoding.EncodeToString(bs)
+
+	sessionPayload := *payload
+	sessionPayload.SessionID = sessionID
+
+	expiresAt := time.Now().Add(AccessTokenTTL).Unix()
+	accessToken, err := api.genToken(ctx, &sessionPayload, expiresAt)
+	if err != nil {
+		return nil, "", errs.FailedToGenToken(err)
+	}
+
+	return &Tokens{
+		SessionID:    sessionID,
+		AccessToken:  accessToken,
+		RefreshToken: sessionID + "." + secret,
+		ExpiresAt:    expiresAt,
+		Payload:      &sessionPayload,
+	}, hashSecret(secret), nil
+}
+
+// StartSession starts a session of the actor and returns its first tokens
+func (api *authAPI) StartSession(ctx context.Context, payload *Payload) (*Tokens, error) {
+	// Validation
+	var err error
+	switch {
+	case payload == nil:
+		err = errs.NilObject("Payload")
+	case payload.ID == "":
+		err = errs.MissingField("payload id")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, refreshHash, err := api.genTokens(ctx, uuid.New().String(), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = api.redisClient.TxPipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		pipeliner.HSet(ctx, sessionKey(tokens.SessionID), "actor_id", payload.ID, "refresh_hash", refreshHash)
+		pipeliner.Expire(ctx, sessionKey(tokens.SessionID), RefreshTokenTTL)
+		pipeliner.SAdd(ctx, actorSessionsKey(payload.ID), tokens.SessionID)
+		pipeliner.Expire(ctx, actorSessionsKey(payload.ID), RefreshTokenTTL)
+		return nil
+	})
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "HSET")
+	}
+
+	return tokens, nil
+}
+
+// RefreshSession replaces the refresh token with new tokens of its session. Reusing a replaced refresh token
+// ends the session since the token may have been stolen.
+func (api *authAPI) RefreshSession(
+	ctx context.Context, refreshToken string, getPayload PayloadFunc,
+) (*Tokens, error) {
+	sessionID, secret, ok := splitRefreshToken(refreshToken)
+	if !ok {
+		return nil, errRefreshInvalid
+	}
+
+	key := sessionKey(sessionID)
+	refreshHash := hashSecret(secret)
+
+	session, err := api.redisClient.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, errs.RedisCmdFailed(err, "HGETALL")
+	}
+	if len(session) == 0 {
+		return nil, errSessionEnded
+	}
+	if subtle.ConstantTimeCompare([]byte(session["refresh_hash"]), []byte(refreshHash)) != 1 {
+		err = api.EndSession(ctx, sessionID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errRefreshReused
+	}
+
+	// Payload is read again as the actor may have changed since the session started
+	payload, err := getPayload(ctx, session["actor_id"])
+	if err != nil {
+		return nil, err
+	}
+	payload.ID = session["actor_id"]
+
+	tokens, newRefreshHash, err := api.genTokens(ctx, sessionID, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	// The refresh token is only replaced when no other refresh or logout changed the session meanwhile
+	err = api.redisClient.Watch(ctx, func(tx *redis.Tx) error {
+		currentHash, err := tx.HGet(ctx, key, "refresh_hash").Result()
+		switch {
+		case errors.Is(err, redis.Nil):
+			return errSessionEnded
+		case err != nil:
+			return errs.RedisCmdFailed(err, "HGET")
+		case currentHash != refreshHash:
+			return errRefreshReused
+		}
+		_, err = tx.TxPipelined(ctx, func(pipeliner redis.Pipeliner) error {
+			pipeliner.HSet(ctx, key, "refresh_hash", newRefreshHash)
+			pipeliner.Expire(ctx, key, RefreshTokenTTL)
+			pipeliner.Expire(ctx, actorSessionsKey(payload.ID), RefreshTokenTTL)
+			return nil
+		})
+		return err
+	}, key)
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.TxFailedErr):
+		return nil, errRefreshReused
+	case status.Code(err) != codes.Unknown:
+		return nil, err
+	default:
+		return nil, errs.RedisCmdFailed(err, "EXEC")
+	}
+
+	return tokens, nil
+}
+
+// EndSession ends the session. Its refresh token can no longer be used and its access tokens are revoked.
+func (api *authAPI) EndSession(ctx context.Context, sessionID string) error {
+	// Validation
+	if sessionID == "" {
+		return errs.MissingField("session id")
+	}
+
+	actorID, err := api.redisClient.HGet(ctx, sessionKey(sessionID), "actor_id").Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return errs.RedisCmdFailed(err, "HGET")
+	}
+
+	_, err = api.redisClient.TxPipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		pipeliner.Del(ctx, sessionKey(sessionID))
+		pipeliner.Set(ctx, revokedSessionKey(sessionID), 1, AccessTokenTTL)
+		if actorID != "" {
+			pipeliner.SRem(ctx, actorSessionsKey(actorID), sessionID)
+		}
+		return nil
+	})
+	if err != nil {
+		return errs.RedisCmdFailed(err, "DEL")
+	}
+
+	return nil
+}
+
+// RevokeSessions ends all sessions of the actor. Tokens of the actor issued before are revoked,
+// including tokens that are not of a session.
+func (api *authAPI) RevokeSessions(ctx context.Context, actorID string) error {
+	// Validation
+	if actorID == "" {
+		return errs.MissingField("actor id")
+	}
+
+	sessionIDs, err := api.redisClient.SMembers(ctx, actorSessionsKey(actorID)).Result()
+	if err != nil {
+		return errs.RedisCmdFailed(err, "SMEMBERS")
+	}
+
+	_, err = api.redisClient.TxPipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		for _, sessionID := range sessionIDs {
+			pipeliner.Del(ctx, sessionKey(sessionID))
+			pipeliner.Set(ctx, revokedSessionKey(sessionID), 1, AccessTokenTTL)
+		}
+		pipeliner.Del(ctx, actorSessionsKey(actorID))
+		// Tokens that are not of a session may never expire so the revocation is kept
+		pipeliner.Set(ctx, revokedActorKey(actorID), unixMilli(time.Now()), 0)
+		return nil
+	})
+	if err != nil {
+		return errs.RedisCmdFailed(err, "DEL")
+	}
+
+	return nil
+}
+
+// checkRevoked fails when the session of the token has ended or tokens of the actor were revoked after it was
+// issued. Revocations cannot be checked without redis, e.g by the package default API, so tokens are rejected.
+func (api *authAPI) checkRevoked(ctx context.Context, claims *Claims) error {
+	if api.redisClient == nil {
+		return status.Error(codes.Unauthenticated, "revocations of the token cannot be checked")
+	}
+	if claims.Payload == nil {
+		return nil
+	}
+
+	var (
+		sessionRevoked *redis.IntCmd
+		revokedAt      *redis.StringCmd
+	)
+	_, err := api.redisClient.Pipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		sessionRevoked = pipeliner.Exists(ctx, revokedSessionKey(claims.SessionID))
+		revokedAt = pipeliner.Get(ctx, revokedActorKey(claims.ID))
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return errs.RedisCmdFailed(err, "GET")
+	}
+
+	if sessionRevoked.Val() > 0 {
+		return status.Error(codes.Unauthenticated, "the token has been revoked")
+	}
+
+	value, err := revokedAt.Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return nil
+	case err != nil:
+		return errs.RedisCmdFailed(err, "GET")
+	}
+	revokedMs, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to parse revocation time: %v", err)
+	}
+	// Tokens without issue time in milliseconds are revoked when issued in the second of the revocation
+	issuedMs := claims.IssuedAtMs
+	if issuedMs == 0 {
+		issuedMs = claims.IssuedAt * 1000
+	}
+	if issuedMs <= revokedMs {
+		return status.Error(codes.Unauthenticated, "the token has been revoked")
+	}
+
+	return nil
+}
+
+func unixMilli(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
@@ -0,0 +1,249 @@
+package breakpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// CLSI is the Clinical and Laboratory Standards Institute
+	CLSI = "CLSI"
+	// EUCAST is the European Committee on Antimicrobial Susceptibility Testing
+	EUCAST = "EUCAST"
+)
+
+// Breakpoint is the interpretive criteria of a group of pathogens against an antimicrobial.
+// Zone diameters are in mm and MICs in mg/L. Missing values mean the method cannot be interpreted.
+type Breakpoint struct {
+	Group         string   `json:"group"`
+	Antimicrobial string   `json:"antimicrobial"`
+	DiskContent   string   `json:"disk_content,omitempty"`
+	DiskS         *float64 `json:"disk_s,omitempty"`
+	DiskR         *float64 `json:"disk_r,omitempty"`
+	MICS          *float64 `json:"mic_s,omitempty"`
+	MICR          *float64 `json:"mic_r,omitempty"`
+	// Results between the susceptible and resistant breakpoints are susceptible dose-dependent
+	DoseDependent bool `json:"dose_dependent,omitempty"`
+}
+
+// Table is a version of the breakpoint tables of a standard
+type Table struct {
+	Standard    string              `json:"standard"`
+	Version     string              `json:"version"`
+	Groups      map[string][]string `json:"groups"`
+	Breakpoints []*Breakpoint       `json:"breakpoints"`
+}
+
+// Name identifies the table e.g "CLSI M100-ED30"
+func (table *Table) Name() string {
+	return table.Standard + " " + table.Version
+}
+
+// Interpretation is the label of a result and the table used to interpret it
+type Interpretation struct {
+	Label   culture.Label
+	Version string
+}
+
+// Interpreter interprets disk diameters and MICs using versioned breakpoint tables
+type Interpreter struct {
+	tables         map[string]*Table
+	defaultVersion string
+}
+
+// NewInterpreter creates an interpreter from tables. Results are interpreted with the default
+// version unless another version is requested.
+func NewInterpreter(defaultVersion string, tables ...*Table) (*Interpreter, error) {
+	interpreter := &Interpreter{
+		tables:         make(map[string]*Table, len(tables)),
+		defaultVersion: defaultVersion,
+	}
+	for _, table := range tables {
+		switch {
+		case table.Standard != CLSI && table.Standard != EUCAST:
+			return nil, fmt.Errorf("unknown breakpoint standard %q", table.Standard)
+		case table.Version == "":
+			return nil, fmt.Errorf("missing version of %s breakpoint table", table.Standard)
+		}
+		interpreter.tables[table.Name()] = table
+	}
+	if _, ok := interpreter.tables[defaultVersion]; !ok {
+		return nil, fmt.Errorf("default breakpoint table %q not loaded", defaultVersion)
+	}
+	return interpreter, nil
+}
+
+// LoadDir creates an interpreter from all json breakpoint tables in the directory
+func LoadDir(dir, defaultVersion string) (*Interpreter, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make([]*Table, 0, len(files))
+	for _, file := range files {
+		bs, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read breakpoint table: %v", err)
+		}
+		table := &Table{}
+		err = json.Unmarshal(bs, table)
+		if err != nil {
+			return nil, fmt.Errorf("failed to json unmarshal breakpoint table %s: %v", file, err)
+		}
+		tables = append(tables, table)
+	}
+
+	return NewInterpreter(defaultVersion, tables...)
+}
+
+// DefaultVersion is the name of the table used when no version is requested
+func (interpreter *Interpreter) DefaultVersion() string {
+	return interpreter.defaultVersion
+}
+
+// HasVersion checks whether the version of breakpoint tables is loaded
+func (interpreter *Interpreter) HasVersion(version string) bool {
+	_, ok := interpreter.tables[version]
+	return ok
+}
+
+func normalize(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, sep := range []string{" / ", "/", " + ", "+", " - "} {
+		name = strings.Replace(name, sep, "-", -1)
+	}
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// find returns the breakpoint of the pair or nil if the table has none
+func (table *Table) find(pathogenName, antimicrobialName string) *Breakpoint {
+	pathogenName = strings.ToLower(pathogenName)
+	antimicrobialName = normalize(antimicrobialName)
+	for _, breakpoint := range table.Breakpoints {
+		if normalize(breakpoint.Antimicrobial) != antimicrobialName {
+			continue
+		}
+		for _, member := range table.Groups[breakpoint.Group] {
+			if strings.Contains(pathogenName, strings.ToLower(member)) {
+				return breakpoint
+			}
+		}
+	}
+	return nil
+}
+
+// ParseMeasurement parses a disk diameter or MIC such as "22", "22 mm" or "<=0.5".
+// The comparator is empty for on-scale values.
+func ParseMeasurement(value string) (number float64, comparator string, err error) {
+	value = strings.TrimSpace(value)
+	for _, prefix := range []string{"<=", ">=", "<", ">", "="} {
+		if strings.HasPrefix(value, prefix) {
+			comparator = strings.TrimPrefix(prefix, "=")
+			value = strings.TrimSpace(strings.TrimPrefix(value, prefix))
+			break
+		}
+	}
+	value = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(value, "mg/L"), "mm"))
+	number, err = strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to parse measurement %q", value)
+	}
+	return number, comparator, nil
+}
+
+// intermediate is the label of results between the susceptible and resistant breakpoints
+func (table *Table) intermediate(breakpoint *Breakpoint) culture.Label {
+	// EUCAST I is susceptible, increased exposure
+	if breakpoint.DoseDependent || table.Standard == EUCAST {
+		return culture.Label_DOSE_SUSCEPTIBLE
+	}
+	return culture.Label_INTERMEDIATE
+}
+
+// interpretDisk interprets a zone diameter. Larger zones are more susceptible.
+func (table *Table) interpretDisk(breakpoint *Breakpoint, diameter float64) culture.Label {
+	switch {
+	case diameter >= *breakpoint.DiskS:
+		return culture.Label_SUSCEPTIBLE
+	case table.Standard == EUCAST && diameter < *breakpoint.DiskR,
+		table.Standard == CLSI && diameter <= *breakpoint.DiskR:
+		return culture.Label_RESISTANT
+	}
+	return table.intermediate(breakpoint)
+}
+
+// interpretMIC interprets an MIC. Lower concentrations are more susceptible.
+func (table *Table) interpretMIC(breakpoint *Breakpoint, mic float64) culture.Label {
+	switch {
+	case mic <= *breakpoint.MICS:
+		return culture.Label_SUSCEPTIBLE
+	case table.Standard == EUCAST && mic > *breakpoint.MICR,
+		table.Standard == CLSI && mic >= *breakpoint.MICR:
+		return culture.Label_RESISTANT
+	}
+	return table.intermediate(breakpoint)
+}
+
+// breakpoint returns the table and breakpoint of the pair for the version.
+// The breakpoint is nil when the table has none for the pair.
+func (interpreter *Interpreter) breakpoint(
+	version, pathogenName, antimicrobialName string,
+) (*Table, *Breakpoint, error) {
+	if version == "" {
+		version = interpreter.defaultVersion
+	}
+
+	table, ok := interpreter.tables[version]
+	if !ok {
+		return nil, nil, fmt.Errorf("breakpoint table %q not found", version)
+	}
+
+	return table, table.find(pathogenName, antimicrobialName), nil
+}
+
+// InterpretDisk returns the label of a zone diameter in mm. It returns false when the table
+// has no disk breakpoints for the pair.
+func (interpreter *Interpreter) InterpretDisk(
+	version, pathogenName, antimicrobialName string, diameter float64,
+) (*Interpretation, bool, error) {
+	table, breakpoint, err := interpreter.breakpoint(version, pathogenName, antimicrobialName)
+	if err != nil || breakpoint == nil || breakpoint.DiskS == nil || breakpoint.DiskR == nil {
+		return nil, false, err
+	}
+
+	return &Interpretation{Label: table.interpretDisk(breakpoint, diameter), Version: table.Name()}, true, nil
+}
+
+// InterpretMIC returns the label of an MIC in mg/L. The comparator is one of <, <=, >, >= or empty.
+// It returns false when the table has no MIC breakpoints for the pair or when an off-scale MIC
+// cannot be interpreted.
+func (interpreter *Interpreter) InterpretMIC(
+	version, pathogenName, antimicrobialName string, mic float64, comparator string,
+) (*Interpretation, bool, error) {
+	table, breakpoint, err := interpreter.breakpoint(version, pathogenName, antimicrobialName)
+	if err != nil || breakpoint == nil || breakpoint.MICS == nil || breakpoint.MICR == nil {
+		return nil, false, err
+	}
+
+	label := table.interpretMIC(breakpoint, mic)
+
+	// Off-scale MICs are at most or at least the value
+	switch comparator {
+	case "<", "<=":
+		if label != culture.Label_SUSCEPTIBLE {
+			return nil, false, nil
+		}
+	case ">", ">=":
+		if label != culture.Label_RESISTANT {
+			return nil, false, nil
+		}
+	}
+
+	return &Interpretation{Label: label, Version: table.Name()}, true, nil
+}
@@ -0,0 +1,209 @@
+// Package expertrules checks susceptibility results against expert rules such as intrinsic
+// resistance of pathogens and resistance inferred from other results of the same isolate.
+package expertrules
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gidyon/antibug/pkg/api/culture"
+	"io/ioutil"
+	"strings"
+)
+
+// Severities of rules
+const (
+	// SeverityWarning saves violating results with an annotation
+	SeverityWarning = "warning"
+	// SeverityBlock rejects violating results
+	SeverityBlock = "block"
+)
+
+// Condition matches results of an isolate against any of the antimicrobials with any of the labels
+type Condition struct {
+	Antimicrobials []string `json:"antimicrobials"`
+	Labels         []string `json:"labels"`
+	labels         map[culture.Label]bool
+}
+
+// Rule expects results of pathogens of the group to have the labels of then. Rules with a when
+// condition only apply to isolates with a result matching the condition.
+type Rule struct {
+	ID          string     `json:"id"`
+	Description string     `json:"description"`
+	Group       string     `json:"group"`
+	When        *Condition `json:"when,omitempty"`
+	Then        *Condition `json:"then"`
+	Severity    string     `json:"severity"`
+	// Violating results of warning rules are given the expected label. Then must have one label.
+	Infer bool `json:"infer,omitempty"`
+}
+
+// Label is the label expected by the rule
+func (rule *Rule) Label() culture.Label {
+	return culture.Label(culture.Label_value[rule.Then.Labels[0]])
+}
+
+// RuleSet is a version of expert rules and the pathogen groups they apply to
+type RuleSet struct {
+	Name    string              `json:"name"`
+	Version string              `json:"version"`
+	Groups  map[string][]string `json:"groups"`
+	Rules   []*Rule             `json:"rules"`
+}
+
+// Finding is a result violating a rule
+type Finding struct {
+	Rule   *Rule
+	Result *culture.LabTestResult
+}
+
+// Engine checks results against a rule set
+type Engine struct {
+	ruleSet *RuleSet
+}
+
+func normalize(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+func (condition *Condition) parse() error {
+	if len(condition.Antimicrobials) == 0 {
+		return fmt.Errorf("missing antimicrobials")
+	}
+	if len(condition.Labels) == 0 {
+		return fmt.Errorf("missing labels")
+	}
+	condition.labels = make(map[culture.Label]bool, len(condition.Labels))
+	for _, label := range condition.Labels {
+		value, ok := culture.Label_value[label]
+		if !ok {
+			return fmt.Errorf("unknown label %q", label)
+		}
+		condition.labels[culture.Label(value)] = true
+	}
+	return nil
+}
+
+// NewEngine creates an engine of the rule set
+func NewEngine(ruleSet *RuleSet) (*Engine, error) {
+	if ruleSet.Name == "" || ruleSet.Version == "" {
+		return nil, fmt.Errorf("missing name or version of expert rules")
+	}
+
+	ids := make(map[string]bool, len(ruleSet.Rules))
+	for _, rule := range ruleSet.Rules {
+		var err error
+		switch {
+		case rule.ID == "":
+			err = fmt.Errorf("missing id")
+		case ids[rule.ID]:
+			err = fmt.Errorf("duplicate id")
+		case len(ruleSet.Groups[rule.Group]) == 0:
+			err = fmt.Errorf("unknown group %q", rule.Group)
+		case rule.Severity != SeverityWarning && rule.Severity != SeverityBlock:
+			err = fmt.Errorf("unknown severity %q", rule.Severity)
+		case rule.Then == nil:
+			err = fmt.Errorf("missing then")
+		case rule.Infer && rule.Severity == SeverityBlock:
+			err = fmt.Errorf("blocking rules cannot infer labels")
+		case rule.Infer && len(rule.Then.Labels) != 1:
+			err = fmt.Errorf("rules inferring labels must expect one label")
+		}
+		if err == nil {
+			err = rule.Then.parse()
+		}
+		if err == nil && rule.When != nil {
+			err = rule.When.parse()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("expert rule %q: %v", rule.ID, err)
+		}
+		ids[rule.ID] = true
+	}
+
+	return &Engine{ruleSet: ruleSet}, nil
+}
+
+// Load creates an engine from a json rule set
+func Load(file string) (*Engine, error) {
+	bs, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read expert rules: %v", err)
+	}
+	ruleSet := &RuleSet{}
+	err = json.Unmarshal(bs, ruleSet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to json unmarshal expert rules %s: %v", file, err)
+	}
+	return NewEngine(ruleSet)
+}
+
+// Name identifies the rule set e.g "EUCAST Expert Rules 3.2"
+func (engine *Engine) Name() string {
+	return engine.ruleSet.Name + " " + engine.ruleSet.Version
+}
+
+// inGroup checks whether the pathogen is a member of the group
+func (engine *Engine) inGroup(group, pathogenName string) bool {
+	pathogenName = strings.ToLower(pathogenName)
+	for _, member := range engine.ruleSet.Groups[group] {
+		if strings.Contains(pathogenName, strings.ToLower(member)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (condition *Condition) hasAntimicrobial(antimicrobialName string) bool {
+	antimicrobialName = normalize(antimicrobialName)
+	for _, antimicrobial := range condition.Antimicrobials {
+		if normalize(antimicrobial) == antimicrobialName {
+			return true
+		}
+	}
+	return false
+}
+
+// Check returns results violating rules. Results are of isolates of their pathogen.
+func (engine *Engine) Check(results []*culture.LabTestResult) []*Finding {
+	isolates := make(map[string][]*culture.LabTestResult)
+	pathogens := make([]string, 0)
+	for _, result := range results {
+		if _, ok := isolates[result.PathogenId]; !ok {
+			pathogens = append(pathogens, result.PathogenId)
+		}
+		isolates[result.PathogenId] = append(isolates[result.PathogenId], result)
+	}
+
+	findings := make([]*Finding, 0)
+
+	for _, pathogenID := range pathogens {
+		isolate := isolates[pathogenID]
+		for _, rule := range engine.ruleSet.Rules {
+			if !engine.inGroup(rule.Group, isolate[0].PathogenName) {
+				continue
+			}
+
+			if rule.When != nil {
+				matched := false
+				for _, result := range isolate {
+					if rule.When.hasAntimicrobial(result.AntimicrobialName) && rule.When.labels[result.Label] {
+						matched = true
+						break
+					}
+				}
+				if !matched {
+					continue
+				}
+			}
+
+			for _, result := range isolate {
+				if rule.Then.hasAntimicrobial(result.AntimicrobialName) && !rule.Then.labels[result.Label] {
+					findings = append(findings, &Finding{Rule: rule, Result: result})
+				}
+			}
+		}
+	}
+
+	return findings
+}
@@ -0,0 +1,194 @@
+package fhir
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of FHIR json resources
+const ContentType = "application/fhir+json"
+
+// Code systems used by resources
+const (
+	SystemLOINC                     = "http://loinc.org"
+	SystemUCUM                      = "http://unitsofmeasure.org"
+	SystemDiagnosticService         = "http://terminology.hl7.org/CodeSystem/v2-0074"
+	SystemObservationCategory       = "http://terminology.hl7.org/CodeSystem/observation-category"
+	SystemObservationInterpretation = "http://terminology.hl7.org/CodeSystem/v3-ObservationInterpretation"
+)
+
+// Coding is a code defined by a code system
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+// CodeableConcept is a concept with codings and text
+type CodeableConcept struct {
+	Coding []*Coding `json:"coding,omitempty"`
+	Text   string    `json:"text,omitempty"`
+}
+
+// Reference is a reference to another resource
+type Reference struct {
+	Reference string `json:"reference,omitempty"`
+	Display   string `json:"display,omitempty"`
+}
+
+// Quantity is a measured amount. Comparator is one of <, <=, >= or >.
+type Quantity struct {
+	Value      float64 `json:"value"`
+	Comparator string  `json:"comparator,omitempty"`
+	Unit       string  `json:"unit,omitempty"`
+	System     string  `json:"system,omitempty"`
+	Code       string  `json:"code,omitempty"`
+}
+
+// Annotation is a text note
+type Annotation struct {
+	Text string `json:"text"`
+}
+
+// Observation is a measurement or assertion about a patient
+type Observation struct {
+	ResourceType         string             `json:"resourceType"`
+	ID                   string             `json:"id,omitempty"`
+	Status               string             `json:"status"`
+	Category             []*CodeableConcept `json:"category,omitempty"`
+	Code                 *CodeableConcept   `json:"code"`
+	Subject              *Reference         `json:"subject,omitempty"`
+	EffectiveDateTime    string             `json:"effectiveDateTime,omitempty"`
+	Performer            []*Reference       `json:"performer,omitempty"`
+	ValueQuantity        *Quantity          `json:"valueQuantity,omitempty"`
+	ValueCodeableConcept *CodeableConcept   `json:"valueCodeableConcept,omitempty"`
+	Interpretation       []*CodeableConcept `json:"interpretation,omitempty"`
+	Note                 []*Annotation      `json:"note,omitempty"`
+	Method               *CodeableConcept   `json:"method,omitempty"`
+	HasMember            []*Reference       `json:"hasMember,omitempty"`
+	DerivedFrom          []*Reference       `json:"derivedFrom,omitempty"`
+}
+
+// DiagnosticReport is the findings of diagnostic tests on a patient
+type DiagnosticReport struct {
+	ResourceType       string             `json:"resourceType"`
+	ID                 string             `json:"id,omitempty"`
+	Status             string             `json:"status"`
+	Category           []*CodeableConcept `json:"category,omitempty"`
+	Code               *CodeableConcept   `json:"code"`
+	Subject            *Reference         `json:"subject,omitempty"`
+	EffectiveDateTime  string             `json:"effectiveDateTime,omitempty"`
+	Issued             string             `json:"issued,omitempty"`
+	Performer          []*Reference       `json:"performer,omitempty"`
+	ResultsInterpreter []*Reference       `json:"resultsInterpreter,omitempty"`
+	Specimen           []*Reference       `json:"specimen,omitempty"`
+	Result             []*Reference       `json:"result,omitempty"`
+}
+
+// BundleLink is a link of a bundle e.g to the next page
+type BundleLink struct {
+	Relation string `json:"relation"`
+	URL      string `json:"url"`
+}
+
+// BundleSearch is how an entry was included in a search result
+type BundleSearch struct {
+	Mode string `json:"mode"`
+}
+
+// BundleEntry is a resource in a bundle
+type BundleEntry struct {
+	FullURL  string        `json:"fullUrl,omitempty"`
+	Resource interface{}   `json:"resource"`
+	Search   *BundleSearch `json:"search,omitempty"`
+}
+
+// Bundle is a collection of resources
+type Bundle struct {
+	ResourceType string         `json:"resourceType"`
+	Type         string         `json:"type"`
+	Timestamp    string         `json:"timestamp,omitempty"`
+	Link         []*BundleLink  `json:"link,omitempty"`
+	Entry        []*BundleEntry `json:"entry"`
+}
+
+// OperationOutcomeIssue is an error or warning of an operation
+type OperationOutcomeIssue struct {
+	Severity    string `json:"severity"`
+	Code        string `json:"code"`
+	Diagnostics string `json:"diagnostics,omitempty"`
+}
+
+// OperationOutcome is the outcome of a failed operation
+type OperationOutcome struct {
+	ResourceType string                   `json:"resourceType"`
+	Issue        []*OperationOutcomeIssue `json:"issue"`
+}
+
+// FormatDateTime formats a timestamp as a FHIR dateTime
+func FormatDateTime(timestampSec int64) string {
+	return time.Unix(timestampSec, 0).UTC().Format(time.RFC3339)
+}
+
+// dateLayouts are layouts of FHIR dates from the least to most precise
+var dateLayouts = []struct {
+	layout string
+	next   func(time.Time) time.Time
+}{
+	{"2006", func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }},
+	{"2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }},
+	{"2006-01-02", func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }},
+	{time.RFC3339, func(t time.Time) time.Time { return t.Add(time.Second) }},
+}
+
+// DateParam is a date search parameter e.g ge2020-01-01. The value covers the period from start
+// to end, not including end, implied by its precision.
+type DateParam struct {
+	Prefix string
+	Start  time.Time
+	End    time.Time
+}
+
+// ParseDateParam parses a date search parameter with an optional prefix
+func ParseDateParam(value string) (*DateParam, error) {
+	param := &DateParam{Prefix: "eq"}
+	for _, prefix := range []string{"eq", "ne", "gt", "lt", "ge", "le", "sa", "eb", "ap"} {
+		if strings.HasPrefix(value, prefix) {
+			param.Prefix, value = prefix, strings.TrimPrefix(value, prefix)
+			break
+		}
+	}
+
+	for _, dateLayout := range dateLayouts {
+		t, err := time.Parse(dateLayout.layout, value)
+		if err == nil {
+			param.Start, param.End = t, dateLayout.next(t)
+			return param, nil
+		}
+	}
+
+	return nil, fmt.Errorf("malformed date %q", value)
+}
+
+// WriteResource writes a resource as json with the status code
+func WriteResource(w http.ResponseWriter, statusCode int, resource interface{}) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(statusCode)
+	encoder := json.NewEncoder(w)
+	// Comparators such as <= are written as is
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(resource)
+}
+
+// WriteError writes an operation outcome with an issue of the code e.g invalid, not-found or exception
+func WriteError(w http.ResponseWriter, statusCode int, code, diagnostics string) {
+	WriteResource(w, statusCode, &OperationOutcome{
+		ResourceType: "OperationOutcome",
+		Issue: []*OperationOutcomeIssue{
+			{Severity: "error", Code: code, Diagnostics: diagnostics},
+		},
+	})
+}
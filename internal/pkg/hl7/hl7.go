@@ -0,0 +1,329 @@
+package hl7
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Acknowledgment codes of MSA-1
+const (
+	// AA means the message was accepted
+	AA = "AA"
+	// AE means the message was accepted but could not be processed
+	AE = "AE"
+	// AR means the message was rejected
+	AR = "AR"
+)
+
+// MLLP frame delimiters
+const (
+	startBlock     = 0x0b
+	endBlock       = 0x1c
+	carriageReturn = 0x0d
+)
+
+// Separators of a message. They are declared in MSH-1 and MSH-2.
+type Separators struct {
+	Field        byte
+	Component    byte
+	Repetition   byte
+	Escape       byte
+	Subcomponent byte
+}
+
+func (separators *Separators) encodingCharacters() string {
+	return string([]byte{separators.Component, separators.Repetition, separators.Escape, separators.Subcomponent})
+}
+
+// Segment is a line of a message. Fields are numbered as in the HL7 specification so that
+// Field(1) of MSH is the field separator.
+type Segment struct {
+	Name       string
+	fields     []string
+	separators *Separators
+}
+
+// Message is a parsed HL7 v2 message
+type Message struct {
+	Segments   []*Segment
+	Separators *Separators
+}
+
+// Parse parses an HL7 v2 message. Segments may be separated by carriage returns or new lines.
+func Parse(data []byte) (*Message, error) {
+	data = bytes.TrimSpace(data)
+	if !bytes.HasPrefix(data, []byte("MSH")) || len(data) < 8 {
+		return nil, errors.New("message must start with MSH segment")
+	}
+
+	separators := &Separators{
+		Field:        data[3],
+		Component:    data[4],
+		Repetition:   data[5],
+		Escape:       data[6],
+		Subcomponent: data[7],
+	}
+
+	message := &Message{Separators: separators}
+
+	lines := strings.FieldsFunc(string(data), func(r rune) bool {
+		return r == '\r' || r == '\n'
+	})
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, string(separators.Field))
+		if len(fields[0]) != 3 {
+			return nil, fmt.Errorf("malformed segment %q", line)
+		}
+		segment := &Segment{Name: fields[0], separators: separators}
+		if segment.Name == "MSH" {
+			// MSH-1 is the field separator itself
+			segment.fields = append([]string{"MSH", string(separators.Field)}, fields[1:]...)
+		} else {
+			segment.fields = fields
+		}
+		message.Segments = append(message.Segments, segment)
+	}
+
+	return message, nil
+}
+
+// Segment returns the first segment with the name or nil
+func (message *Message) Segment(name string) *Segment {
+	for _, segment := range message.Segments {
+		if segment.Name == name {
+			return segment
+		}
+	}
+	return nil
+}
+
+// Type returns the message type and trigger event e.g ORU^R01
+func (message *Message) Type() string {
+	msh := message.Segment("MSH")
+	if msh == nil {
+		return ""
+	}
+	return msh.Component(9, 1) + "^" + msh.Component(9, 2)
+}
+
+// ControlID returns MSH-10 message control id
+func (message *Message) ControlID() string {
+	msh := message.Segment("MSH")
+	if msh == nil {
+		return ""
+	}
+	return msh.Field(10)
+}
+
+// Field returns the unescaped field at the position. Only the first repetition is returned.
+func (segment *Segment) Field(position int) string {
+	return segment.Component(position, 0)
+}
+
+// Component returns the unescaped component of the field starting from 1. Component 0 is the whole field.
+func (segment *Segment) Component(position, component int) string {
+	if position < 0 || position >= len(segment.fields) {
+		return ""
+	}
+	value := segment.fields[position]
+	if segment.Name == "MSH" && position <= 2 {
+		return value
+	}
+	value = strings.SplitN(value, string(segment.separators.Repetition), 2)[0]
+	if component > 0 {
+		components := strings.Split(value, string(segment.separators.Component))
+		if component > len(components) {
+			return ""
+		}
+		value = components[component-1]
+	}
+	return segment.unescape(value)
+}
+
+// unescape replaces escape sequences of separators
+func (segment *Segment) unescape(value string) string {
+	escape := string(segment.separators.Escape)
+	if !strings.Contains(value, escape) {
+		return value
+	}
+	return strings.NewReplacer(
+		escape+"F"+escape, string(segment.separators.Field),
+		escape+"S"+escape, string(segment.separators.Component),
+		escape+"R"+escape, string(segment.separators.Repetition),
+		escape+"T"+escape, string(segment.separators.Subcomponent),
+		escape+"E"+escape, escape,
+	).Replace(value)
+}
+
+// ParseTime parses HL7 timestamps YYYY[MM[DD[HH[MM[SS[.S[S[S[S]]]]]]]]][+/-ZZZZ] such as 20200301,
+// 202003011230 or 20200301123000.25+0300. Fractions of seconds without the dot are accepted.
+func ParseTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	malformed := fmt.Errorf("malformed timestamp %q", value)
+
+	datetime, zone := value, ""
+	if i := strings.IndexAny(value, "+-"); i > 0 {
+		datetime, zone = value[:i], value[i:]
+	}
+
+	fraction := ""
+	if i := strings.IndexByte(datetime, '.'); i >= 0 {
+		datetime, fraction = datetime[:i], datetime[i+1:]
+	} else if len(datetime) > 14 {
+		datetime, fraction = datetime[:14], datetime[14:]
+	}
+
+	switch {
+	case len(datetime) < 4 || len(datetime) > 14 || len(datetime)%2 != 0:
+		return time.Time{}, malformed
+	case fraction != "" && (len(datetime) != 14 || len(fraction) > 4 || !isDigits(fraction)):
+		return time.Time{}, malformed
+	case zone != "" && (len(zone) != 5 || !isDigits(zone[1:])):
+		return time.Time{}, malformed
+	}
+
+	layout := "20060102150405"[:len(datetime)]
+	if zone != "" {
+		layout += "-0700"
+		datetime += zone
+	}
+
+	t, err := time.Parse(layout, datetime)
+	if err != nil {
+		return time.Time{}, malformed
+	}
+
+	if fraction != "" {
+		nanoseconds, err := strconv.Atoi((fraction + "000000000")[:9])
+		if err != nil {
+			return time.Time{}, malformed
+		}
+		t = t.Add(time.Duration(nanoseconds))
+	}
+
+	return t, nil
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+// NewACK creates an acknowledgment of the message with the code and text
+func NewACK(message *Message, code, text string) []byte {
+	separators := &Separators{Field: '|', Component: '^', Repetition: '~', Escape: '\\', Subcomponent: '&'}
+
+	var sendingApp, sendingFacility, receivingApp, receivingFacility, trigger, version string
+	if message != nil {
+		separators = message.Separators
+		if msh := message.Segment("MSH"); msh != nil {
+			sendingApp, sendingFacility = msh.Field(3), msh.Field(4)
+			receivingApp, receivingFacility = msh.Field(5), msh.Field(6)
+			trigger, version = msh.Component(9, 2), msh.Field(12)
+		}
+	}
+	if version == "" {
+		version = "2.5.1"
+	}
+
+	field := string(separators.Field)
+	escape := strings.NewReplacer(
+		string(separators.Escape), string([]byte{separators.Escape, 'E', separators.Escape}),
+		field, string([]byte{separators.Escape, 'F', separators.Escape}),
+		string(separators.Component), string([]byte{separators.Escape, 'S', separators.Escape}),
+		string(separators.Repetition), string([]byte{separators.Escape, 'R', separators.Escape}),
+		string(separators.Subcomponent), string([]byte{separators.Escape, 'T', separators.Escape}),
+		"\r", " ", "\n", " ",
+	)
+
+	controlID := ""
+	if message != nil {
+		controlID = message.ControlID()
+	}
+
+	messageType := "ACK"
+	if trigger != "" {
+		messageType += string(separators.Component) + trigger
+	}
+
+	// Receiving and sending parties are swapped in the acknowledgment
+	msh := strings.Join([]string{
+		"MSH", separators.encodingCharacters(), receivingApp, receivingFacility, sendingApp, sendingFacility,
+		time.Now().Format("20060102150405"), "", messageType, "ACK" + controlID, "P", version,
+	}, field)
+	msa := strings.Join([]string{"MSA", code, controlID, escape.Replace(text)}, field)
+
+	return []byte(msh + "\r" + msa + "\r")
+}
+
+// ErrFrameTooLarge is returned when an MLLP frame is larger than the maximum size of messages
+var ErrFrameTooLarge = errors.New("mllp frame is too large")
+
+// ReadMLLP reads a message framed with the minimal lower layer protocol. Frames larger than maxSize
+// are not read to the end, and the reader can no longer be used.
+func ReadMLLP(reader *bufio.Reader, maxSize int) ([]byte, error) {
+	// Skip bytes before the start of the block
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if b == startBlock {
+			break
+		}
+	}
+
+	data := make([]byte, 0, reader.Size())
+	for {
+		chunk, err := reader.ReadSlice(endBlock)
+		data = append(data, chunk...)
+		// The end block is not part of the message
+		if len(data) > maxSize+1 {
+			return nil, ErrFrameTooLarge
+		}
+		if err == nil {
+			break
+		}
+		switch {
+		case errors.Is(err, bufio.ErrBufferFull):
+			continue
+		case errors.Is(err, io.EOF):
+			return nil, io.ErrUnexpectedEOF
+		default:
+			return nil, err
+		}
+	}
+
+	b, err := reader.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	if b != carriageReturn {
+		return nil, errors.New("mllp block must end with a carriage return")
+	}
+
+	return data[:len(data)-1], nil
+}
+
+// WriteMLLP writes a message framed with the minimal lower layer protocol
+func WriteMLLP(writer io.Writer, data []byte) error {
+	frame := make([]byte, 0, len(data)+3)
+	frame = append(frame, startBlock)
+	frame = append(frame, data...)
+	frame = append(frame, endBlock, carriageReturn)
+	_, err := writer.Write(frame)
+	return err
+}
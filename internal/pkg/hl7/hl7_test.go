@@ -0,0 +1,67 @@
+package hl7
+
+import (
+	"bufio"
+	"bytes"
+	"time"
+)
+
+var _ = Describe("Parsing HL7 timestamps", func() {
+	DescribeTable("well-formed timestamps",
+		func(value string, expected time.Time) {
+			t, err := ParseTime(value)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(t.Equal(expected)).Should(BeTrue(), "parsed %s as %s", value, t)
+		},
+		Entry("year", "2020", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("date", "20200301", time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("minutes", "202003011230", time.Date(2020, 3, 1, 12, 30, 0, 0, time.UTC)),
+		Entry("seconds", "20200301123000", time.Date(2020, 3, 1, 12, 30, 0, 0, time.UTC)),
+		Entry("fraction", "20200301123000.1234", time.Date(2020, 3, 1, 12, 30, 0, 123400000, time.UTC)),
+		Entry("zone", "20200301123000+0300", time.Date(2020, 3, 1, 9, 30, 0, 0, time.UTC)),
+		Entry("date with zone", "20200301-0500", time.Date(2020, 3, 1, 5, 0, 0, 0, time.UTC)),
+		Entry("fraction with zone", "20200301123000.5+0300", time.Date(2020, 3, 1, 9, 30, 0, 500000000, time.UTC)),
+		Entry("fraction without dot with zone", "202003011230001+0300", time.Date(2020, 3, 1, 9, 30, 0, 100000000, time.UTC)),
+	)
+
+	DescribeTable("malformed timestamps",
+		func(value string) {
+			_, err := ParseTime(value)
+			Expect(err).Should(HaveOccurred())
+		},
+		Entry("empty", ""),
+		Entry("short year", "202"),
+		Entry("odd length", "2020030"),
+		Entry("fraction of minutes", "202003011230.5"),
+		Entry("long fraction", "20200301123000.12345"),
+		Entry("long fraction without dot", "2020030112300012345"),
+		Entry("long fraction with zone", "2020030112300012345+0300"),
+		Entry("short zone", "20200301123000+03"),
+		Entry("letters", "2020MA01"),
+		Entry("month out of range", "20201301"),
+	)
+})
+
+var _ = Describe("Reading MLLP frames", func() {
+	frame := func(data []byte) *bufio.Reader {
+		buf := &bytes.Buffer{}
+		Expect(WriteMLLP(buf, data)).ShouldNot(HaveOccurred())
+		return bufio.NewReader(buf)
+	}
+
+	It("should read messages up to the maximum size", func() {
+		data := bytes.Repeat([]byte("a"), 10000)
+		message, err := ReadMLLP(frame(data), len(data))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(message).Should(Equal(data))
+	})
+	It("should fail for messages larger than the maximum size", func() {
+		data := bytes.Repeat([]byte("a"), 10001)
+		_, err := ReadMLLP(frame(data), 10000)
+		Expect(err).Should(Equal(ErrFrameTooLarge))
+	})
+	It("should fail for frames without an end block", func() {
+		_, err := ReadMLLP(bufio.NewReader(bytes.NewReader([]byte{startBlock, 'a'})), 10000)
+		Expect(err).Should(HaveOccurred())
+	})
+})
@@ -0,0 +1,263 @@
+// Package parquet writes flat tables of required columns as uncompressed parquet files.
+// Rows are buffered one row group at a time so that memory is bounded by the row group size.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// ContentType is the media type of parquet files
+const ContentType = "application/vnd.apache.parquet"
+
+const magic = "PAR1"
+
+// Kind is the type of values of a column
+type Kind int
+
+// Kinds of columns
+const (
+	String Kind = iota
+	Int64
+	Double
+)
+
+// Physical types, encodings and converted types of the parquet format
+const (
+	typeInt64     = 2
+	typeDouble    = 5
+	typeByteArray = 6
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	convertedUTF8 = 0
+	required      = 0
+	dataPage      = 0
+	uncompressed  = 0
+)
+
+var physicalTypes = map[Kind]int32{
+	String: typeByteArray,
+	Int64:  typeInt64,
+	Double: typeDouble,
+}
+
+// Column is a named column of a kind
+type Column struct {
+	Name string
+	Kind Kind
+}
+
+type columnChunk struct {
+	offset int64
+	size   int64
+}
+
+type rowGroup struct {
+	chunks []columnChunk
+	rows   int64
+	size   int64
+}
+
+// Writer writes rows of the columns to a parquet file
+type Writer struct {
+	w            io.Writer
+	offset       int64
+	columns      []Column
+	buffers      []*bytes.Buffer
+	rows         int
+	rowGroupSize int
+	rowGroups    []*rowGroup
+	totalRows    int64
+}
+
+// NewWriter creates a writer of the columns. Row groups have at most rowGroupSize rows.
+func NewWriter(w io.Writer, columns []Column, rowGroupSize int) (*Writer, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("parquet files must have columns")
+	}
+	for _, column := range columns {
+		if _, ok := physicalTypes[column.Kind]; !ok {
+			return nil, fmt.Errorf("column %s has unknown kind %d", column.Name, column.Kind)
+		}
+	}
+	if rowGroupSize <= 0 {
+		return nil, fmt.Errorf("row group size must be positive")
+	}
+
+	writer := &Writer{
+		w:            w,
+		columns:      columns,
+		buffers:      make([]*bytes.Buffer, len(columns)),
+		rowGroupSize: rowGroupSize,
+		rowGroups:    make([]*rowGroup, 0),
+	}
+	for i := range writer.buffers {
+		writer.buffers[i] = &bytes.Buffer{}
+	}
+
+	err := writer.write([]byte(magic))
+	if err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+func (writer *Writer) write(bs []byte) error {
+	n, err := writer.w.Write(bs)
+	writer.offset += int64(n)
+	return err
+}
+
+// Write adds a row. Values are string, int64 or float64 following kinds of the columns.
+func (writer *Writer) Write(values []interface{}) error {
+	if len(values) != len(writer.columns) {
+		return fmt.Errorf("row has %d values for %d columns", len(values), len(writer.columns))
+	}
+
+	for i, value := range values {
+		column, buffer := writer.columns[i], writer.buffers[i]
+		var bs [8]byte
+		switch v := value.(type) {
+		case string:
+			if column.Kind != String {
+				return fmt.Errorf("column %s: unexpected string value", column.Name)
+			}
+			binary.LittleEndian.PutUint32(bs[:4], uint32(len(v)))
+			buffer.Write(bs[:4])
+			buffer.WriteString(v)
+		case int64:
+			if column.Kind != Int64 {
+				return fmt.Errorf("column %s: unexpected int64 value", column.Name)
+			}
+			binary.LittleEndian.PutUint64(bs[:], uint64(v))
+			buffer.Write(bs[:])
+		case float64:
+			if column.Kind != Double {
+				return fmt.Errorf("column %s: unexpected float64 value", column.Name)
+			}
+			binary.LittleEndian.PutUint64(bs[:], math.Float64bits(v))
+			buffer.Write(bs[:])
+		default:
+			return fmt.Errorf("column %s: unsupported value of type %T", column.Name, value)
+		}
+	}
+
+	writer.rows++
+	if writer.rows == writer.rowGroupSize {
+		return writer.flushRowGroup()
+	}
+
+	return nil
+}
+
+// flushRowGroup writes buffered rows as a row group with one data page per column
+func (writer *Writer) flushRowGroup() error {
+	group := &rowGroup{
+		chunks: make([]columnChunk, 0, len(writer.columns)),
+		rows:   int64(writer.rows),
+	}
+
+	for _, buffer := range writer.buffers {
+		header := &compactWriter{}
+		header.writeStruct(func() {
+			header.i32Field(1, dataPage)
+			header.i32Field(2, int32(buffer.Len()))
+			header.i32Field(3, int32(buffer.Len()))
+			header.structField(5, func() {
+				header.i32Field(1, int32(writer.rows))
+				header.i32Field(2, encodingPlain)
+				header.i32Field(3, encodingRLE)
+				header.i32Field(4, encodingRLE)
+			})
+		})
+
+		chunk := columnChunk{offset: writer.offset, size: int64(len(header.buf) + buffer.Len())}
+
+		err := writer.write(header.buf)
+		if err != nil {
+			return err
+		}
+		err = writer.write(buffer.Bytes())
+		if err != nil {
+			return err
+		}
+		buffer.Reset()
+
+		group.chunks = append(group.chunks, chunk)
+		group.size += chunk.size
+	}
+
+	writer.rowGroups = append(writer.rowGroups, group)
+	writer.totalRows += group.rows
+	writer.rows = 0
+
+	return nil
+}
+
+// Close writes buffered rows and the file metadata. It does not close the underlying writer.
+func (writer *Writer) Close() error {
+	if writer.rows > 0 {
+		err := writer.flushRowGroup()
+		if err != nil {
+			return err
+		}
+	}
+
+	footer := &compactWriter{}
+	footer.writeStruct(func() {
+		footer.i32Field(1, 1)
+		footer.structListField(2, len(writer.columns)+1, func(i int) {
+			if i == 0 {
+				footer.binaryField(4, "schema")
+				footer.i32Field(5, int32(len(writer.columns)))
+				return
+			}
+			column := writer.columns[i-1]
+			footer.i32Field(1, physicalTypes[column.Kind])
+			footer.i32Field(3, required)
+			footer.binaryField(4, column.Name)
+			if column.Kind == String {
+				footer.i32Field(6, convertedUTF8)
+			}
+		})
+		footer.i64Field(3, writer.totalRows)
+		footer.structListField(4, len(writer.rowGroups), func(i int) {
+			group := writer.rowGroups[i]
+			footer.structListField(1, len(group.chunks), func(j int) {
+				chunk, column := group.chunks[j], writer.columns[j]
+				footer.i64Field(2, chunk.offset)
+				footer.structField(3, func() {
+					footer.i32Field(1, physicalTypes[column.Kind])
+					footer.i32ListField(2, encodingPlain, encodingRLE)
+					footer.binaryListField(3, column.Name)
+					footer.i32Field(4, uncompressed)
+					footer.i64Field(5, group.rows)
+					footer.i64Field(6, chunk.size)
+					footer.i64Field(7, chunk.size)
+					footer.i64Field(9, chunk.offset)
+				})
+			})
+			footer.i64Field(2, group.size)
+			footer.i64Field(3, group.rows)
+		})
+		footer.binaryField(6, "antibug")
+	})
+
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(footer.buf)))
+
+	for _, bs := range [][]byte{footer.buf, length[:], []byte(magic)} {
+		err := writer.write(bs)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// compactReader decodes thrift compact structs into values keyed by field id. Integers are int64,
+// binaries are strings, lists are slices and structs are maps.
+type compactReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *compactReader) varint() uint64 {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	Expect(n).Should(BeNumerically(">", 0), "malformed varint at %d", r.pos)
+	r.pos += n
+	return v
+}
+
+func (r *compactReader) zigzag() int64 {
+	v := r.varint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *compactReader) value(valueType byte) interface{} {
+	switch valueType {
+	case compactI32, compactI64:
+		return r.zigzag()
+	case compactBinary:
+		size := int(r.varint())
+		s := string(r.buf[r.pos : r.pos+size])
+		r.pos += size
+		return s
+	case compactList:
+		header := r.buf[r.pos]
+		r.pos++
+		size, elemType := int(header>>4), header&0x0f
+		if size == 15 {
+			size = int(r.varint())
+		}
+		values := make([]interface{}, 0, size)
+		for i := 0; i < size; i++ {
+			values = append(values, r.value(elemType))
+		}
+		return values
+	case compactStruct:
+		return r.readStruct()
+	}
+	Fail(fmt.Sprintf("unexpected compact type %d at %d", valueType, r.pos))
+	return nil
+}
+
+func (r *compactReader) readStruct() map[int16]interface{} {
+	fields := make(map[int16]interface{})
+	var lastID int16
+	for {
+		header := r.buf[r.pos]
+		r.pos++
+		if header == 0 {
+			return fields
+		}
+		id := lastID + int16(header>>4)
+		if header>>4 == 0 {
+			id = int16(r.zigzag())
+		}
+		fields[id] = r.value(header & 0x0f)
+		lastID = id
+	}
+}
+
+// readFooter checks the magic bytes of the file and decodes its file metadata
+func readFooter(data []byte) map[int16]interface{} {
+	Expect(string(data[:4])).Should(Equal(magic))
+	Expect(string(data[len(data)-4:])).Should(Equal(magic))
+
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer := data[len(data)-8-footerLen : len(data)-8]
+	return (&compactReader{buf: footer}).readStruct()
+}
+
+// readColumnChunk decodes the values of the data page of a column chunk
+func readColumnChunk(data []byte, offset int64, kind Kind) []interface{} {
+	r := &compactReader{buf: data, pos: int(offset)}
+	header := r.readStruct()
+	Expect(header[1]).Should(BeEquivalentTo(dataPage))
+	Expect(header[2]).Should(Equal(header[3]))
+
+	pageHeader := header[5].(map[int16]interface{})
+	Expect(pageHeader[2]).Should(BeEquivalentTo(encodingPlain))
+
+	page := data[r.pos : r.pos+int(header[3].(int64))]
+	values := make([]interface{}, 0, pageHeader[1].(int64))
+	for len(page) > 0 {
+		switch kind {
+		case String:
+			size := int(binary.LittleEndian.Uint32(page))
+			values = append(values, string(page[4:4+size]))
+			page = page[4+size:]
+		case Int64:
+			values = append(values, int64(binary.LittleEndian.Uint64(page)))
+			page = page[8:]
+		case Double:
+			values = append(values, math.Float64frombits(binary.LittleEndian.Uint64(page)))
+			page = page[8:]
+		}
+	}
+	Expect(values).Should(HaveLen(int(pageHeader[1].(int64))))
+	return values
+}
+
+var _ = Describe("Writing parquet files", func() {
+	Describe("Creating writers with malformed options", func() {
+		It("should fail without columns", func() {
+			_, err := NewWriter(&bytes.Buffer{}, nil, 10)
+			Expect(err).Should(HaveOccurred())
+		})
+		It("should fail when a column kind is unknown", func() {
+			_, err := NewWriter(&bytes.Buffer{}, []Column{{Name: "id", Kind: Kind(9)}}, 10)
+			Expect(err).Should(HaveOccurred())
+		})
+		It("should fail when row group size is not positive", func() {
+			_, err := NewWriter(&bytes.Buffer{}, []Column{{Name: "id", Kind: Int64}}, 0)
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Describe("Writing malformed rows", func() {
+		var writer *Writer
+
+		BeforeEach(func() {
+			var err error
+			writer, err = NewWriter(&bytes.Buffer{}, []Column{{Name: "id", Kind: Int64}, {Name: "name", Kind: String}}, 10)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("should fail when values are missing", func() {
+			Expect(writer.Write([]interface{}{int64(1)})).Should(HaveOccurred())
+		})
+		It("should fail when a value is not of the column kind", func() {
+			Expect(writer.Write([]interface{}{"1", "one"})).Should(HaveOccurred())
+		})
+	})
+
+	Describe("Reading back written files", func() {
+		const (
+			columnsCount = 33
+			rowsCount    = 5
+			rowGroupSize = 2
+		)
+
+		var (
+			columns []Column
+			rows    [][]interface{}
+			data    []byte
+			meta    map[int16]interface{}
+		)
+
+		BeforeEach(func() {
+			// 33 columns need the long form of list headers in the schema
+			columns = make([]Column, 0, columnsCount)
+			for i := 0; i < columnsCount; i++ {
+				columns = append(columns, Column{Name: fmt.Sprintf("column_%d", i), Kind: Kind(i % 3)})
+			}
+
+			rows = make([][]interface{}, 0, rowsCount)
+			for i := 0; i < rowsCount; i++ {
+				row := make([]interface{}, 0, columnsCount)
+				for _, column := range columns {
+					switch column.Kind {
+					case String:
+						row = append(row, fmt.Sprintf("%s row %d", column.Name, i))
+					case Int64:
+						row = append(row, int64(i*1000+len(row)))
+					case Double:
+						row = append(row, float64(i)+0.25)
+					}
+				}
+				rows = append(rows, row)
+			}
+
+			buf := &bytes.Buffer{}
+			writer, err := NewWriter(buf, columns, rowGroupSize)
+			Expect(err).ShouldNot(HaveOccurred())
+			for _, row := range rows {
+				Expect(writer.Write(row)).ShouldNot(HaveOccurred())
+			}
+			Expect(writer.Close()).ShouldNot(HaveOccurred())
+
+			data = buf.Bytes()
+			meta = readFooter(data)
+		})
+
+		It("should describe columns in the schema", func() {
+			Expect(meta[1]).Should(BeEquivalentTo(1))
+			Expect(meta[3]).Should(BeEquivalentTo(rowsCount))
+
+			schema := meta[2].([]interface{})
+			Expect(schema).Should(HaveLen(columnsCount + 1))
+
+			root := schema[0].(map[int16]interface{})
+			Expect(root[4]).Should(Equal("schema"))
+			Expect(root[5]).Should(BeEquivalentTo(columnsCount))
+
+			for i, column := range columns {
+				element := schema[i+1].(map[int16]interface{})
+				Expect(element[1]).Should(BeEquivalentTo(physicalTypes[column.Kind]))
+				Expect(element[3]).Should(BeEquivalentTo(required))
+				Expect(element[4]).Should(Equal(column.Name))
+				if column.Kind == String {
+					Expect(element[6]).Should(BeEquivalentTo(convertedUTF8))
+				} else {
+					Expect(element).ShouldNot(HaveKey(int16(6)))
+				}
+			}
+		})
+
+		It("should locate column chunks of row groups", func() {
+			rowGroups := meta[4].([]interface{})
+			Expect(rowGroups).Should(HaveLen(3))
+
+			offset := int64(len(magic))
+			for i, value := range rowGroups {
+				rowGroup := value.(map[int16]interface{})
+				groupRows := int64(rowGroupSize)
+				if i == len(rowGroups)-1 {
+					groupRows = rowsCount % rowGroupSize
+				}
+				Expect(rowGroup[3]).Should(Equal(groupRows))
+
+				chunks := rowGroup[1].([]interface{})
+				Expect(chunks).Should(HaveLen(columnsCount))
+
+				var groupSize int64
+				for j, value := range chunks {
+					chunk := value.(map[int16]interface{})
+					chunkMeta := chunk[3].(map[int16]interface{})
+
+					// Chunks follow each other from the magic bytes on
+					Expect(chunk[2]).Should(Equal(offset))
+					Expect(chunkMeta[9]).Should(Equal(offset))
+					Expect(chunkMeta[1]).Should(BeEquivalentTo(physicalTypes[columns[j].Kind]))
+					Expect(chunkMeta[3]).Should(Equal([]interface{}{columns[j].Name}))
+					Expect(chunkMeta[4]).Should(BeEquivalentTo(uncompressed))
+					Expect(chunkMeta[5]).Should(Equal(groupRows))
+					Expect(chunkMeta[6]).Should(Equal(chunkMeta[7]))
+
+					offset += chunkMeta[7].(int64)
+					groupSize += chunkMeta[7].(int64)
+				}
+				Expect(rowGroup[2]).Should(Equal(groupSize))
+			}
+
+			footerLen := int64(binary.LittleEndian.Uint32(data[len(data)-8:]))
+			Expect(offset).Should(Equal(int64(len(data)) - 8 - footerLen))
+		})
+
+		It("should read back rows of a row group", func() {
+			rowGroup := meta[4].([]interface{})[1].(map[int16]interface{})
+			chunks := rowGroup[1].([]interface{})
+
+			for j, value := range chunks {
+				chunk := value.(map[int16]interface{})
+				values := readColumnChunk(data, chunk[2].(int64), columns[j].Kind)
+				Expect(values).Should(Equal([]interface{}{rows[2][j], rows[3][j]}))
+			}
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package parquet
+
+import "encoding/binary"
+
+// Types of the thrift compact protocol
+const (
+	compactI32    = 5
+	compactI64    = 6
+	compactBinary = 8
+	compactList   = 9
+	compactStruct = 12
+)
+
+// compactWriter encodes parquet metadata with the thrift compact protocol
+type compactWriter struct {
+	buf     []byte
+	lastID  int16
+	lastIDs []int16
+}
+
+func (w *compactWriter) varint(v uint64) {
+	var bs [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(bs[:], v)
+	w.buf = append(w.buf, bs[:n]...)
+}
+
+func (w *compactWriter) zigzag(v int64) {
+	w.varint(uint64((v << 1) ^ (v >> 63)))
+}
+
+func (w *compactWriter) fieldHeader(id int16, fieldType byte) {
+	if delta := id - w.lastID; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|fieldType)
+	} else {
+		w.buf = append(w.buf, fieldType)
+		w.zigzag(int64(id))
+	}
+	w.lastID = id
+}
+
+func (w *compactWriter) listHeader(elemType byte, size int) {
+	if size < 15 {
+		w.buf = append(w.buf, byte(size)<<4|elemType)
+		return
+	}
+	w.buf = append(w.buf, 0xf0|elemType)
+	w.varint(uint64(size))
+}
+
+func (w *compactWriter) binary(s string) {
+	w.varint(uint64(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+// writeStruct writes the fields of a struct followed by the stop byte
+func (w *compactWriter) writeStruct(fields func()) {
+	w.lastIDs = append(w.lastIDs, w.lastID)
+	w.lastID = 0
+	fields()
+	w.buf = append(w.buf, 0)
+	w.lastID = w.lastIDs[len(w.lastIDs)-1]
+	w.lastIDs = w.lastIDs[:len(w.lastIDs)-1]
+}
+
+func (w *compactWriter) i32Field(id int16, v int32) {
+	w.fieldHeader(id, compactI32)
+	w.zigzag(int64(v))
+}
+
+func (w *compactWriter) i64Field(id int16, v int64) {
+	w.fieldHeader(id, compactI64)
+	w.zigzag(v)
+}
+
+func (w *compactWriter) binaryField(id int16, s string) {
+	w.fieldHeader(id, compactBinary)
+	w.binary(s)
+}
+
+func (w *compactWriter) structField(id int16, fields func()) {
+	w.fieldHeader(id, compactStruct)
+	w.writeStruct(fields)
+}
+
+func (w *compactWriter) structListField(id int16, size int, element func(i int)) {
+	w.fieldHeader(id, compactList)
+	w.listHeader(compactStruct, size)
+	for i := 0; i < size; i++ {
+		w.writeStruct(func() { element(i) })
+	}
+}
+
+func (w *compactWriter) i32ListField(id int16, values ...int32) {
+	w.fieldHeader(id, compactList)
+	w.listHeader(compactI32, len(values))
+	for _, v := range values {
+		w.zigzag(int64(v))
+	}
+}
+
+func (w *compactWriter) binaryListField(id int16, values ...string) {
+	w.fieldHeader(id, compactList)
+	w.listHeader(compactBinary, len(values))
+	for _, v := range values {
+		w.binary(v)
+	}
+}
@@ -417,15 +417,18 @@ func (m *LoginRequest) GetPassword() string {
 	return ""
 }
 
-// LoginResponse is response after login
+// LoginResponse is response after login or refreshing tokens. The access token is short-lived and is renewed
+// with the refresh token, which can only be used once.
 type LoginResponse struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	AccountId            string   `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	AccountState         bool     `protobuf:"varint,3,opt,name=account_state,json=accountState,proto3" json:"account_state,omitempty"`
-	AccountGroup         string   `protobuf:"bytes,5,opt,name=account_group,json=accountGroup,proto3" json:"account_group,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Token                    string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	AccountId                string   `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountState             bool     `protobuf:"varint,3,opt,name=account_state,json=accountState,proto3" json:"account_state,omitempty"`
+	AccountGroup             string   `protobuf:"bytes,5,opt,name=account_group,json=accountGroup,proto3" json:"account_group,omitempty"`
+	RefreshToken             string   `protobuf:"bytes,6,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	TokenExpiresTimestampSec int64    `protobuf:"varint,7,opt,name=token_expires_timestamp_sec,json=tokenExpiresTimestampSec,proto3" json:"token_expires_timestamp_sec,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
@@ -481,6 +484,140 @@ func (m *LoginResponse) GetAccountGroup() string {
 	return ""
 }
 
+func (m *LoginResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *LoginResponse) GetTokenExpiresTimestampSec() int64 {
+	if m != nil {
+		return m.TokenExpiresTimestampSec
+	}
+	return 0
+}
+
+// RefreshTokenRequest is request to get new tokens of a session
+type RefreshTokenRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()    {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{8}
+}
+
+func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenRequest.Unmarshal(m, b)
+}
+func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenRequest.Merge(m, src)
+}
+func (m *RefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenRequest.Size(m)
+}
+func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
+
+func (m *RefreshTokenRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+// LogoutRequest is request to end the session of the token
+type LogoutRequest struct {
+	AccountId            string   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()    {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{9}
+}
+
+func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
+}
+func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
+}
+func (m *LogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutRequest.Merge(m, src)
+}
+func (m *LogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutRequest.Size(m)
+}
+func (m *LogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
+
+func (m *LogoutRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+// RevokeSessionsRequest is request to end all sessions of an account e.g of a lost phone or departing staff
+type RevokeSessionsRequest struct {
+	AccountId            string   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSessionsRequest) Reset()         { *m = RevokeSessionsRequest{} }
+func (m *RevokeSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionsRequest) ProtoMessage()    {}
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e28828dcb8d24f0, []int{10}
+}
+
+func (m *RevokeSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionsRequest.Unmarshal(m, b)
+}
+func (m *RevokeSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionsRequest.Merge(m, src)
+}
+func (m *RevokeSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionsRequest.Size(m)
+}
+func (m *RevokeSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionsRequest proto.InternalMessageInfo
+
+func (m *RevokeSessionsRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
 // CreateAccountRequest is request tp create an account
 type CreateAccountRequest struct {
 	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -495,7 +632,7 @@ func (m *CreateAccountRequest) Reset()         { *m = CreateAccountRequest{} }
 func (m *CreateAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateAccountRequest) ProtoMessage()    {}
 func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{8}
+	return fileDescriptor_8e28828dcb8d24f0, []int{11}
 }
 
 func (m *CreateAccountRequest) XXX_Unmarshal(b []byte) error {
@@ -549,7 +686,7 @@ func (m *CreateAccountResponse) Reset()         { *m = CreateAccountResponse{} }
 func (m *CreateAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateAccountResponse) ProtoMessage()    {}
 func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{9}
+	return fileDescriptor_8e28828dcb8d24f0, []int{12}
 }
 
 func (m *CreateAccountResponse) XXX_Unmarshal(b []byte) error {
@@ -590,7 +727,7 @@ func (m *ActivateAccountRequest) Reset()         { *m = ActivateAccountRequest{}
 func (m *ActivateAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAccountRequest) ProtoMessage()    {}
 func (*ActivateAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{10}
+	return fileDescriptor_8e28828dcb8d24f0, []int{13}
 }
 
 func (m *ActivateAccountRequest) XXX_Unmarshal(b []byte) error {
@@ -638,7 +775,7 @@ func (m *UpdateAccountRequest) Reset()         { *m = UpdateAccountRequest{} }
 func (m *UpdateAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateAccountRequest) ProtoMessage()    {}
 func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{11}
+	return fileDescriptor_8e28828dcb8d24f0, []int{14}
 }
 
 func (m *UpdateAccountRequest) XXX_Unmarshal(b []byte) error {
@@ -685,7 +822,7 @@ func (m *DeleteAccountRequest) Reset()         { *m = DeleteAccountRequest{} }
 func (m *DeleteAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAccountRequest) ProtoMessage()    {}
 func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{12}
+	return fileDescriptor_8e28828dcb8d24f0, []int{15}
 }
 
 func (m *DeleteAccountRequest) XXX_Unmarshal(b []byte) error {
@@ -725,7 +862,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{13}
+	return fileDescriptor_8e28828dcb8d24f0, []int{16}
 }
 
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
@@ -766,7 +903,7 @@ func (m *UpdateSettingsRequest) Reset()         { *m = UpdateSettingsRequest{} }
 func (m *UpdateSettingsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSettingsRequest) ProtoMessage()    {}
 func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{14}
+	return fileDescriptor_8e28828dcb8d24f0, []int{17}
 }
 
 func (m *UpdateSettingsRequest) XXX_Unmarshal(b []byte) error {
@@ -814,7 +951,7 @@ func (m *UpdateJobsRequest) Reset()         { *m = UpdateJobsRequest{} }
 func (m *UpdateJobsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateJobsRequest) ProtoMessage()    {}
 func (*UpdateJobsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{15}
+	return fileDescriptor_8e28828dcb8d24f0, []int{18}
 }
 
 func (m *UpdateJobsRequest) XXX_Unmarshal(b []byte) error {
@@ -862,7 +999,7 @@ func (m *UpdateStarredFacilitiesRequest) Reset()         { *m = UpdateStarredFac
 func (m *UpdateStarredFacilitiesRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateStarredFacilitiesRequest) ProtoMessage()    {}
 func (*UpdateStarredFacilitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e28828dcb8d24f0, []int{16}
+	return fileDescriptor_8e28828dcb8d24f0, []int{19}
 }
 
 func (m *UpdateStarredFacilitiesRequest) XXX_Unmarshal(b []byte) error {
@@ -907,6 +1044,9 @@ func init() {
 	proto.RegisterMapType((map[string]bool)(nil), "antibug.account.Settings.SettingsEntry")
 	proto.RegisterType((*LoginRequest)(nil), "antibug.account.LoginRequest")
 	proto.RegisterType((*LoginResponse)(nil), "antibug.account.LoginResponse")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "antibug.account.RefreshTokenRequest")
+	proto.RegisterType((*LogoutRequest)(nil), "antibug.account.LogoutRequest")
+	proto.RegisterType((*RevokeSessionsRequest)(nil), "antibug.account.RevokeSessionsRequest")
 	proto.RegisterType((*CreateAccountRequest)(nil), "antibug.account.CreateAccountRequest")
 	proto.RegisterType((*CreateAccountResponse)(nil), "antibug.account.CreateAccountResponse")
 	proto.RegisterType((*ActivateAccountRequest)(nil), "antibug.account.ActivateAccountRequest")
@@ -921,89 +1061,99 @@ func init() {
 func init() { proto.RegisterFile("account.proto", fileDescriptor_8e28828dcb8d24f0) }
 
 var fileDescriptor_8e28828dcb8d24f0 = []byte{
-	// 1304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xd7, 0xda, 0x69, 0xe2, 0x3c, 0x4e, 0xfe, 0x6d, 0x47, 0x4e, 0xff, 0xae, 0x4b, 0xdb, 0xed,
-	0x94, 0x36, 0xa9, 0x5b, 0x7b, 0x83, 0x9b, 0x56, 0x34, 0x45, 0x82, 0x34, 0x4d, 0xa3, 0x54, 0x55,
-	0x55, 0x1c, 0x7a, 0x41, 0x88, 0x68, 0x5f, 0x26, 0x9b, 0x49, 0xd7, 0x3b, 0xcb, 0xcc, 0xb8, 0xa9,
-	0x0b, 0xbd, 0x20, 0x0e, 0x1c, 0x10, 0x12, 0x20, 0x24, 0xc4, 0x95, 0x6f, 0xc0, 0x07, 0x80, 0x33,
-	0x67, 0xbe, 0x02, 0xdf, 0x82, 0x0b, 0x9a, 0xd9, 0x59, 0xc7, 0x89, 0xb3, 0x8e, 0x83, 0x38, 0x65,
-	0x9f, 0x97, 0x79, 0x5e, 0x7e, 0xf3, 0x3c, 0xbf, 0x8c, 0x61, 0xd6, 0xf5, 0x7d, 0xd6, 0x8d, 0x65,
-	0x33, 0xe1, 0x4c, 0x32, 0x74, 0xda, 0x8d, 0x25, 0xf5, 0xba, 0x61, 0xd3, 0xa8, 0x6b, 0x6f, 0x85,
-	0x8c, 0x85, 0x11, 0x71, 0xdc, 0x84, 0x3a, 0x6e, 0x1c, 0x33, 0xe9, 0x4a, 0xca, 0x62, 0x91, 0xba,
-	0xd7, 0x2e, 0x18, 0xab, 0x96, 0xbc, 0xee, 0xb6, 0x43, 0x3a, 0x89, 0xec, 0x19, 0xe3, 0x2d, 0xfd,
-	0xc7, 0x6f, 0x84, 0x24, 0x6e, 0x88, 0x3d, 0x37, 0x0c, 0x09, 0x77, 0x58, 0xa2, 0x8f, 0x0f, 0x87,
-	0xc2, 0x7f, 0x5b, 0x30, 0xb5, 0x92, 0x26, 0x45, 0x17, 0x01, 0xb6, 0x29, 0x17, 0x72, 0x2b, 0x76,
-	0x3b, 0xa4, 0x6a, 0xd9, 0xd6, 0xc2, 0x74, 0x7b, 0x5a, 0x6b, 0x9e, 0xba, 0x1d, 0x82, 0x2e, 0xc0,
-	0x74, 0xe4, 0x66, 0xd6, 0x82, 0xb6, 0x96, 0x94, 0x42, 0x1b, 0x2b, 0x70, 0x8a, 0x74, 0x5c, 0x1a,
-	0x55, 0x8b, 0xda, 0x90, 0x0a, 0x4a, 0x9b, 0xec, 0xb0, 0x98, 0x54, 0x27, 0x52, 0xad, 0x16, 0xd0,
-	0x65, 0x28, 0x27, 0x9c, 0x6d, 0xd3, 0x88, 0x6c, 0x75, 0x79, 0x54, 0x3d, 0xa5, 0x6d, 0x60, 0x54,
-	0xcf, 0x79, 0x84, 0xce, 0xc1, 0x64, 0x48, 0xe2, 0x80, 0xf0, 0xea, 0xa4, 0xb6, 0x19, 0x49, 0x85,
-	0x0b, 0x39, 0xeb, 0x26, 0xd5, 0xa9, 0x34, 0x9c, 0x16, 0xd0, 0x15, 0x98, 0x09, 0xc8, 0x4b, 0xea,
-	0x93, 0x2d, 0xc9, 0x5e, 0x90, 0xb8, 0x5a, 0xd2, 0xc6, 0x72, 0xaa, 0xfb, 0x48, 0xa9, 0x54, 0x40,
-	0xd7, 0x97, 0xf4, 0x25, 0xa9, 0x4e, 0xdb, 0xd6, 0x42, 0xa9, 0x6d, 0x24, 0xfc, 0x93, 0x05, 0xc5,
-	0xc7, 0xcc, 0x43, 0x57, 0x61, 0x76, 0xdb, 0xf5, 0x69, 0x44, 0x65, 0x6f, 0xb0, 0xf9, 0x99, 0x4c,
-	0xa9, 0x5b, 0xbc, 0x0c, 0xe5, 0xbe, 0x13, 0x0d, 0x0c, 0x02, 0x90, 0xa9, 0x36, 0x02, 0x84, 0x60,
-	0x82, 0xb3, 0x88, 0x18, 0x08, 0xf4, 0x37, 0x9a, 0x83, 0xc9, 0x5d, 0xe6, 0x29, 0x7f, 0x03, 0xc1,
-	0x2e, 0xf3, 0x36, 0x02, 0x64, 0x43, 0x39, 0x20, 0xc2, 0xe7, 0x54, 0x5f, 0x8c, 0x81, 0x60, 0x50,
-	0x85, 0x17, 0x61, 0xe2, 0x31, 0xf3, 0x04, 0x5a, 0x80, 0x89, 0x5d, 0xe6, 0x89, 0xaa, 0x65, 0x17,
-	0x17, 0xca, 0xad, 0x4a, 0xf3, 0xd0, 0xa4, 0x34, 0x1f, 0x33, 0xaf, 0xad, 0x3d, 0xf0, 0x33, 0x28,
-	0x3d, 0x32, 0xc5, 0xfc, 0x37, 0x0d, 0xe1, 0xa7, 0x70, 0x76, 0x53, 0xba, 0x9c, 0x93, 0xc0, 0x04,
-	0xa6, 0x44, 0xa0, 0x7b, 0x90, 0xb9, 0x50, 0x92, 0x95, 0x75, 0x7e, 0xa8, 0xac, 0xac, 0x92, 0xf6,
-	0x80, 0x33, 0xfe, 0xc6, 0x82, 0xd2, 0x26, 0x91, 0x92, 0xc6, 0xa1, 0x40, 0xab, 0x50, 0x12, 0xe6,
-	0xdb, 0x44, 0x99, 0x1f, 0x8a, 0x92, 0x39, 0xf7, 0x3f, 0xd6, 0x62, 0xc9, 0x7b, 0xed, 0xfe, 0xc1,
-	0xda, 0x7d, 0x98, 0x3d, 0x60, 0x42, 0x67, 0xa0, 0xf8, 0x82, 0xf4, 0x4c, 0xbb, 0xea, 0x53, 0x0d,
-	0xcd, 0x4b, 0x37, 0xea, 0xa6, 0x23, 0x5b, 0x6a, 0xa7, 0xc2, 0x72, 0xe1, 0x5d, 0x0b, 0x3f, 0x82,
-	0x99, 0x27, 0x2c, 0xa4, 0x71, 0x9b, 0x7c, 0xd6, 0x25, 0x42, 0xa2, 0x1a, 0x94, 0xba, 0x82, 0xf0,
-	0x01, 0xbc, 0xfa, 0xb2, 0xb2, 0x25, 0xae, 0x10, 0x7b, 0x8c, 0x67, 0x40, 0xf5, 0x65, 0xfc, 0xad,
-	0x05, 0xb3, 0x26, 0x90, 0x48, 0x58, 0x2c, 0xf4, 0x36, 0xa4, 0xb3, 0x98, 0x86, 0x49, 0x05, 0xb5,
-	0x5f, 0xa6, 0xb1, 0x7d, 0xb8, 0xa7, 0x8d, 0x66, 0x23, 0x50, 0x77, 0x96, 0x99, 0x85, 0x74, 0x65,
-	0x3a, 0x47, 0xa5, 0xf6, 0x8c, 0x51, 0x6e, 0x2a, 0xdd, 0xa0, 0x53, 0xba, 0x0a, 0xe9, 0xe8, 0x64,
-	0x4e, 0xeb, 0x4a, 0x87, 0xbf, 0xb3, 0xa0, 0xb2, 0xca, 0x89, 0x2b, 0x89, 0x59, 0xed, 0xac, 0xc3,
-	0x16, 0x4c, 0x19, 0x47, 0x5d, 0x59, 0xb9, 0x55, 0x1d, 0x82, 0x3c, 0x3b, 0x91, 0x39, 0x8e, 0xea,
-	0x1c, 0xdd, 0x80, 0x33, 0x3e, 0x8b, 0xb7, 0x29, 0xef, 0x6c, 0xf5, 0x7d, 0xd2, 0xe9, 0x3f, 0x6d,
-	0xf4, 0xcf, 0x32, 0x90, 0xee, 0xc2, 0xdc, 0xa1, 0x92, 0x0c, 0x56, 0x07, 0x51, 0xb1, 0x0e, 0xa1,
-	0x82, 0xdb, 0x70, 0x6e, 0x45, 0x2d, 0xeb, 0x70, 0x33, 0xa3, 0x0f, 0xa2, 0xf3, 0x50, 0xf2, 0x7a,
-	0x5b, 0x6e, 0xd0, 0xa1, 0xb1, 0xb9, 0xfa, 0x29, 0xaf, 0xb7, 0xa2, 0x44, 0x4c, 0xa1, 0xf2, 0x3c,
-	0x09, 0x4e, 0x1c, 0x71, 0x00, 0xbd, 0xc2, 0x98, 0xe8, 0xe1, 0x3b, 0x50, 0x79, 0x48, 0x22, 0x72,
-	0xc2, 0x54, 0xf8, 0x26, 0xc0, 0x3a, 0x19, 0xd7, 0xb9, 0x03, 0x73, 0x69, 0x3b, 0xd9, 0x2a, 0x8c,
-	0xd9, 0xcf, 0x9d, 0x81, 0x0d, 0x4c, 0x1b, 0x3a, 0x9f, 0xbb, 0x81, 0xfb, 0x3b, 0x87, 0x3f, 0x81,
-	0xb3, 0x69, 0x3a, 0xc5, 0x4f, 0x63, 0xa6, 0xca, 0x58, 0xac, 0x70, 0x2c, 0x8b, 0xbd, 0x86, 0x4b,
-	0xa6, 0x99, 0xc3, 0xcc, 0x33, 0x66, 0xaa, 0x83, 0xfc, 0x54, 0x38, 0x01, 0x3f, 0xb5, 0x7e, 0x2b,
-	0x03, 0x98, 0x7b, 0x5a, 0x79, 0xb6, 0x81, 0xba, 0x70, 0x4a, 0xaf, 0x35, 0xba, 0x38, 0x74, 0x7c,
-	0x90, 0x37, 0x6a, 0x97, 0xf2, 0xcc, 0xe9, 0x84, 0xe3, 0xc6, 0x97, 0x7f, 0xfe, 0xf5, 0x43, 0x61,
-	0x1e, 0x63, 0xf3, 0xef, 0x5c, 0xfb, 0x3a, 0xc6, 0x57, 0x38, 0xea, 0x7f, 0x11, 0x8b, 0x9d, 0x48,
-	0x9d, 0x59, 0xb6, 0xea, 0x48, 0xd1, 0xc9, 0x81, 0x55, 0x41, 0xd7, 0x86, 0x12, 0x1c, 0xb5, 0xdd,
-	0xb5, 0xeb, 0xc7, 0xb9, 0x99, 0x7a, 0x9a, 0xba, 0x9e, 0x05, 0x7c, 0x75, 0x64, 0x3d, 0xbe, 0x3e,
-	0xab, 0x0a, 0xfa, 0xca, 0x82, 0xd3, 0x87, 0x76, 0x10, 0xcd, 0x1f, 0x31, 0xfa, 0x47, 0x6d, 0x69,
-	0xed, 0x5c, 0x33, 0x7d, 0xac, 0x34, 0xb3, 0xc7, 0x4a, 0x73, 0x4d, 0x3d, 0x56, 0xf0, 0xa2, 0x2e,
-	0xa2, 0x8e, 0xaf, 0x8d, 0x2c, 0xc2, 0x35, 0x41, 0x55, 0x19, 0x6f, 0x60, 0xf6, 0xc0, 0xd6, 0x1e,
-	0x01, 0xcb, 0x51, 0x5b, 0x9d, 0x5b, 0x81, 0xb9, 0x96, 0x56, 0xce, 0xb5, 0x7c, 0xbe, 0x3f, 0x64,
-	0x6f, 0x54, 0x7a, 0xa6, 0x57, 0x32, 0xcb, 0x7d, 0x61, 0x28, 0xf7, 0xfe, 0xbe, 0xd6, 0x72, 0x79,
-	0x01, 0xd7, 0x75, 0xce, 0xb7, 0xd1, 0x18, 0x39, 0xd1, 0x6b, 0x28, 0xaf, 0x13, 0xd9, 0xff, 0x7f,
-	0x39, 0x32, 0x63, 0xfe, 0xe2, 0xe2, 0xdb, 0x3a, 0x65, 0x03, 0xdd, 0x3c, 0x3e, 0xa5, 0x93, 0xed,
-	0x38, 0xfa, 0xda, 0x82, 0xff, 0x1d, 0xe4, 0x14, 0x74, 0x3d, 0x07, 0xed, 0x43, 0xa4, 0x93, 0x0b,
-	0xf7, 0x5d, 0x5d, 0xc7, 0x62, 0xed, 0x24, 0x75, 0x28, 0xdc, 0x63, 0x98, 0x5a, 0x27, 0x52, 0xbf,
-	0x85, 0x46, 0x42, 0x30, 0x77, 0x14, 0xa9, 0x08, 0xec, 0xe8, 0xb4, 0x37, 0xd0, 0xfc, 0x18, 0x69,
-	0x15, 0x01, 0xa1, 0x2f, 0x00, 0xf6, 0xe9, 0x0d, 0xe1, 0x9c, 0xae, 0x07, 0xb8, 0x2f, 0xb7, 0xe3,
-	0x96, 0x4e, 0x7d, 0xab, 0x36, 0x6e, 0x6a, 0xd5, 0xed, 0x8f, 0x16, 0x54, 0xd4, 0xad, 0x0f, 0x3d,
-	0xbb, 0x46, 0xf6, 0x3e, 0x5c, 0xe5, 0x50, 0x00, 0xfc, 0x9e, 0xae, 0xe6, 0x2e, 0x5a, 0x1a, 0x07,
-	0x7f, 0xe9, 0x72, 0x12, 0x34, 0xf6, 0xa9, 0x11, 0xfd, 0x62, 0xc1, 0xff, 0x73, 0x78, 0x19, 0x39,
-	0x79, 0x93, 0x91, 0xc3, 0xe0, 0xb9, 0x80, 0xbd, 0xaf, 0x4b, 0xbc, 0x57, 0xfb, 0x57, 0x25, 0x2e,
-	0x5b, 0xf5, 0x07, 0xbf, 0x17, 0xbe, 0x5f, 0xf9, 0xb5, 0x80, 0xfe, 0xd0, 0x84, 0xa5, 0x7d, 0xed,
-	0x4d, 0xc2, 0xd5, 0xef, 0x00, 0xfc, 0x29, 0xe0, 0x2c, 0x90, 0x2d, 0x52, 0x9d, 0xdd, 0xb0, 0x4d,
-	0x0e, 0x3b, 0xe1, 0x6c, 0x97, 0xf8, 0x12, 0x5d, 0xd9, 0x91, 0x32, 0x11, 0xcb, 0x8e, 0x13, 0x52,
-	0xb9, 0xd3, 0xf5, 0x9a, 0x3e, 0xeb, 0x38, 0x21, 0x0d, 0x7a, 0x8a, 0x8a, 0x52, 0xd7, 0xda, 0x5c,
-	0x48, 0x03, 0xc2, 0xe2, 0x1d, 0xd7, 0x27, 0xfc, 0x83, 0x50, 0xfd, 0xcc, 0x51, 0x5e, 0xf5, 0x0f,
-	0xa1, 0xf2, 0x60, 0xf3, 0xa1, 0x7d, 0xbb, 0xb1, 0x1a, 0xb9, 0x5d, 0x41, 0xec, 0x27, 0xd4, 0x27,
-	0xea, 0x79, 0x73, 0xef, 0xd8, 0x88, 0x8e, 0x17, 0x31, 0xcf, 0xe9, 0xb8, 0x42, 0x12, 0xee, 0x3c,
-	0xd9, 0x58, 0x5d, 0x7b, 0xba, 0xb9, 0xd6, 0x94, 0xaf, 0x64, 0xab, 0xf8, 0x4e, 0x73, 0xb1, 0x5e,
-	0xb4, 0x0a, 0x13, 0xad, 0x33, 0x6e, 0x92, 0x44, 0xd4, 0xd7, 0xbf, 0xdc, 0x9c, 0x5d, 0xc1, 0xe2,
-	0xe5, 0x21, 0x4d, 0xfb, 0x3e, 0x14, 0x97, 0x16, 0x97, 0xd0, 0x12, 0xd4, 0xdb, 0x44, 0x76, 0x79,
-	0x4c, 0x02, 0x7b, 0x6f, 0x87, 0xc4, 0xb6, 0xdc, 0x21, 0x36, 0x27, 0x82, 0x75, 0xb9, 0x4f, 0xec,
-	0x80, 0x11, 0x61, 0xc7, 0x4c, 0xda, 0xe4, 0x15, 0x15, 0xb2, 0x89, 0x26, 0x61, 0xe2, 0xe7, 0x82,
-	0x35, 0xf9, 0x71, 0xf6, 0x5e, 0xf1, 0x26, 0xf5, 0x95, 0xdc, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x68, 0x5b, 0xe8, 0x85, 0xa1, 0x0e, 0x00, 0x00,
+	// 1462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xd7, 0xd9, 0x69, 0xe2, 0x4c, 0x92, 0xfe, 0x59, 0x92, 0xe2, 0x3a, 0xb4, 0xbd, 0x5e, 0xff,
+	0x24, 0x75, 0x1b, 0x5f, 0x70, 0xd3, 0x88, 0xa6, 0x20, 0x48, 0xd3, 0x34, 0x4a, 0x55, 0x55, 0xc5,
+	0x6e, 0x5f, 0x10, 0xc2, 0x3a, 0xdf, 0x4d, 0x9c, 0x4b, 0xcf, 0xb7, 0xc7, 0xee, 0x5e, 0xda, 0x14,
+	0xfa, 0x82, 0x40, 0xe2, 0x01, 0x21, 0x01, 0x42, 0x42, 0xbc, 0xf2, 0x0d, 0xf8, 0x02, 0xf0, 0xca,
+	0x33, 0x5f, 0x81, 0x4f, 0xc0, 0x2b, 0x2f, 0x68, 0xf7, 0xf6, 0x12, 0x27, 0xe7, 0x4b, 0x1c, 0xc4,
+	0x53, 0x6e, 0x67, 0x66, 0x67, 0x7e, 0x33, 0x3b, 0xf3, 0xdb, 0x75, 0x60, 0xc2, 0x71, 0x5d, 0x1a,
+	0x87, 0xa2, 0x16, 0x31, 0x2a, 0x28, 0x39, 0xe5, 0x84, 0xc2, 0x6f, 0xc7, 0x9d, 0x9a, 0x16, 0x57,
+	0xde, 0xea, 0x50, 0xda, 0x09, 0xd0, 0x76, 0x22, 0xdf, 0x76, 0xc2, 0x90, 0x0a, 0x47, 0xf8, 0x34,
+	0xe4, 0x89, 0x79, 0x65, 0x5a, 0x6b, 0xd5, 0xaa, 0x1d, 0x6f, 0xd8, 0xd8, 0x8d, 0xc4, 0x8e, 0x56,
+	0xde, 0x54, 0x7f, 0xdc, 0xb9, 0x0e, 0x86, 0x73, 0xfc, 0x85, 0xd3, 0xe9, 0x20, 0xb3, 0x69, 0xa4,
+	0xb6, 0x67, 0x5d, 0x59, 0xff, 0x18, 0x30, 0xb2, 0x9c, 0x04, 0x25, 0xe7, 0x01, 0x36, 0x7c, 0xc6,
+	0x45, 0x2b, 0x74, 0xba, 0x58, 0x36, 0x4c, 0x63, 0x76, 0xb4, 0x31, 0xaa, 0x24, 0x8f, 0x9d, 0x2e,
+	0x92, 0x69, 0x18, 0x0d, 0x9c, 0x54, 0x5b, 0x50, 0xda, 0x52, 0xe0, 0x68, 0xe5, 0x24, 0x9c, 0xc0,
+	0xae, 0xe3, 0x07, 0xe5, 0xa2, 0x52, 0x24, 0x0b, 0x29, 0x8d, 0x36, 0x69, 0x88, 0xe5, 0xa1, 0x44,
+	0xaa, 0x16, 0xe4, 0x22, 0x8c, 0x45, 0x8c, 0x6e, 0xf8, 0x01, 0xb6, 0x62, 0x16, 0x94, 0x4f, 0x28,
+	0x1d, 0x68, 0xd1, 0x33, 0x16, 0x90, 0xb3, 0x30, 0xdc, 0xc1, 0xd0, 0x43, 0x56, 0x1e, 0x56, 0x3a,
+	0xbd, 0x92, 0xee, 0x3a, 0x8c, 0xc6, 0x51, 0x79, 0x24, 0x71, 0xa7, 0x16, 0xe4, 0x12, 0x8c, 0x7b,
+	0xb8, 0xed, 0xbb, 0xd8, 0x12, 0xf4, 0x39, 0x86, 0xe5, 0x92, 0x52, 0x8e, 0x25, 0xb2, 0xa7, 0x52,
+	0x24, 0x1d, 0x3a, 0xae, 0xf0, 0xb7, 0xb1, 0x3c, 0x6a, 0x1a, 0xb3, 0xa5, 0x86, 0x5e, 0x59, 0x3f,
+	0x19, 0x50, 0x7c, 0x48, 0xdb, 0xe4, 0x32, 0x4c, 0x6c, 0x38, 0xae, 0x1f, 0xf8, 0x62, 0xa7, 0x37,
+	0xf9, 0xf1, 0x54, 0xa8, 0x52, 0xbc, 0x08, 0x63, 0xbb, 0x46, 0xbe, 0xa7, 0x2b, 0x00, 0xa9, 0x68,
+	0xdd, 0x23, 0x04, 0x86, 0x18, 0x0d, 0x50, 0x97, 0x40, 0x7d, 0x93, 0x29, 0x18, 0xde, 0xa2, 0x6d,
+	0x69, 0xaf, 0x4b, 0xb0, 0x45, 0xdb, 0xeb, 0x1e, 0x31, 0x61, 0xcc, 0x43, 0xee, 0x32, 0x5f, 0x1d,
+	0x8c, 0x2e, 0x41, 0xaf, 0xc8, 0x9a, 0x87, 0xa1, 0x87, 0xb4, 0xcd, 0xc9, 0x2c, 0x0c, 0x6d, 0xd1,
+	0x36, 0x2f, 0x1b, 0x66, 0x71, 0x76, 0xac, 0x3e, 0x59, 0x3b, 0xd0, 0x29, 0xb5, 0x87, 0xb4, 0xdd,
+	0x50, 0x16, 0xd6, 0x13, 0x28, 0x3d, 0xd0, 0x60, 0xfe, 0x9f, 0x84, 0xac, 0xc7, 0x70, 0xa6, 0x29,
+	0x1c, 0xc6, 0xd0, 0xd3, 0x8e, 0x7d, 0xe4, 0xe4, 0x0e, 0xa4, 0x26, 0x3e, 0xa6, 0xb0, 0xce, 0x65,
+	0x60, 0xa5, 0x48, 0x1a, 0x3d, 0xc6, 0xd6, 0x37, 0x06, 0x94, 0x9a, 0x28, 0x84, 0x1f, 0x76, 0x38,
+	0x59, 0x81, 0x12, 0xd7, 0xdf, 0xda, 0xcb, 0x4c, 0xc6, 0x4b, 0x6a, 0xbc, 0xfb, 0xb1, 0x1a, 0x0a,
+	0xb6, 0xd3, 0xd8, 0xdd, 0x58, 0xb9, 0x0b, 0x13, 0xfb, 0x54, 0xe4, 0x34, 0x14, 0x9f, 0xe3, 0x8e,
+	0x4e, 0x57, 0x7e, 0xca, 0xa6, 0xd9, 0x76, 0x82, 0x38, 0x69, 0xd9, 0x52, 0x23, 0x59, 0x2c, 0x15,
+	0xde, 0x31, 0xac, 0x07, 0x30, 0xfe, 0x88, 0x76, 0xfc, 0xb0, 0x81, 0x9f, 0xc6, 0xc8, 0x05, 0xa9,
+	0x40, 0x29, 0xe6, 0xc8, 0x7a, 0xea, 0xb5, 0xbb, 0x96, 0xba, 0xc8, 0xe1, 0xfc, 0x05, 0x65, 0x69,
+	0xa1, 0x76, 0xd7, 0xd6, 0xdf, 0x06, 0x4c, 0x68, 0x47, 0x3c, 0xa2, 0x21, 0x57, 0xd3, 0x90, 0xf4,
+	0x62, 0xe2, 0x26, 0x59, 0xc8, 0xf9, 0xd2, 0x89, 0xed, 0x95, 0x7b, 0x54, 0x4b, 0xd6, 0x3d, 0x79,
+	0x66, 0xa9, 0x9a, 0x0b, 0x47, 0x24, 0x7d, 0x54, 0x6a, 0x8c, 0x6b, 0x61, 0x53, 0xca, 0x7a, 0x8d,
+	0x92, 0x51, 0x48, 0x5a, 0x27, 0x35, 0x5a, 0x53, 0x13, 0x71, 0x19, 0x26, 0x18, 0x6e, 0x30, 0xe4,
+	0x9b, 0x7a, 0x24, 0x92, 0x31, 0x1a, 0xd7, 0xc2, 0x64, 0x26, 0xde, 0x83, 0x69, 0xa5, 0x6c, 0xe1,
+	0xcb, 0xc8, 0x67, 0xc8, 0x5b, 0xc2, 0xef, 0x22, 0x17, 0x4e, 0x37, 0x6a, 0x71, 0x74, 0xd5, 0x88,
+	0x15, 0x1b, 0x65, 0x65, 0xb2, 0x9a, 0x58, 0x3c, 0x4d, 0x0d, 0x9a, 0xe8, 0x5a, 0x4b, 0xf0, 0x46,
+	0xa3, 0xc7, 0x5d, 0x5a, 0xc3, 0x4c, 0x68, 0x23, 0x1b, 0xda, 0xaa, 0xa9, 0x7a, 0xd1, 0x58, 0xa4,
+	0xbb, 0xf6, 0x57, 0xc6, 0x38, 0x50, 0x19, 0x6b, 0x11, 0xa6, 0x1a, 0xb8, 0x4d, 0x9f, 0x63, 0x13,
+	0x39, 0x97, 0xe4, 0x35, 0xe0, 0xbe, 0xef, 0x0c, 0x98, 0x5c, 0x61, 0xe8, 0x08, 0xd4, 0x14, 0x97,
+	0xee, 0xab, 0xc3, 0x88, 0xb6, 0x52, 0x9b, 0xc6, 0xea, 0xe5, 0x4c, 0xeb, 0xa5, 0x3b, 0x52, 0xc3,
+	0xc3, 0x3a, 0x80, 0x5c, 0x87, 0xd3, 0x2e, 0x0d, 0x37, 0x7c, 0xd6, 0x6d, 0xed, 0xda, 0x24, 0x2c,
+	0x70, 0x4a, 0xcb, 0x9f, 0xa4, 0xcd, 0xb2, 0x08, 0x53, 0x07, 0x20, 0xe9, 0x9e, 0x39, 0x22, 0x97,
+	0x06, 0x9c, 0x5d, 0x96, 0xa4, 0x95, 0x4d, 0xe6, 0xf0, 0x8d, 0xe4, 0x1c, 0x94, 0xda, 0x3b, 0x2d,
+	0xc7, 0xeb, 0xfa, 0xa1, 0x1e, 0x81, 0x91, 0xf6, 0xce, 0xb2, 0x5c, 0x5a, 0x3e, 0x4c, 0x3e, 0x8b,
+	0xbc, 0x63, 0x7b, 0xec, 0xa9, 0x5e, 0x61, 0xc0, 0xea, 0x59, 0xb7, 0x61, 0xf2, 0x3e, 0x06, 0x78,
+	0xcc, 0x50, 0xd6, 0x0d, 0x80, 0x35, 0x1c, 0xd4, 0xb8, 0x0b, 0x53, 0x49, 0x3a, 0x29, 0x25, 0x0c,
+	0x98, 0xcf, 0xed, 0x1e, 0x26, 0x4a, 0x12, 0x3a, 0x97, 0xcb, 0x44, 0x7b, 0xdc, 0x63, 0x7d, 0x0c,
+	0x67, 0x92, 0x70, 0x92, 0xa7, 0x07, 0x0c, 0x95, 0xb2, 0x79, 0xe1, 0x48, 0x36, 0x7f, 0x05, 0x17,
+	0x74, 0x32, 0x07, 0x19, 0x78, 0xc0, 0x50, 0xfb, 0x79, 0xba, 0x70, 0x0c, 0x9e, 0xae, 0xff, 0x7e,
+	0x12, 0x40, 0x9f, 0xd3, 0xf2, 0x93, 0x75, 0x12, 0xc3, 0x09, 0x45, 0x6f, 0xe4, 0x7c, 0x66, 0x7b,
+	0x2f, 0x7f, 0x56, 0x2e, 0xe4, 0xa9, 0x93, 0x0e, 0xb7, 0xe6, 0xbe, 0xf8, 0xf3, 0xaf, 0x1f, 0x0a,
+	0x33, 0x96, 0xa5, 0x9f, 0x35, 0xca, 0xd6, 0xd6, 0xb6, 0xdc, 0x76, 0x5c, 0xe1, 0xd3, 0xd0, 0x0e,
+	0xe4, 0x9e, 0x25, 0xa3, 0x4a, 0xbe, 0x32, 0x60, 0xbc, 0x97, 0x62, 0xc8, 0x95, 0x8c, 0xff, 0x3e,
+	0x0c, 0x74, 0x24, 0x0a, 0x5b, 0xa1, 0xb8, 0x6e, 0x5d, 0x39, 0x14, 0x85, 0xe6, 0x2b, 0x89, 0x23,
+	0x82, 0xe1, 0x84, 0xad, 0x48, 0x5f, 0xd7, 0x7b, 0x34, 0x56, 0x39, 0x5b, 0x4b, 0x1e, 0x66, 0xb5,
+	0xf4, 0x61, 0x56, 0x5b, 0x95, 0x0f, 0x33, 0xab, 0xa6, 0x42, 0xce, 0x5a, 0x97, 0x8f, 0x4a, 0x9c,
+	0xc6, 0x42, 0x67, 0x7e, 0x72, 0x3f, 0xe1, 0x91, 0x6b, 0x7d, 0x72, 0xef, 0xc3, 0x88, 0xb9, 0x10,
+	0x6e, 0x29, 0x08, 0x73, 0xd5, 0x1b, 0xfd, 0x21, 0x7c, 0xb6, 0xd7, 0x49, 0xaf, 0x6d, 0x9e, 0x06,
+	0xfd, 0xd6, 0x80, 0x89, 0x7d, 0x64, 0x45, 0xae, 0x66, 0x60, 0xf4, 0xe3, 0xd7, 0xca, 0xb5, 0xa3,
+	0xcc, 0xf4, 0x59, 0x0c, 0x56, 0x18, 0x57, 0xed, 0x95, 0x85, 0xf9, 0xd2, 0x80, 0x53, 0x07, 0x58,
+	0x90, 0xcc, 0xf4, 0x21, 0x9f, 0x7e, 0x3c, 0x99, 0x5b, 0x9a, 0x79, 0x05, 0xa2, 0x6a, 0x5d, 0x3d,
+	0x14, 0x84, 0xa3, 0x9d, 0x4a, 0x18, 0xaf, 0x61, 0x62, 0x1f, 0x6f, 0xf6, 0x29, 0x4b, 0x3f, 0x5e,
+	0xcd, 0x45, 0xa0, 0x07, 0xa3, 0x6e, 0x1d, 0x7d, 0x38, 0x32, 0x3c, 0x55, 0xa4, 0x98, 0xc6, 0x9e,
+	0xce, 0xc4, 0xde, 0x63, 0xcc, 0x4a, 0x2e, 0x33, 0x5b, 0x55, 0x15, 0xf3, 0x0a, 0x19, 0x20, 0x26,
+	0x79, 0x05, 0x63, 0x6b, 0x28, 0x76, 0x5f, 0x6e, 0x87, 0x46, 0xcc, 0xa7, 0xce, 0xb4, 0x07, 0xc9,
+	0x60, 0x3d, 0xa8, 0x83, 0x7d, 0x6d, 0xc0, 0xc9, 0xfd, 0xac, 0xde, 0x67, 0x16, 0xfa, 0xd2, 0x7e,
+	0x6e, 0xb9, 0x17, 0x15, 0x8e, 0xf9, 0xca, 0x71, 0x70, 0xc8, 0xba, 0x87, 0x30, 0xb2, 0x86, 0x42,
+	0xbd, 0xca, 0x0f, 0x2d, 0xc1, 0x54, 0x3f, 0x5a, 0xe7, 0x29, 0xf1, 0x90, 0x99, 0x01, 0xc2, 0xca,
+	0x2b, 0x80, 0x7c, 0x0e, 0xb0, 0x77, 0xc1, 0x10, 0x2b, 0x27, 0xeb, 0x9e, 0xdb, 0x27, 0x37, 0xe3,
+	0xba, 0x0a, 0x7d, 0xb3, 0x32, 0x68, 0x68, 0x99, 0xed, 0x8f, 0x06, 0x4c, 0xca, 0x53, 0xcf, 0xfc,
+	0x00, 0x38, 0x34, 0xf7, 0x2c, 0xca, 0x8c, 0x03, 0xeb, 0x5d, 0x85, 0x66, 0x91, 0x2c, 0x0c, 0x52,
+	0x7f, 0xe1, 0x30, 0xf4, 0xe6, 0xf6, 0x2e, 0x27, 0xf2, 0x8b, 0x01, 0x6f, 0xe6, 0xdc, 0x8c, 0xc4,
+	0xce, 0xeb, 0x8c, 0x9c, 0x3b, 0x34, 0xb7, 0x60, 0xef, 0x2b, 0x88, 0x77, 0x2a, 0xff, 0x09, 0xe2,
+	0x92, 0x51, 0xbd, 0xf7, 0x5b, 0xe1, 0xfb, 0xe5, 0x5f, 0x0b, 0xe4, 0x0f, 0x45, 0x58, 0xca, 0xd6,
+	0x6c, 0x22, 0x93, 0xbf, 0x48, 0xad, 0x4f, 0xc0, 0x4a, 0x1d, 0x99, 0x3c, 0x91, 0x99, 0x73, 0xa6,
+	0x8e, 0x61, 0x46, 0x8c, 0x6e, 0xa1, 0x2b, 0xc8, 0xa5, 0x4d, 0x21, 0x22, 0xbe, 0x64, 0xdb, 0x1d,
+	0x5f, 0x6c, 0xc6, 0xed, 0x9a, 0x4b, 0xbb, 0x76, 0xc7, 0xf7, 0x76, 0x24, 0x15, 0x25, 0xa6, 0x95,
+	0xa9, 0x8e, 0xef, 0x21, 0x0d, 0x37, 0x1d, 0x17, 0xd9, 0x07, 0x1d, 0xf9, 0x83, 0x5b, 0x5a, 0x55,
+	0x3f, 0x84, 0xc9, 0x7b, 0xcd, 0xfb, 0xe6, 0xad, 0xb9, 0x95, 0xc0, 0x89, 0x39, 0x9a, 0x8f, 0x7c,
+	0x17, 0xe5, 0x03, 0xf3, 0xce, 0x91, 0x1e, 0xed, 0x76, 0x40, 0xdb, 0x76, 0xd7, 0xe1, 0x02, 0x99,
+	0xfd, 0x68, 0x7d, 0x65, 0xf5, 0x71, 0x73, 0xb5, 0x26, 0x5e, 0x8a, 0x7a, 0xf1, 0xed, 0xda, 0x7c,
+	0xb5, 0x68, 0x14, 0x86, 0xea, 0xa7, 0x9d, 0x28, 0x0a, 0x7c, 0x57, 0xfd, 0x0f, 0xc1, 0xde, 0xe2,
+	0x34, 0x5c, 0xca, 0x48, 0x1a, 0x77, 0xa1, 0xb8, 0x30, 0xbf, 0x40, 0x16, 0xa0, 0xda, 0x40, 0x11,
+	0xb3, 0x10, 0x3d, 0xf3, 0xc5, 0x26, 0x86, 0xa6, 0xd8, 0x44, 0x93, 0x21, 0xa7, 0x31, 0x73, 0xd1,
+	0xf4, 0x28, 0x72, 0x33, 0xa4, 0xc2, 0xc4, 0x97, 0x3e, 0x17, 0x35, 0x32, 0x0c, 0x43, 0x3f, 0x17,
+	0x8c, 0xe1, 0x8f, 0xd2, 0x17, 0x63, 0x7b, 0x58, 0x1d, 0xc9, 0xad, 0x7f, 0x07, 0x00, 0x7f, 0xce,
+	0x1f, 0x25, 0x2b, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1020,6 +1170,12 @@ const _ = grpc.SupportPackageIsVersion4
 type AccountAPIClient interface {
 	// Logins a user
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Gets new tokens of a session. The refresh token is replaced.
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Ends the session of the token
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Ends all sessions of an account. Admins may end sessions of other accounts.
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Creates account for user
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	// Activates a user account
@@ -1059,6 +1215,33 @@ func (c *accountAPIClient) Login(ctx context.Context, in *LoginRequest, opts ...
 	return out, nil
 }
 
+func (c *accountAPIClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/antibug.account.AccountAPI/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/antibug.account.AccountAPI/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/antibug.account.AccountAPI/RevokeSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountAPIClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
 	out := new(CreateAccountResponse)
 	err := c.cc.Invoke(ctx, "/antibug.account.AccountAPI/CreateAccount", in, out, opts...)
@@ -1153,6 +1336,12 @@ func (c *accountAPIClient) UpdateStarredFacilities(ctx context.Context, in *Upda
 type AccountAPIServer interface {
 	// Logins a user
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// Gets new tokens of a session. The refresh token is replaced.
+	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginResponse, error)
+	// Ends the session of the token
+	Logout(context.Context, *LogoutRequest) (*empty.Empty, error)
+	// Ends all sessions of an account. Admins may end sessions of other accounts.
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*empty.Empty, error)
 	// Creates account for user
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
 	// Activates a user account
@@ -1197,6 +1386,60 @@ func _AccountAPI_Login_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountAPI_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.account.AccountAPI/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.account.AccountAPI/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.account.AccountAPI/RevokeSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountAPI_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAccountRequest)
 	if err := dec(in); err != nil {
@@ -1385,6 +1628,18 @@ var _AccountAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _AccountAPI_Login_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AccountAPI_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AccountAPI_Logout_Handler,
+		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _AccountAPI_RevokeSessions_Handler,
+		},
 		{
 			MethodName: "CreateAccount",
 			Handler:    _AccountAPI_CreateAccount_Handler,
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	"github.com/gidyon/antibug/pkg/api/culture"
 	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -127,6 +128,117 @@ func (Gender) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_51c4d2d40a40cad1, []int{2}
 }
 
+// Represents the method used to compute the antibiogram
+type AntibiogramMode int32
+
+const (
+	AntibiogramMode_STANDARD AntibiogramMode = 0
+	AntibiogramMode_CLSI_M39 AntibiogramMode = 1
+)
+
+var AntibiogramMode_name = map[int32]string{
+	0: "STANDARD",
+	1: "CLSI_M39",
+}
+
+var AntibiogramMode_value = map[string]int32{
+	"STANDARD": 0,
+	"CLSI_M39": 1,
+}
+
+func (x AntibiogramMode) String() string {
+	return proto.EnumName(AntibiogramMode_name, int32(x))
+}
+
+func (AntibiogramMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{3}
+}
+
+// Represents the size of buckets in a resistance trend
+type TrendInterval int32
+
+const (
+	TrendInterval_MONTHLY   TrendInterval = 0
+	TrendInterval_QUARTERLY TrendInterval = 1
+	TrendInterval_YEARLY    TrendInterval = 2
+)
+
+var TrendInterval_name = map[int32]string{
+	0: "MONTHLY",
+	1: "QUARTERLY",
+	2: "YEARLY",
+}
+
+var TrendInterval_value = map[string]int32{
+	"MONTHLY":   0,
+	"QUARTERLY": 1,
+	"YEARLY":    2,
+}
+
+func (x TrendInterval) String() string {
+	return proto.EnumName(TrendInterval_name, int32(x))
+}
+
+func (TrendInterval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{4}
+}
+
+// LabelStat is the number and percentage of isolates interpreted with a label
+type LabelStat struct {
+	Label                culture.Label `protobuf:"varint,1,opt,name=label,proto3,enum=antibug.culture.Label" json:"label,omitempty"`
+	Isolates             int32         `protobuf:"varint,2,opt,name=isolates,proto3" json:"isolates,omitempty"`
+	Percent              float32       `protobuf:"fixed32,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *LabelStat) Reset()         { *m = LabelStat{} }
+func (m *LabelStat) String() string { return proto.CompactTextString(m) }
+func (*LabelStat) ProtoMessage()    {}
+func (*LabelStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{0}
+}
+
+func (m *LabelStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelStat.Unmarshal(m, b)
+}
+func (m *LabelStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelStat.Marshal(b, m, deterministic)
+}
+func (m *LabelStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelStat.Merge(m, src)
+}
+func (m *LabelStat) XXX_Size() int {
+	return xxx_messageInfo_LabelStat.Size(m)
+}
+func (m *LabelStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelStat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelStat proto.InternalMessageInfo
+
+func (m *LabelStat) GetLabel() culture.Label {
+	if m != nil {
+		return m.Label
+	}
+	return culture.Label_SUSCEPTIBLE
+}
+
+func (m *LabelStat) GetIsolates() int32 {
+	if m != nil {
+		return m.Isolates
+	}
+	return 0
+}
+
+func (m *LabelStat) GetPercent() float32 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
 // PathogenSusceptibility refers to susceptibility of a pathogen against an antimicrobial agent
 type PathogenSusceptibility struct {
 	AntimicrobialName    string        `protobuf:"bytes,1,opt,name=antimicrobial_name,json=antimicrobialName,proto3" json:"antimicrobial_name,omitempty"`
@@ -134,6 +246,10 @@ type PathogenSusceptibility struct {
 	Isolates             int32         `protobuf:"varint,3,opt,name=isolates,proto3" json:"isolates,omitempty"`
 	SusceptibilityScore  float32       `protobuf:"fixed32,4,opt,name=susceptibility_score,json=susceptibilityScore,proto3" json:"susceptibility_score,omitempty"`
 	Label                culture.Label `protobuf:"varint,5,opt,name=label,proto3,enum=antibug.culture.Label" json:"label,omitempty"`
+	IsolatesSusceptible  int32         `protobuf:"varint,6,opt,name=isolates_susceptible,json=isolatesSusceptible,proto3" json:"isolates_susceptible,omitempty"`
+	PercentSusceptible   float32       `protobuf:"fixed32,7,opt,name=percent_susceptible,json=percentSusceptible,proto3" json:"percent_susceptible,omitempty"`
+	InsufficientIsolates bool          `protobuf:"varint,8,opt,name=insufficient_isolates,json=insufficientIsolates,proto3" json:"insufficient_isolates,omitempty"`
+	LabelStats           []*LabelStat  `protobuf:"bytes,9,rep,name=label_stats,json=labelStats,proto3" json:"label_stats,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -143,7 +259,7 @@ func (m *PathogenSusceptibility) Reset()         { *m = PathogenSusceptibility{}
 func (m *PathogenSusceptibility) String() string { return proto.CompactTextString(m) }
 func (*PathogenSusceptibility) ProtoMessage()    {}
 func (*PathogenSusceptibility) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c4d2d40a40cad1, []int{0}
+	return fileDescriptor_51c4d2d40a40cad1, []int{1}
 }
 
 func (m *PathogenSusceptibility) XXX_Unmarshal(b []byte) error {
@@ -199,6 +315,34 @@ func (m *PathogenSusceptibility) GetLabel() culture.Label {
 	return culture.Label_SUSCEPTIBLE
 }
 
+func (m *PathogenSusceptibility) GetIsolatesSusceptible() int32 {
+	if m != nil {
+		return m.IsolatesSusceptible
+	}
+	return 0
+}
+
+func (m *PathogenSusceptibility) GetPercentSusceptible() float32 {
+	if m != nil {
+		return m.PercentSusceptible
+	}
+	return 0
+}
+
+func (m *PathogenSusceptibility) GetInsufficientIsolates() bool {
+	if m != nil {
+		return m.InsufficientIsolates
+	}
+	return false
+}
+
+func (m *PathogenSusceptibility) GetLabelStats() []*LabelStat {
+	if m != nil {
+		return m.LabelStats
+	}
+	return nil
+}
+
 // AntimicrobialSusceptibility is the susceptibility of an antimicrobial against a pathogen
 type AntimicrobialSusceptibility struct {
 	PathogenName         string        `protobuf:"bytes,1,opt,name=pathogen_name,json=pathogenName,proto3" json:"pathogen_name,omitempty"`
@@ -206,6 +350,10 @@ type AntimicrobialSusceptibility struct {
 	Isolates             int32         `protobuf:"varint,3,opt,name=isolates,proto3" json:"isolates,omitempty"`
 	SusceptibilityScore  float32       `protobuf:"fixed32,4,opt,name=susceptibility_score,json=susceptibilityScore,proto3" json:"susceptibility_score,omitempty"`
 	Label                culture.Label `protobuf:"varint,5,opt,name=label,proto3,enum=antibug.culture.Label" json:"label,omitempty"`
+	IsolatesSusceptible  int32         `protobuf:"varint,6,opt,name=isolates_susceptible,json=isolatesSusceptible,proto3" json:"isolates_susceptible,omitempty"`
+	PercentSusceptible   float32       `protobuf:"fixed32,7,opt,name=percent_susceptible,json=percentSusceptible,proto3" json:"percent_susceptible,omitempty"`
+	InsufficientIsolates bool          `protobuf:"varint,8,opt,name=insufficient_isolates,json=insufficientIsolates,proto3" json:"insufficient_isolates,omitempty"`
+	LabelStats           []*LabelStat  `protobuf:"bytes,9,rep,name=label_stats,json=labelStats,proto3" json:"label_stats,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -215,7 +363,7 @@ func (m *AntimicrobialSusceptibility) Reset()         { *m = AntimicrobialSuscep
 func (m *AntimicrobialSusceptibility) String() string { return proto.CompactTextString(m) }
 func (*AntimicrobialSusceptibility) ProtoMessage()    {}
 func (*AntimicrobialSusceptibility) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c4d2d40a40cad1, []int{1}
+	return fileDescriptor_51c4d2d40a40cad1, []int{2}
 }
 
 func (m *AntimicrobialSusceptibility) XXX_Unmarshal(b []byte) error {
@@ -271,6 +419,34 @@ func (m *AntimicrobialSusceptibility) GetLabel() culture.Label {
 	return culture.Label_SUSCEPTIBLE
 }
 
+func (m *AntimicrobialSusceptibility) GetIsolatesSusceptible() int32 {
+	if m != nil {
+		return m.IsolatesSusceptible
+	}
+	return 0
+}
+
+func (m *AntimicrobialSusceptibility) GetPercentSusceptible() float32 {
+	if m != nil {
+		return m.PercentSusceptible
+	}
+	return 0
+}
+
+func (m *AntimicrobialSusceptibility) GetInsufficientIsolates() bool {
+	if m != nil {
+		return m.InsufficientIsolates
+	}
+	return false
+}
+
+func (m *AntimicrobialSusceptibility) GetLabelStats() []*LabelStat {
+	if m != nil {
+		return m.LabelStats
+	}
+	return nil
+}
+
 // PathogenAntibiogram represents the antibiogram report for a particular pathogen
 type PathogenAntibiogram struct {
 	PathogenName         string                    `protobuf:"bytes,1,opt,name=pathogen_name,json=pathogenName,proto3" json:"pathogen_name,omitempty"`
@@ -285,7 +461,7 @@ func (m *PathogenAntibiogram) Reset()         { *m = PathogenAntibiogram{} }
 func (m *PathogenAntibiogram) String() string { return proto.CompactTextString(m) }
 func (*PathogenAntibiogram) ProtoMessage()    {}
 func (*PathogenAntibiogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c4d2d40a40cad1, []int{2}
+	return fileDescriptor_51c4d2d40a40cad1, []int{3}
 }
 
 func (m *PathogenAntibiogram) XXX_Unmarshal(b []byte) error {
@@ -339,7 +515,7 @@ func (m *PathogensAntibiogram) Reset()         { *m = PathogensAntibiogram{} }
 func (m *PathogensAntibiogram) String() string { return proto.CompactTextString(m) }
 func (*PathogensAntibiogram) ProtoMessage()    {}
 func (*PathogensAntibiogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c4d2d40a40cad1, []int{3}
+	return fileDescriptor_51c4d2d40a40cad1, []int{4}
 }
 
 func (m *PathogensAntibiogram) XXX_Unmarshal(b []byte) error {
@@ -381,7 +557,7 @@ func (m *AntimicrobialAntibiogram) Reset()         { *m = AntimicrobialAntibiogr
 func (m *AntimicrobialAntibiogram) String() string { return proto.CompactTextString(m) }
 func (*AntimicrobialAntibiogram) ProtoMessage()    {}
 func (*AntimicrobialAntibiogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c4d2d40a40cad1, []int{4}
+	return fileDescriptor_51c4d2d40a40cad1, []int{5}
 }
 
 func (m *AntimicrobialAntibiogram) XXX_Unmarshal(b []byte) error {
@@ -435,7 +611,7 @@ func (m *AntimicrobialsAntibiogram) Reset()         { *m = AntimicrobialsAntibio
 func (m *AntimicrobialsAntibiogram) String() string { return proto.CompactTextString(m) }
 func (*AntimicrobialsAntibiogram) ProtoMessage()    {}
 func (*AntimicrobialsAntibiogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c4d2d40a40cad1, []int{5}
+	return fileDescriptor_51c4d2d40a40cad1, []int{6}
 }
 
 func (m *AntimicrobialsAntibiogram) XXX_Unmarshal(b []byte) error {
@@ -463,6 +639,190 @@ func (m *AntimicrobialsAntibiogram) GetAntibiograms() []*AntimicrobialAntibiogra
 	return nil
 }
 
+// AntibiogramCell is the susceptibility of the row pathogen against the column antimicrobial
+type AntibiogramCell struct {
+	AntimicrobialId      string   `protobuf:"bytes,1,opt,name=antimicrobial_id,json=antimicrobialId,proto3" json:"antimicrobial_id,omitempty"`
+	Isolates             int32    `protobuf:"varint,2,opt,name=isolates,proto3" json:"isolates,omitempty"`
+	IsolatesSusceptible  int32    `protobuf:"varint,3,opt,name=isolates_susceptible,json=isolatesSusceptible,proto3" json:"isolates_susceptible,omitempty"`
+	PercentSusceptible   float32  `protobuf:"fixed32,4,opt,name=percent_susceptible,json=percentSusceptible,proto3" json:"percent_susceptible,omitempty"`
+	InsufficientIsolates bool     `protobuf:"varint,5,opt,name=insufficient_isolates,json=insufficientIsolates,proto3" json:"insufficient_isolates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AntibiogramCell) Reset()         { *m = AntibiogramCell{} }
+func (m *AntibiogramCell) String() string { return proto.CompactTextString(m) }
+func (*AntibiogramCell) ProtoMessage()    {}
+func (*AntibiogramCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{7}
+}
+
+func (m *AntibiogramCell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AntibiogramCell.Unmarshal(m, b)
+}
+func (m *AntibiogramCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AntibiogramCell.Marshal(b, m, deterministic)
+}
+func (m *AntibiogramCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AntibiogramCell.Merge(m, src)
+}
+func (m *AntibiogramCell) XXX_Size() int {
+	return xxx_messageInfo_AntibiogramCell.Size(m)
+}
+func (m *AntibiogramCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_AntibiogramCell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AntibiogramCell proto.InternalMessageInfo
+
+func (m *AntibiogramCell) GetAntimicrobialId() string {
+	if m != nil {
+		return m.AntimicrobialId
+	}
+	return ""
+}
+
+func (m *AntibiogramCell) GetIsolates() int32 {
+	if m != nil {
+		return m.Isolates
+	}
+	return 0
+}
+
+func (m *AntibiogramCell) GetIsolatesSusceptible() int32 {
+	if m != nil {
+		return m.IsolatesSusceptible
+	}
+	return 0
+}
+
+func (m *AntibiogramCell) GetPercentSusceptible() float32 {
+	if m != nil {
+		return m.PercentSusceptible
+	}
+	return 0
+}
+
+func (m *AntibiogramCell) GetInsufficientIsolates() bool {
+	if m != nil {
+		return m.InsufficientIsolates
+	}
+	return false
+}
+
+// AntibiogramRow contains cells for a pathogen, one for each column of the matrix
+type AntibiogramRow struct {
+	PathogenName         string             `protobuf:"bytes,1,opt,name=pathogen_name,json=pathogenName,proto3" json:"pathogen_name,omitempty"`
+	PathogenId           string             `protobuf:"bytes,2,opt,name=pathogen_id,json=pathogenId,proto3" json:"pathogen_id,omitempty"`
+	Isolates             int32              `protobuf:"varint,3,opt,name=isolates,proto3" json:"isolates,omitempty"`
+	Cells                []*AntibiogramCell `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AntibiogramRow) Reset()         { *m = AntibiogramRow{} }
+func (m *AntibiogramRow) String() string { return proto.CompactTextString(m) }
+func (*AntibiogramRow) ProtoMessage()    {}
+func (*AntibiogramRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{8}
+}
+
+func (m *AntibiogramRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AntibiogramRow.Unmarshal(m, b)
+}
+func (m *AntibiogramRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AntibiogramRow.Marshal(b, m, deterministic)
+}
+func (m *AntibiogramRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AntibiogramRow.Merge(m, src)
+}
+func (m *AntibiogramRow) XXX_Size() int {
+	return xxx_messageInfo_AntibiogramRow.Size(m)
+}
+func (m *AntibiogramRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_AntibiogramRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AntibiogramRow proto.InternalMessageInfo
+
+func (m *AntibiogramRow) GetPathogenName() string {
+	if m != nil {
+		return m.PathogenName
+	}
+	return ""
+}
+
+func (m *AntibiogramRow) GetPathogenId() string {
+	if m != nil {
+		return m.PathogenId
+	}
+	return ""
+}
+
+func (m *AntibiogramRow) GetIsolates() int32 {
+	if m != nil {
+		return m.Isolates
+	}
+	return 0
+}
+
+func (m *AntibiogramRow) GetCells() []*AntibiogramCell {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+// AntibiogramMatrix is the pathogen by antimicrobial antibiogram table
+type AntibiogramMatrix struct {
+	Antimicrobials       []*Value          `protobuf:"bytes,1,rep,name=antimicrobials,proto3" json:"antimicrobials,omitempty"`
+	Rows                 []*AntibiogramRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AntibiogramMatrix) Reset()         { *m = AntibiogramMatrix{} }
+func (m *AntibiogramMatrix) String() string { return proto.CompactTextString(m) }
+func (*AntibiogramMatrix) ProtoMessage()    {}
+func (*AntibiogramMatrix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{9}
+}
+
+func (m *AntibiogramMatrix) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AntibiogramMatrix.Unmarshal(m, b)
+}
+func (m *AntibiogramMatrix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AntibiogramMatrix.Marshal(b, m, deterministic)
+}
+func (m *AntibiogramMatrix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AntibiogramMatrix.Merge(m, src)
+}
+func (m *AntibiogramMatrix) XXX_Size() int {
+	return xxx_messageInfo_AntibiogramMatrix.Size(m)
+}
+func (m *AntibiogramMatrix) XXX_DiscardUnknown() {
+	xxx_messageInfo_AntibiogramMatrix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AntibiogramMatrix proto.InternalMessageInfo
+
+func (m *AntibiogramMatrix) GetAntimicrobials() []*Value {
+	if m != nil {
+		return m.Antimicrobials
+	}
+	return nil
+}
+
+func (m *AntibiogramMatrix) GetRows() []*AntibiogramRow {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
 // key value of the filter criteria
 type Value struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -476,7 +836,7 @@ func (m *Value) Reset()         { *m = Value{} }
 func (m *Value) String() string { return proto.CompactTextString(m) }
 func (*Value) ProtoMessage()    {}
 func (*Value) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c4d2d40a40cad1, []int{6}
+	return fileDescriptor_51c4d2d40a40cad1, []int{10}
 }
 
 func (m *Value) XXX_Unmarshal(b []byte) error {
@@ -512,19 +872,24 @@ func (m *Value) GetId() string {
 }
 
 type AdvancedFilter struct {
-	Gender               Gender   `protobuf:"varint,1,opt,name=gender,proto3,enum=antibug.antibiogram.Gender" json:"gender,omitempty"`
-	AgeMinDays           int64    `protobuf:"varint,2,opt,name=age_min_days,json=ageMinDays,proto3" json:"age_min_days,omitempty"`
-	AgeMaxDays           int64    `protobuf:"varint,3,opt,name=age_max_days,json=ageMaxDays,proto3" json:"age_max_days,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Gender     Gender `protobuf:"varint,1,opt,name=gender,proto3,enum=antibug.antibiogram.Gender" json:"gender,omitempty"`
+	AgeMinDays int64  `protobuf:"varint,2,opt,name=age_min_days,json=ageMinDays,proto3" json:"age_min_days,omitempty"`
+	AgeMaxDays int64  `protobuf:"varint,3,opt,name=age_max_days,json=ageMaxDays,proto3" json:"age_max_days,omitempty"`
+	// Cultures of any of the values are selected. Empty lists select all cultures.
+	Wards                []string                 `protobuf:"bytes,4,rep,name=wards,proto3" json:"wards,omitempty"`
+	Departments          []culture.Department     `protobuf:"varint,5,rep,packed,name=departments,proto3,enum=antibug.culture.Department" json:"departments,omitempty"`
+	PatientSettings      []culture.PatientSetting `protobuf:"varint,6,rep,packed,name=patient_settings,json=patientSettings,proto3,enum=antibug.culture.PatientSetting" json:"patient_settings,omitempty"`
+	SpecimenTypes        []culture.SpecimenType   `protobuf:"varint,7,rep,packed,name=specimen_types,json=specimenTypes,proto3,enum=antibug.culture.SpecimenType" json:"specimen_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *AdvancedFilter) Reset()         { *m = AdvancedFilter{} }
 func (m *AdvancedFilter) String() string { return proto.CompactTextString(m) }
 func (*AdvancedFilter) ProtoMessage()    {}
 func (*AdvancedFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c4d2d40a40cad1, []int{7}
+	return fileDescriptor_51c4d2d40a40cad1, []int{11}
 }
 
 func (m *AdvancedFilter) XXX_Unmarshal(b []byte) error {
@@ -566,24 +931,58 @@ func (m *AdvancedFilter) GetAgeMaxDays() int64 {
 	return 0
 }
 
+func (m *AdvancedFilter) GetWards() []string {
+	if m != nil {
+		return m.Wards
+	}
+	return nil
+}
+
+func (m *AdvancedFilter) GetDepartments() []culture.Department {
+	if m != nil {
+		return m.Departments
+	}
+	return nil
+}
+
+func (m *AdvancedFilter) GetPatientSettings() []culture.PatientSetting {
+	if m != nil {
+		return m.PatientSettings
+	}
+	return nil
+}
+
+func (m *AdvancedFilter) GetSpecimenTypes() []culture.SpecimenType {
+	if m != nil {
+		return m.SpecimenTypes
+	}
+	return nil
+}
+
 // Filter represents the filter criteria used in filtering the antibiogram report
 type Filter struct {
-	PastDuration         Duration        `protobuf:"varint,1,opt,name=past_duration,json=pastDuration,proto3,enum=antibug.antibiogram.Duration" json:"past_duration,omitempty"`
-	RegionScope          RegionScope     `protobuf:"varint,2,opt,name=region_scope,json=regionScope,proto3,enum=antibug.antibiogram.RegionScope" json:"region_scope,omitempty"`
-	InputValues          []*Value        `protobuf:"bytes,3,rep,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
-	ScopeValues          []string        `protobuf:"bytes,4,rep,name=scope_values,json=scopeValues,proto3" json:"scope_values,omitempty"`
-	Advanced             bool            `protobuf:"varint,5,opt,name=advanced,proto3" json:"advanced,omitempty"`
-	Advance              *AdvancedFilter `protobuf:"bytes,6,opt,name=advance,proto3" json:"advance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	PastDuration         Duration            `protobuf:"varint,1,opt,name=past_duration,json=pastDuration,proto3,enum=antibug.antibiogram.Duration" json:"past_duration,omitempty"`
+	RegionScope          RegionScope         `protobuf:"varint,2,opt,name=region_scope,json=regionScope,proto3,enum=antibug.antibiogram.RegionScope" json:"region_scope,omitempty"`
+	InputValues          []*Value            `protobuf:"bytes,3,rep,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
+	ScopeValues          []string            `protobuf:"bytes,4,rep,name=scope_values,json=scopeValues,proto3" json:"scope_values,omitempty"`
+	Advanced             bool                `protobuf:"varint,5,opt,name=advanced,proto3" json:"advanced,omitempty"`
+	Advance              *AdvancedFilter     `protobuf:"bytes,6,opt,name=advance,proto3" json:"advance,omitempty"`
+	Mode                 AntibiogramMode     `protobuf:"varint,7,opt,name=mode,proto3,enum=antibug.antibiogram.AntibiogramMode" json:"mode,omitempty"`
+	MinIsolates          int32               `protobuf:"varint,8,opt,name=min_isolates,json=minIsolates,proto3" json:"min_isolates,omitempty"`
+	HideInsufficient     bool                `protobuf:"varint,9,opt,name=hide_insufficient,json=hideInsufficient,proto3" json:"hide_insufficient,omitempty"`
+	DateFilter           *culture.DateFilter `protobuf:"bytes,10,opt,name=date_filter,json=dateFilter,proto3" json:"date_filter,omitempty"`
+	CalendarYear         int32               `protobuf:"varint,11,opt,name=calendar_year,json=calendarYear,proto3" json:"calendar_year,omitempty"`
+	MaxStalenessSec      int64               `protobuf:"varint,12,opt,name=max_staleness_sec,json=maxStalenessSec,proto3" json:"max_staleness_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Filter) Reset()         { *m = Filter{} }
 func (m *Filter) String() string { return proto.CompactTextString(m) }
 func (*Filter) ProtoMessage()    {}
 func (*Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51c4d2d40a40cad1, []int{8}
+	return fileDescriptor_51c4d2d40a40cad1, []int{12}
 }
 
 func (m *Filter) XXX_Unmarshal(b []byte) error {
@@ -646,100 +1045,845 @@ func (m *Filter) GetAdvance() *AdvancedFilter {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("antibug.antibiogram.Duration", Duration_name, Duration_value)
-	proto.RegisterEnum("antibug.antibiogram.RegionScope", RegionScope_name, RegionScope_value)
-	proto.RegisterEnum("antibug.antibiogram.Gender", Gender_name, Gender_value)
-	proto.RegisterType((*PathogenSusceptibility)(nil), "antibug.antibiogram.PathogenSusceptibility")
-	proto.RegisterType((*AntimicrobialSusceptibility)(nil), "antibug.antibiogram.AntimicrobialSusceptibility")
-	proto.RegisterType((*PathogenAntibiogram)(nil), "antibug.antibiogram.PathogenAntibiogram")
-	proto.RegisterType((*PathogensAntibiogram)(nil), "antibug.antibiogram.PathogensAntibiogram")
-	proto.RegisterType((*AntimicrobialAntibiogram)(nil), "antibug.antibiogram.AntimicrobialAntibiogram")
-	proto.RegisterType((*AntimicrobialsAntibiogram)(nil), "antibug.antibiogram.AntimicrobialsAntibiogram")
-	proto.RegisterType((*Value)(nil), "antibug.antibiogram.Value")
-	proto.RegisterType((*AdvancedFilter)(nil), "antibug.antibiogram.AdvancedFilter")
-	proto.RegisterType((*Filter)(nil), "antibug.antibiogram.Filter")
+func (m *Filter) GetMode() AntibiogramMode {
+	if m != nil {
+		return m.Mode
+	}
+	return AntibiogramMode_STANDARD
 }
 
-func init() { proto.RegisterFile("antibiogram.proto", fileDescriptor_51c4d2d40a40cad1) }
-
-var fileDescriptor_51c4d2d40a40cad1 = []byte{
-	// 1142 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x0e, 0x29, 0x59, 0x51, 0x46, 0x8a, 0xa2, 0xac, 0x1d, 0xff, 0x14, 0x25, 0x3f, 0x94, 0x91,
-	0x1b, 0x44, 0x96, 0x23, 0xd1, 0x91, 0x7d, 0x69, 0x8a, 0x00, 0x95, 0x6d, 0xd9, 0x16, 0x20, 0xcb,
-	0x0e, 0x25, 0x37, 0x75, 0x51, 0x40, 0x58, 0x91, 0x0b, 0x6a, 0x03, 0x8a, 0x14, 0xb8, 0x2b, 0xc7,
-	0xbe, 0xf6, 0x56, 0x14, 0xe8, 0xa1, 0xbd, 0x05, 0xe8, 0xb1, 0x6f, 0xd0, 0x4b, 0x5f, 0xa2, 0x28,
-	0xd2, 0x17, 0xe8, 0xa1, 0xc7, 0x02, 0x7d, 0x85, 0x82, 0x2b, 0x52, 0x26, 0x1d, 0xfa, 0x4f, 0x81,
-	0x16, 0x3d, 0x71, 0x77, 0xf6, 0x9b, 0x99, 0x6f, 0xbe, 0x9d, 0x1d, 0x10, 0xee, 0x62, 0x9b, 0xd3,
-	0x01, 0x75, 0x4c, 0x17, 0x8f, 0x6a, 0x63, 0xd7, 0xe1, 0x0e, 0x9a, 0x17, 0xa6, 0x89, 0x59, 0x0b,
-	0x1d, 0x15, 0x1f, 0x9a, 0x8e, 0x63, 0x5a, 0x44, 0xc5, 0x63, 0xaa, 0x62, 0xdb, 0x76, 0x38, 0xe6,
-	0xd4, 0xb1, 0xd9, 0xd4, 0xa5, 0xf8, 0x54, 0x7c, 0xf4, 0xaa, 0x49, 0xec, 0x2a, 0x7b, 0x83, 0x4d,
-	0x93, 0xb8, 0xaa, 0x33, 0x16, 0x88, 0x18, 0xf4, 0x6d, 0x7d, 0x62, 0xf1, 0x89, 0x4b, 0xa6, 0xdb,
-	0xd2, 0x9f, 0x12, 0x2c, 0x1e, 0x60, 0x3e, 0x74, 0x4c, 0x62, 0x77, 0x27, 0x4c, 0x27, 0x63, 0x2f,
-	0xad, 0x45, 0xf9, 0x29, 0xaa, 0x02, 0xf2, 0x48, 0x8c, 0xa8, 0xee, 0x3a, 0x03, 0x8a, 0xad, 0xbe,
-	0x8d, 0x47, 0xa4, 0x20, 0x29, 0x52, 0xf9, 0x96, 0x76, 0x37, 0x72, 0xd2, 0xc1, 0x23, 0x82, 0x96,
-	0x21, 0x1f, 0x85, 0x53, 0xa3, 0x20, 0x0b, 0xf0, 0x9d, 0x88, 0xbd, 0x65, 0xa0, 0x22, 0xa4, 0x29,
-	0x73, 0x2c, 0xcc, 0x09, 0x2b, 0x24, 0x14, 0xa9, 0x3c, 0xa7, 0xcd, 0xf6, 0xe8, 0x19, 0x2c, 0xb0,
-	0x08, 0x8f, 0x3e, 0xd3, 0x1d, 0x97, 0x14, 0x92, 0x8a, 0x54, 0x96, 0xb5, 0xf9, 0xe8, 0x59, 0xd7,
-	0x3b, 0x42, 0x4f, 0x61, 0xce, 0xc2, 0x03, 0x62, 0x15, 0xe6, 0x14, 0xa9, 0x9c, 0xab, 0x2f, 0xd6,
-	0x02, 0x0d, 0x83, 0x52, 0xdb, 0xde, 0xa9, 0x36, 0x05, 0x95, 0x7e, 0x93, 0xe0, 0x41, 0x23, 0x4c,
-	0xe8, 0x5c, 0xd9, 0x4b, 0x70, 0x7b, 0xec, 0x0b, 0x12, 0xae, 0x38, 0x1b, 0x18, 0x45, 0xb1, 0x1f,
-	0x40, 0x66, 0x06, 0x9a, 0xd5, 0x09, 0x81, 0xe9, 0xbf, 0x2e, 0xf1, 0x47, 0x09, 0xe6, 0x83, 0x4b,
-	0x6d, 0x9c, 0xf5, 0xd1, 0x3f, 0x54, 0xda, 0x2b, 0xc8, 0x47, 0x28, 0x52, 0xc2, 0x0a, 0x49, 0x25,
-	0x51, 0xce, 0xd4, 0x57, 0x6a, 0x31, 0xdd, 0x5b, 0x8b, 0x6f, 0x2f, 0xed, 0xbd, 0x20, 0x25, 0x03,
-	0x16, 0x02, 0x2c, 0x0b, 0xd3, 0x6e, 0x43, 0x36, 0x14, 0x8f, 0x15, 0x24, 0x91, 0xac, 0x7c, 0x69,
-	0xb2, 0x90, 0xbf, 0x16, 0xf1, 0x2e, 0xbd, 0x93, 0xa0, 0x10, 0xb9, 0xff, 0x70, 0xaa, 0x7f, 0xaf,
-	0xe7, 0xbf, 0xb8, 0x50, 0xb5, 0xd5, 0xd8, 0x42, 0x2e, 0x69, 0xd1, 0x18, 0xe9, 0x6c, 0xb8, 0x1f,
-	0x71, 0x88, 0xe8, 0xf7, 0xf2, 0x9c, 0x7e, 0xb2, 0x48, 0x5b, 0xbd, 0x3a, 0xed, 0xc5, 0x22, 0xae,
-	0xc0, 0xdc, 0xa7, 0xd8, 0x9a, 0x10, 0x84, 0x20, 0x19, 0x92, 0x48, 0xac, 0x51, 0x0e, 0xe4, 0x99,
-	0x0e, 0x32, 0x35, 0x4a, 0x5f, 0x49, 0x90, 0x6b, 0x18, 0xc7, 0xd8, 0xd6, 0x89, 0xb1, 0x4d, 0x2d,
-	0x4e, 0x5c, 0xb4, 0x06, 0x29, 0x93, 0xd8, 0x06, 0x71, 0x85, 0x63, 0xae, 0xfe, 0x20, 0x96, 0xcc,
-	0x8e, 0x80, 0x68, 0x3e, 0x14, 0x29, 0x90, 0xc5, 0x26, 0xe9, 0x8f, 0xa8, 0xdd, 0x37, 0xf0, 0x29,
-	0x13, 0x19, 0x12, 0x1a, 0x60, 0x93, 0xec, 0x51, 0x7b, 0x0b, 0x9f, 0xb2, 0x19, 0x02, 0x9f, 0x4c,
-	0x11, 0x89, 0x33, 0x04, 0x3e, 0xf1, 0x10, 0xa5, 0x9f, 0x65, 0x48, 0xf9, 0x1c, 0x36, 0xbc, 0xd7,
-	0xc0, 0x78, 0xdf, 0x98, 0xb8, 0x62, 0x42, 0xfa, 0x54, 0xfe, 0x1f, 0x4b, 0x65, 0xcb, 0x07, 0x79,
-	0x8f, 0x85, 0xf1, 0x60, 0x87, 0x36, 0x21, 0xeb, 0x12, 0x93, 0x3a, 0xb6, 0xf7, 0x84, 0xc7, 0x44,
-	0x50, 0xca, 0xd5, 0x95, 0xd8, 0x10, 0x9a, 0x00, 0x76, 0x3d, 0x9c, 0x96, 0x71, 0xcf, 0x36, 0xe8,
-	0x05, 0x64, 0xa9, 0x3d, 0x9e, 0xf0, 0xfe, 0xb1, 0x27, 0xa9, 0xc7, 0xda, 0xbb, 0x9f, 0x62, 0x6c,
-	0x10, 0xa1, 0xba, 0x96, 0x11, 0x78, 0xb1, 0x66, 0xe8, 0x11, 0x64, 0x45, 0xf2, 0xc0, 0xdd, 0xeb,
-	0xaa, 0x5b, 0x5a, 0x46, 0xd8, 0x7c, 0x48, 0x11, 0xd2, 0xd8, 0xbf, 0x00, 0x31, 0x41, 0xd2, 0xda,
-	0x6c, 0x8f, 0x5e, 0xc0, 0x4d, 0x7f, 0x5d, 0x48, 0x29, 0x52, 0x39, 0x53, 0x5f, 0x8a, 0x6f, 0x8c,
-	0xc8, 0x05, 0x6a, 0x81, 0x4f, 0xe5, 0x07, 0x09, 0xd2, 0x33, 0x39, 0xe6, 0xe1, 0xce, 0x41, 0xa3,
-	0xdb, 0xeb, 0x77, 0x5b, 0x9f, 0xf5, 0xf7, 0xf6, 0x3b, 0xbd, 0xdd, 0x6e, 0xfe, 0x06, 0x42, 0x90,
-	0x13, 0xc6, 0xfd, 0x4e, 0xb3, 0x7f, 0xd4, 0x6c, 0x68, 0xdd, 0xbc, 0x34, 0xb3, 0xf5, 0x5e, 0xed,
-	0xfb, 0x36, 0x79, 0xe6, 0xbc, 0xbd, 0x7f, 0xa8, 0xf9, 0xc6, 0x04, 0x5a, 0x80, 0xbc, 0x30, 0x36,
-	0x5b, 0x3b, 0xbb, 0x3d, 0xdf, 0x9a, 0x44, 0x8b, 0x80, 0x82, 0x3c, 0xbd, 0x66, 0xb3, 0xe3, 0xdb,
-	0xe7, 0xd0, 0x7d, 0xb8, 0x37, 0x0d, 0xbb, 0xdb, 0xd2, 0x7a, 0x47, 0xa1, 0xe8, 0xa9, 0xca, 0x16,
-	0x64, 0x42, 0x17, 0x80, 0x32, 0x70, 0x73, 0x73, 0xff, 0xb0, 0xd3, 0xd3, 0x8e, 0xf2, 0x37, 0x10,
-	0x40, 0x4a, 0x6c, 0x8e, 0xf2, 0x12, 0xca, 0x01, 0x74, 0x0f, 0x37, 0xfa, 0xfe, 0x5e, 0x46, 0x59,
-	0x48, 0x6f, 0x37, 0x36, 0x5b, 0xed, 0x56, 0xef, 0x28, 0x9f, 0xa8, 0x3c, 0x81, 0xd4, 0xb4, 0x29,
-	0xd1, 0x4d, 0x48, 0x34, 0xda, 0xed, 0xfc, 0x0d, 0x94, 0x86, 0xe4, 0x5e, 0xa3, 0xdd, 0xcc, 0x4b,
-	0x5e, 0x98, 0xed, 0xa6, 0x58, 0x27, 0xea, 0x7f, 0x24, 0x21, 0x17, 0x7a, 0x3e, 0x8d, 0x83, 0x16,
-	0xfa, 0x46, 0x82, 0xff, 0xed, 0x10, 0x3b, 0x76, 0xc4, 0xc5, 0xf7, 0xff, 0x54, 0xeb, 0xe2, 0xf2,
-	0xa5, 0x93, 0x2e, 0x1c, 0xa7, 0xb4, 0xf2, 0xe5, 0xaf, 0xbf, 0x7f, 0x27, 0x3f, 0x46, 0x4b, 0xfe,
-	0xbf, 0x82, 0x70, 0x53, 0xc3, 0x4f, 0x57, 0x0d, 0x26, 0x39, 0x43, 0x5f, 0x4b, 0xb0, 0x18, 0x22,
-	0x74, 0x6d, 0x3e, 0xd7, 0x9e, 0xbc, 0xa5, 0x8a, 0xa0, 0xf3, 0x21, 0x2a, 0x5d, 0x4d, 0x07, 0x7d,
-	0x2f, 0xc1, 0xc3, 0x9d, 0xa9, 0x7b, 0xfc, 0x18, 0xbb, 0x94, 0x53, 0xed, 0xea, 0x69, 0x16, 0x11,
-	0x6a, 0x55, 0x30, 0xab, 0xa0, 0xf2, 0xc5, 0xcc, 0x22, 0x13, 0x9c, 0xa1, 0xb7, 0x12, 0x3c, 0x38,
-	0xcf, 0xef, 0xda, 0xf4, 0xfe, 0xde, 0xb0, 0x2d, 0xa9, 0x82, 0xdd, 0x32, 0x7a, 0x72, 0x4d, 0x76,
-	0x1b, 0xef, 0xe4, 0x6f, 0x1b, 0x3f, 0xc9, 0xe8, 0x17, 0x09, 0xe6, 0x43, 0x71, 0x94, 0x2e, 0x71,
-	0x8f, 0xa9, 0x4e, 0x4a, 0x18, 0x1e, 0x87, 0x9c, 0x15, 0x36, 0x35, 0x2b, 0x55, 0xc5, 0x0f, 0xad,
-	0x8c, 0x5d, 0xe7, 0x35, 0xd1, 0x39, 0x7a, 0x34, 0xe4, 0x7c, 0xcc, 0x9e, 0xab, 0xaa, 0x49, 0xf9,
-	0x70, 0x32, 0xa8, 0xe9, 0xce, 0x48, 0x35, 0xa9, 0x71, 0xea, 0xd8, 0x01, 0x8b, 0xe2, 0x3d, 0x93,
-	0x1a, 0xc4, 0xb1, 0x87, 0x58, 0x27, 0xee, 0x27, 0xe6, 0x08, 0x53, 0xcb, 0x43, 0x55, 0x5e, 0xc2,
-	0xc2, 0x46, 0x77, 0x4b, 0x59, 0xab, 0x6e, 0x5a, 0x78, 0xc2, 0x88, 0xd2, 0xa6, 0x3a, 0xb1, 0x19,
-	0x41, 0x1f, 0x5d, 0x19, 0x51, 0x1d, 0x58, 0xce, 0x40, 0x1d, 0x61, 0xc6, 0x89, 0xab, 0xb6, 0x5b,
-	0x9b, 0xcd, 0x4e, 0xb7, 0x59, 0xe3, 0x27, 0xbc, 0x9e, 0x78, 0x56, 0x5b, 0xad, 0x24, 0x24, 0x39,
-	0x59, 0xcf, 0xe3, 0xf1, 0xd8, 0xa2, 0xba, 0x18, 0x32, 0xea, 0x6b, 0xe6, 0xd8, 0xcf, 0xdf, 0xb3,
-	0x68, 0x1f, 0x43, 0x62, 0x7d, 0x75, 0x1d, 0xad, 0x43, 0x45, 0x23, 0x7c, 0xe2, 0xda, 0xc4, 0x50,
-	0xde, 0x0c, 0x89, 0xad, 0xf0, 0x21, 0x51, 0x5c, 0xc2, 0x9c, 0x89, 0xab, 0x13, 0xc5, 0x70, 0x08,
-	0x53, 0x6c, 0x87, 0x2b, 0xe4, 0x84, 0x32, 0x5e, 0x43, 0x29, 0x48, 0xbe, 0x95, 0xa5, 0xd4, 0xe7,
-	0x71, 0xbf, 0xdd, 0x83, 0x94, 0xf8, 0x45, 0x5e, 0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x2e,
-	0xc8, 0xd9, 0xa7, 0x0b, 0x00, 0x00,
+func (m *Filter) GetMinIsolates() int32 {
+	if m != nil {
+		return m.MinIsolates
+	}
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
+func (m *Filter) GetHideInsufficient() bool {
+	if m != nil {
+		return m.HideInsufficient
+	}
+	return false
+}
+
+func (m *Filter) GetDateFilter() *culture.DateFilter {
+	if m != nil {
+		return m.DateFilter
+	}
+	return nil
+}
+
+func (m *Filter) GetCalendarYear() int32 {
+	if m != nil {
+		return m.CalendarYear
+	}
+	return 0
+}
+
+func (m *Filter) GetMaxStalenessSec() int64 {
+	if m != nil {
+		return m.MaxStalenessSec
+	}
+	return 0
+}
+
+// Request to generate susceptibility trend of a pathogen against an antimicrobial
+type ResistanceTrendRequest struct {
+	Filter               *Filter       `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PathogenId           string        `protobuf:"bytes,2,opt,name=pathogen_id,json=pathogenId,proto3" json:"pathogen_id,omitempty"`
+	AntimicrobialId      string        `protobuf:"bytes,3,opt,name=antimicrobial_id,json=antimicrobialId,proto3" json:"antimicrobial_id,omitempty"`
+	Interval             TrendInterval `protobuf:"varint,4,opt,name=interval,proto3,enum=antibug.antibiogram.TrendInterval" json:"interval,omitempty"`
+	SignificanceLevel    float32       `protobuf:"fixed32,5,opt,name=significance_level,json=significanceLevel,proto3" json:"significance_level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ResistanceTrendRequest) Reset()         { *m = ResistanceTrendRequest{} }
+func (m *ResistanceTrendRequest) String() string { return proto.CompactTextString(m) }
+func (*ResistanceTrendRequest) ProtoMessage()    {}
+func (*ResistanceTrendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{13}
+}
+
+func (m *ResistanceTrendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResistanceTrendRequest.Unmarshal(m, b)
+}
+func (m *ResistanceTrendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResistanceTrendRequest.Marshal(b, m, deterministic)
+}
+func (m *ResistanceTrendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResistanceTrendRequest.Merge(m, src)
+}
+func (m *ResistanceTrendRequest) XXX_Size() int {
+	return xxx_messageInfo_ResistanceTrendRequest.Size(m)
+}
+func (m *ResistanceTrendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResistanceTrendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResistanceTrendRequest proto.InternalMessageInfo
+
+func (m *ResistanceTrendRequest) GetFilter() *Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ResistanceTrendRequest) GetPathogenId() string {
+	if m != nil {
+		return m.PathogenId
+	}
+	return ""
+}
+
+func (m *ResistanceTrendRequest) GetAntimicrobialId() string {
+	if m != nil {
+		return m.AntimicrobialId
+	}
+	return ""
+}
+
+func (m *ResistanceTrendRequest) GetInterval() TrendInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return TrendInterval_MONTHLY
+}
+
+func (m *ResistanceTrendRequest) GetSignificanceLevel() float32 {
+	if m != nil {
+		return m.SignificanceLevel
+	}
+	return 0
+}
+
+// TrendBucket is the susceptibility of the pair within a period of time
+type TrendBucket struct {
+	Period               string   `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	StartTimestampSec    int64    `protobuf:"varint,2,opt,name=start_timestamp_sec,json=startTimestampSec,proto3" json:"start_timestamp_sec,omitempty"`
+	EndTimestampSec      int64    `protobuf:"varint,3,opt,name=end_timestamp_sec,json=endTimestampSec,proto3" json:"end_timestamp_sec,omitempty"`
+	Isolates             int32    `protobuf:"varint,4,opt,name=isolates,proto3" json:"isolates,omitempty"`
+	IsolatesSusceptible  int32    `protobuf:"varint,5,opt,name=isolates_susceptible,json=isolatesSusceptible,proto3" json:"isolates_susceptible,omitempty"`
+	PercentSusceptible   float32  `protobuf:"fixed32,6,opt,name=percent_susceptible,json=percentSusceptible,proto3" json:"percent_susceptible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrendBucket) Reset()         { *m = TrendBucket{} }
+func (m *TrendBucket) String() string { return proto.CompactTextString(m) }
+func (*TrendBucket) ProtoMessage()    {}
+func (*TrendBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{14}
+}
+
+func (m *TrendBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrendBucket.Unmarshal(m, b)
+}
+func (m *TrendBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrendBucket.Marshal(b, m, deterministic)
+}
+func (m *TrendBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrendBucket.Merge(m, src)
+}
+func (m *TrendBucket) XXX_Size() int {
+	return xxx_messageInfo_TrendBucket.Size(m)
+}
+func (m *TrendBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrendBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrendBucket proto.InternalMessageInfo
+
+func (m *TrendBucket) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *TrendBucket) GetStartTimestampSec() int64 {
+	if m != nil {
+		return m.StartTimestampSec
+	}
+	return 0
+}
+
+func (m *TrendBucket) GetEndTimestampSec() int64 {
+	if m != nil {
+		return m.EndTimestampSec
+	}
+	return 0
+}
+
+func (m *TrendBucket) GetIsolates() int32 {
+	if m != nil {
+		return m.Isolates
+	}
+	return 0
+}
+
+func (m *TrendBucket) GetIsolatesSusceptible() int32 {
+	if m != nil {
+		return m.IsolatesSusceptible
+	}
+	return 0
+}
+
+func (m *TrendBucket) GetPercentSusceptible() float32 {
+	if m != nil {
+		return m.PercentSusceptible
+	}
+	return 0
+}
+
+// ResistanceTrend is the susceptibility of a pathogen against an antimicrobial over time
+type ResistanceTrend struct {
+	PathogenName         string         `protobuf:"bytes,1,opt,name=pathogen_name,json=pathogenName,proto3" json:"pathogen_name,omitempty"`
+	PathogenId           string         `protobuf:"bytes,2,opt,name=pathogen_id,json=pathogenId,proto3" json:"pathogen_id,omitempty"`
+	AntimicrobialName    string         `protobuf:"bytes,3,opt,name=antimicrobial_name,json=antimicrobialName,proto3" json:"antimicrobial_name,omitempty"`
+	AntimicrobialId      string         `protobuf:"bytes,4,opt,name=antimicrobial_id,json=antimicrobialId,proto3" json:"antimicrobial_id,omitempty"`
+	Interval             TrendInterval  `protobuf:"varint,5,opt,name=interval,proto3,enum=antibug.antibiogram.TrendInterval" json:"interval,omitempty"`
+	Buckets              []*TrendBucket `protobuf:"bytes,6,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	ChiSquare            float64        `protobuf:"fixed64,7,opt,name=chi_square,json=chiSquare,proto3" json:"chi_square,omitempty"`
+	PValue               float64        `protobuf:"fixed64,8,opt,name=p_value,json=pValue,proto3" json:"p_value,omitempty"`
+	Declining            bool           `protobuf:"varint,9,opt,name=declining,proto3" json:"declining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ResistanceTrend) Reset()         { *m = ResistanceTrend{} }
+func (m *ResistanceTrend) String() string { return proto.CompactTextString(m) }
+func (*ResistanceTrend) ProtoMessage()    {}
+func (*ResistanceTrend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{15}
+}
+
+func (m *ResistanceTrend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResistanceTrend.Unmarshal(m, b)
+}
+func (m *ResistanceTrend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResistanceTrend.Marshal(b, m, deterministic)
+}
+func (m *ResistanceTrend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResistanceTrend.Merge(m, src)
+}
+func (m *ResistanceTrend) XXX_Size() int {
+	return xxx_messageInfo_ResistanceTrend.Size(m)
+}
+func (m *ResistanceTrend) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResistanceTrend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResistanceTrend proto.InternalMessageInfo
+
+func (m *ResistanceTrend) GetPathogenName() string {
+	if m != nil {
+		return m.PathogenName
+	}
+	return ""
+}
+
+func (m *ResistanceTrend) GetPathogenId() string {
+	if m != nil {
+		return m.PathogenId
+	}
+	return ""
+}
+
+func (m *ResistanceTrend) GetAntimicrobialName() string {
+	if m != nil {
+		return m.AntimicrobialName
+	}
+	return ""
+}
+
+func (m *ResistanceTrend) GetAntimicrobialId() string {
+	if m != nil {
+		return m.AntimicrobialId
+	}
+	return ""
+}
+
+func (m *ResistanceTrend) GetInterval() TrendInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return TrendInterval_MONTHLY
+}
+
+func (m *ResistanceTrend) GetBuckets() []*TrendBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *ResistanceTrend) GetChiSquare() float64 {
+	if m != nil {
+		return m.ChiSquare
+	}
+	return 0
+}
+
+func (m *ResistanceTrend) GetPValue() float64 {
+	if m != nil {
+		return m.PValue
+	}
+	return 0
+}
+
+func (m *ResistanceTrend) GetDeclining() bool {
+	if m != nil {
+		return m.Declining
+	}
+	return false
+}
+
+// Request to generate prevalence of resistant isolates per facility over time
+type ResistancePrevalenceRequest struct {
+	Filter               *Filter       `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	PathogenIds          []string      `protobuf:"bytes,2,rep,name=pathogen_ids,json=pathogenIds,proto3" json:"pathogen_ids,omitempty"`
+	Interval             TrendInterval `protobuf:"varint,3,opt,name=interval,proto3,enum=antibug.antibiogram.TrendInterval" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ResistancePrevalenceRequest) Reset()         { *m = ResistancePrevalenceRequest{} }
+func (m *ResistancePrevalenceRequest) String() string { return proto.CompactTextString(m) }
+func (*ResistancePrevalenceRequest) ProtoMessage()    {}
+func (*ResistancePrevalenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{16}
+}
+
+func (m *ResistancePrevalenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResistancePrevalenceRequest.Unmarshal(m, b)
+}
+func (m *ResistancePrevalenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResistancePrevalenceRequest.Marshal(b, m, deterministic)
+}
+func (m *ResistancePrevalenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResistancePrevalenceRequest.Merge(m, src)
+}
+func (m *ResistancePrevalenceRequest) XXX_Size() int {
+	return xxx_messageInfo_ResistancePrevalenceRequest.Size(m)
+}
+func (m *ResistancePrevalenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResistancePrevalenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResistancePrevalenceRequest proto.InternalMessageInfo
+
+func (m *ResistancePrevalenceRequest) GetFilter() *Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ResistancePrevalenceRequest) GetPathogenIds() []string {
+	if m != nil {
+		return m.PathogenIds
+	}
+	return nil
+}
+
+func (m *ResistancePrevalenceRequest) GetInterval() TrendInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return TrendInterval_MONTHLY
+}
+
+// PrevalenceBucket is the number of isolates in each resistance class for a facility within a period.
+// MDR counts include XDR and PDR isolates and XDR counts include PDR isolates.
+type PrevalenceBucket struct {
+	HospitalId           string   `protobuf:"bytes,1,opt,name=hospital_id,json=hospitalId,proto3" json:"hospital_id,omitempty"`
+	Period               string   `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	StartTimestampSec    int64    `protobuf:"varint,3,opt,name=start_timestamp_sec,json=startTimestampSec,proto3" json:"start_timestamp_sec,omitempty"`
+	EndTimestampSec      int64    `protobuf:"varint,4,opt,name=end_timestamp_sec,json=endTimestampSec,proto3" json:"end_timestamp_sec,omitempty"`
+	Isolates             int32    `protobuf:"varint,5,opt,name=isolates,proto3" json:"isolates,omitempty"`
+	IsolatesClassified   int32    `protobuf:"varint,6,opt,name=isolates_classified,json=isolatesClassified,proto3" json:"isolates_classified,omitempty"`
+	IsolatesMdr          int32    `protobuf:"varint,7,opt,name=isolates_mdr,json=isolatesMdr,proto3" json:"isolates_mdr,omitempty"`
+	IsolatesXdr          int32    `protobuf:"varint,8,opt,name=isolates_xdr,json=isolatesXdr,proto3" json:"isolates_xdr,omitempty"`
+	IsolatesPdr          int32    `protobuf:"varint,9,opt,name=isolates_pdr,json=isolatesPdr,proto3" json:"isolates_pdr,omitempty"`
+	PercentMdr           float32  `protobuf:"fixed32,10,opt,name=percent_mdr,json=percentMdr,proto3" json:"percent_mdr,omitempty"`
+	PercentXdr           float32  `protobuf:"fixed32,11,opt,name=percent_xdr,json=percentXdr,proto3" json:"percent_xdr,omitempty"`
+	PercentPdr           float32  `protobuf:"fixed32,12,opt,name=percent_pdr,json=percentPdr,proto3" json:"percent_pdr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrevalenceBucket) Reset()         { *m = PrevalenceBucket{} }
+func (m *PrevalenceBucket) String() string { return proto.CompactTextString(m) }
+func (*PrevalenceBucket) ProtoMessage()    {}
+func (*PrevalenceBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{17}
+}
+
+func (m *PrevalenceBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrevalenceBucket.Unmarshal(m, b)
+}
+func (m *PrevalenceBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrevalenceBucket.Marshal(b, m, deterministic)
+}
+func (m *PrevalenceBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrevalenceBucket.Merge(m, src)
+}
+func (m *PrevalenceBucket) XXX_Size() int {
+	return xxx_messageInfo_PrevalenceBucket.Size(m)
+}
+func (m *PrevalenceBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrevalenceBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrevalenceBucket proto.InternalMessageInfo
+
+func (m *PrevalenceBucket) GetHospitalId() string {
+	if m != nil {
+		return m.HospitalId
+	}
+	return ""
+}
+
+func (m *PrevalenceBucket) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *PrevalenceBucket) GetStartTimestampSec() int64 {
+	if m != nil {
+		return m.StartTimestampSec
+	}
+	return 0
+}
+
+func (m *PrevalenceBucket) GetEndTimestampSec() int64 {
+	if m != nil {
+		return m.EndTimestampSec
+	}
+	return 0
+}
+
+func (m *PrevalenceBucket) GetIsolates() int32 {
+	if m != nil {
+		return m.Isolates
+	}
+	return 0
+}
+
+func (m *PrevalenceBucket) GetIsolatesClassified() int32 {
+	if m != nil {
+		return m.IsolatesClassified
+	}
+	return 0
+}
+
+func (m *PrevalenceBucket) GetIsolatesMdr() int32 {
+	if m != nil {
+		return m.IsolatesMdr
+	}
+	return 0
+}
+
+func (m *PrevalenceBucket) GetIsolatesXdr() int32 {
+	if m != nil {
+		return m.IsolatesXdr
+	}
+	return 0
+}
+
+func (m *PrevalenceBucket) GetIsolatesPdr() int32 {
+	if m != nil {
+		return m.IsolatesPdr
+	}
+	return 0
+}
+
+func (m *PrevalenceBucket) GetPercentMdr() float32 {
+	if m != nil {
+		return m.PercentMdr
+	}
+	return 0
+}
+
+func (m *PrevalenceBucket) GetPercentXdr() float32 {
+	if m != nil {
+		return m.PercentXdr
+	}
+	return 0
+}
+
+func (m *PrevalenceBucket) GetPercentPdr() float32 {
+	if m != nil {
+		return m.PercentPdr
+	}
+	return 0
+}
+
+// ResistancePrevalence is prevalence of resistant isolates per facility over time
+type ResistancePrevalence struct {
+	Interval             TrendInterval       `protobuf:"varint,1,opt,name=interval,proto3,enum=antibug.antibiogram.TrendInterval" json:"interval,omitempty"`
+	Buckets              []*PrevalenceBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ResistancePrevalence) Reset()         { *m = ResistancePrevalence{} }
+func (m *ResistancePrevalence) String() string { return proto.CompactTextString(m) }
+func (*ResistancePrevalence) ProtoMessage()    {}
+func (*ResistancePrevalence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{18}
+}
+
+func (m *ResistancePrevalence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResistancePrevalence.Unmarshal(m, b)
+}
+func (m *ResistancePrevalence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResistancePrevalence.Marshal(b, m, deterministic)
+}
+func (m *ResistancePrevalence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResistancePrevalence.Merge(m, src)
+}
+func (m *ResistancePrevalence) XXX_Size() int {
+	return xxx_messageInfo_ResistancePrevalence.Size(m)
+}
+func (m *ResistancePrevalence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResistancePrevalence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResistancePrevalence proto.InternalMessageInfo
+
+func (m *ResistancePrevalence) GetInterval() TrendInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return TrendInterval_MONTHLY
+}
+
+func (m *ResistancePrevalence) GetBuckets() []*PrevalenceBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// ViewCacheStats contains cache hits and misses of an antibiogram view
+type ViewCacheStats struct {
+	View                 string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	Hits                 int64    `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses               int64    `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	HitRatio             float32  `protobuf:"fixed32,4,opt,name=hit_ratio,json=hitRatio,proto3" json:"hit_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ViewCacheStats) Reset()         { *m = ViewCacheStats{} }
+func (m *ViewCacheStats) String() string { return proto.CompactTextString(m) }
+func (*ViewCacheStats) ProtoMessage()    {}
+func (*ViewCacheStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{19}
+}
+
+func (m *ViewCacheStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ViewCacheStats.Unmarshal(m, b)
+}
+func (m *ViewCacheStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ViewCacheStats.Marshal(b, m, deterministic)
+}
+func (m *ViewCacheStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewCacheStats.Merge(m, src)
+}
+func (m *ViewCacheStats) XXX_Size() int {
+	return xxx_messageInfo_ViewCacheStats.Size(m)
+}
+func (m *ViewCacheStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewCacheStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ViewCacheStats proto.InternalMessageInfo
+
+func (m *ViewCacheStats) GetView() string {
+	if m != nil {
+		return m.View
+	}
+	return ""
+}
+
+func (m *ViewCacheStats) GetHits() int64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *ViewCacheStats) GetMisses() int64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *ViewCacheStats) GetHitRatio() float32 {
+	if m != nil {
+		return m.HitRatio
+	}
+	return 0
+}
+
+// CacheStats contains cache hits and misses of antibiograms
+type CacheStats struct {
+	Views                []*ViewCacheStats `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+	Hits                 int64             `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses               int64             `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+	HitRatio             float32           `protobuf:"fixed32,4,opt,name=hit_ratio,json=hitRatio,proto3" json:"hit_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CacheStats) Reset()         { *m = CacheStats{} }
+func (m *CacheStats) String() string { return proto.CompactTextString(m) }
+func (*CacheStats) ProtoMessage()    {}
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51c4d2d40a40cad1, []int{20}
+}
+
+func (m *CacheStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CacheStats.Unmarshal(m, b)
+}
+func (m *CacheStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CacheStats.Marshal(b, m, deterministic)
+}
+func (m *CacheStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheStats.Merge(m, src)
+}
+func (m *CacheStats) XXX_Size() int {
+	return xxx_messageInfo_CacheStats.Size(m)
+}
+func (m *CacheStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheStats proto.InternalMessageInfo
+
+func (m *CacheStats) GetViews() []*ViewCacheStats {
+	if m != nil {
+		return m.Views
+	}
+	return nil
+}
+
+func (m *CacheStats) GetHits() int64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *CacheStats) GetMisses() int64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *CacheStats) GetHitRatio() float32 {
+	if m != nil {
+		return m.HitRatio
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("antibug.antibiogram.Duration", Duration_name, Duration_value)
+	proto.RegisterEnum("antibug.antibiogram.RegionScope", RegionScope_name, RegionScope_value)
+	proto.RegisterEnum("antibug.antibiogram.Gender", Gender_name, Gender_value)
+	proto.RegisterEnum("antibug.antibiogram.AntibiogramMode", AntibiogramMode_name, AntibiogramMode_value)
+	proto.RegisterEnum("antibug.antibiogram.TrendInterval", TrendInterval_name, TrendInterval_value)
+	proto.RegisterType((*LabelStat)(nil), "antibug.antibiogram.LabelStat")
+	proto.RegisterType((*PathogenSusceptibility)(nil), "antibug.antibiogram.PathogenSusceptibility")
+	proto.RegisterType((*AntimicrobialSusceptibility)(nil), "antibug.antibiogram.AntimicrobialSusceptibility")
+	proto.RegisterType((*PathogenAntibiogram)(nil), "antibug.antibiogram.PathogenAntibiogram")
+	proto.RegisterType((*PathogensAntibiogram)(nil), "antibug.antibiogram.PathogensAntibiogram")
+	proto.RegisterType((*AntimicrobialAntibiogram)(nil), "antibug.antibiogram.AntimicrobialAntibiogram")
+	proto.RegisterType((*AntimicrobialsAntibiogram)(nil), "antibug.antibiogram.AntimicrobialsAntibiogram")
+	proto.RegisterType((*AntibiogramCell)(nil), "antibug.antibiogram.AntibiogramCell")
+	proto.RegisterType((*AntibiogramRow)(nil), "antibug.antibiogram.AntibiogramRow")
+	proto.RegisterType((*AntibiogramMatrix)(nil), "antibug.antibiogram.AntibiogramMatrix")
+	proto.RegisterType((*Value)(nil), "antibug.antibiogram.Value")
+	proto.RegisterType((*AdvancedFilter)(nil), "antibug.antibiogram.AdvancedFilter")
+	proto.RegisterType((*Filter)(nil), "antibug.antibiogram.Filter")
+	proto.RegisterType((*ResistanceTrendRequest)(nil), "antibug.antibiogram.ResistanceTrendRequest")
+	proto.RegisterType((*TrendBucket)(nil), "antibug.antibiogram.TrendBucket")
+	proto.RegisterType((*ResistanceTrend)(nil), "antibug.antibiogram.ResistanceTrend")
+	proto.RegisterType((*ResistancePrevalenceRequest)(nil), "antibug.antibiogram.ResistancePrevalenceRequest")
+	proto.RegisterType((*PrevalenceBucket)(nil), "antibug.antibiogram.PrevalenceBucket")
+	proto.RegisterType((*ResistancePrevalence)(nil), "antibug.antibiogram.ResistancePrevalence")
+	proto.RegisterType((*ViewCacheStats)(nil), "antibug.antibiogram.ViewCacheStats")
+	proto.RegisterType((*CacheStats)(nil), "antibug.antibiogram.CacheStats")
+}
+
+func init() { proto.RegisterFile("antibiogram.proto", fileDescriptor_51c4d2d40a40cad1) }
+
+var fileDescriptor_51c4d2d40a40cad1 = []byte{
+	// 2299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6f, 0xdb, 0xc8,
+	0xf9, 0x0f, 0xf5, 0x66, 0xeb, 0x91, 0x2d, 0xcb, 0x63, 0xc7, 0xab, 0xb5, 0x93, 0x7f, 0x14, 0x26,
+	0xd9, 0x38, 0x4e, 0x2c, 0x25, 0x4e, 0x80, 0xfd, 0x27, 0x6d, 0xba, 0x95, 0x5f, 0xe2, 0xa8, 0x90,
+	0x1d, 0x67, 0xa4, 0xec, 0xc6, 0x45, 0x01, 0x61, 0x4c, 0x8e, 0xa5, 0xd9, 0x52, 0x24, 0x97, 0x33,
+	0xf2, 0xcb, 0xb1, 0x2d, 0x0a, 0x14, 0x28, 0x50, 0x2c, 0xda, 0x43, 0x81, 0x05, 0x7a, 0x6b, 0xd1,
+	0x5b, 0x4f, 0xed, 0xa1, 0x3d, 0xf5, 0xda, 0x5b, 0xb1, 0xfd, 0x0a, 0x05, 0x7a, 0x6d, 0xbf, 0x41,
+	0xc1, 0x21, 0x29, 0x91, 0x32, 0x65, 0xd9, 0xdb, 0x6c, 0x4f, 0x3d, 0x89, 0xf3, 0xbc, 0xcd, 0x8f,
+	0xcf, 0xcb, 0x3c, 0xcf, 0x50, 0x30, 0x4b, 0x4c, 0xc1, 0x0e, 0x98, 0xd5, 0x76, 0x48, 0xb7, 0x6c,
+	0x3b, 0x96, 0xb0, 0xd0, 0x9c, 0x24, 0xf5, 0xda, 0xe5, 0x10, 0x6b, 0xf1, 0x5a, 0xdb, 0xb2, 0xda,
+	0x06, 0xad, 0x10, 0x9b, 0x55, 0x88, 0x69, 0x5a, 0x82, 0x08, 0x66, 0x99, 0xdc, 0x53, 0x59, 0x5c,
+	0xf2, 0xb9, 0x72, 0x75, 0xd0, 0x3b, 0xac, 0xd0, 0xae, 0x2d, 0x4e, 0x7d, 0xe6, 0x03, 0xf9, 0xa3,
+	0xad, 0xb6, 0xa9, 0xb9, 0xca, 0x8f, 0x49, 0xbb, 0x4d, 0x9d, 0x8a, 0x65, 0x4b, 0xf5, 0x18, 0x53,
+	0xd3, 0x5a, 0xcf, 0x10, 0x3d, 0x87, 0x7a, 0x4b, 0xd5, 0x82, 0x6c, 0x9d, 0x1c, 0x50, 0xa3, 0x21,
+	0x88, 0x40, 0x0f, 0x20, 0x6d, 0xb8, 0x8b, 0xa2, 0x52, 0x52, 0x96, 0xf3, 0x6b, 0x0b, 0xe5, 0x00,
+	0x69, 0xa0, 0x23, 0x45, 0xb1, 0x27, 0x84, 0x16, 0x61, 0x92, 0x71, 0xcb, 0x20, 0x82, 0xf2, 0x62,
+	0xa2, 0xa4, 0x2c, 0xa7, 0x71, 0x7f, 0x8d, 0x8a, 0x30, 0x61, 0x53, 0x47, 0xa3, 0xa6, 0x28, 0x26,
+	0x4b, 0xca, 0x72, 0x02, 0x07, 0x4b, 0xf5, 0x2f, 0x49, 0x58, 0xd8, 0x23, 0xa2, 0x63, 0xb5, 0xa9,
+	0xd9, 0xe8, 0x71, 0x8d, 0xda, 0xae, 0x13, 0x0c, 0x26, 0x4e, 0xd1, 0x2a, 0x20, 0x77, 0xc3, 0x2e,
+	0xd3, 0x1c, 0xeb, 0x80, 0x11, 0xa3, 0x65, 0x92, 0x2e, 0x95, 0x58, 0xb2, 0x78, 0x36, 0xc2, 0xd9,
+	0x25, 0x5d, 0x8a, 0xee, 0x41, 0x21, 0x2a, 0xce, 0x74, 0x89, 0x23, 0x8b, 0x67, 0x22, 0xf4, 0x9a,
+	0x1e, 0x81, 0x9a, 0x1c, 0x82, 0xfa, 0x08, 0xe6, 0x79, 0x04, 0x47, 0x8b, 0x6b, 0x96, 0x43, 0x8b,
+	0x29, 0x89, 0x7b, 0x2e, 0xca, 0x6b, 0xb8, 0xac, 0x81, 0x9f, 0xd2, 0x17, 0xf1, 0xd3, 0x23, 0x98,
+	0x0f, 0x36, 0x6b, 0xf5, 0xad, 0x19, 0xb4, 0x98, 0x91, 0x40, 0xe6, 0x02, 0x5e, 0x63, 0xc0, 0x42,
+	0x15, 0x98, 0xf3, 0xfd, 0x15, 0xd1, 0x98, 0x90, 0x90, 0x90, 0xcf, 0x0a, 0x2b, 0x3c, 0x86, 0xab,
+	0xcc, 0xe4, 0xbd, 0xc3, 0x43, 0xa6, 0x31, 0x57, 0xab, 0xff, 0xb6, 0x93, 0x25, 0x65, 0x79, 0x12,
+	0xcf, 0x87, 0x99, 0xb5, 0xe0, 0xcd, 0x3f, 0x82, 0x9c, 0x44, 0xd8, 0xe2, 0x82, 0x08, 0x5e, 0xcc,
+	0x96, 0x92, 0xcb, 0xb9, 0xb5, 0xff, 0x2b, 0xc7, 0xa4, 0x67, 0xb9, 0x9f, 0x23, 0x18, 0x8c, 0xe0,
+	0x91, 0xab, 0x7f, 0x4a, 0xc2, 0x52, 0x35, 0xec, 0xea, 0xa1, 0x80, 0xde, 0x82, 0x69, 0xdb, 0x0f,
+	0x75, 0x38, 0x96, 0x53, 0x01, 0x51, 0x86, 0xf1, 0x06, 0xe4, 0xfa, 0x42, 0xfd, 0x08, 0x42, 0x40,
+	0xfa, 0x5f, 0xf0, 0xbe, 0xae, 0xe0, 0xfd, 0x5e, 0x81, 0xb9, 0xa0, 0x10, 0xab, 0x03, 0xe9, 0x77,
+	0x14, 0xb4, 0x4f, 0xa0, 0x10, 0x71, 0x3e, 0xa3, 0xbc, 0x98, 0x92, 0x18, 0xef, 0xc7, 0x62, 0x8c,
+	0x3f, 0x12, 0xf0, 0x19, 0x23, 0xaa, 0x0e, 0xf3, 0x81, 0x2c, 0x0f, 0xc3, 0xae, 0xc3, 0x54, 0xc8,
+	0x1e, 0x2f, 0x2a, 0x72, 0xb3, 0xe5, 0x73, 0x37, 0x0b, 0xe9, 0xe3, 0x88, 0xb6, 0xfa, 0xa5, 0x02,
+	0xc5, 0x48, 0x66, 0x87, 0xb7, 0xfa, 0xfa, 0xce, 0xa9, 0xef, 0x8d, 0xf4, 0xda, 0xc3, 0xd8, 0x17,
+	0x39, 0xa7, 0xf8, 0x62, 0x5c, 0x67, 0xc2, 0xfb, 0x11, 0x85, 0x88, 0xff, 0x5e, 0x0f, 0xf9, 0x2f,
+	0x21, 0xb7, 0x5d, 0x1d, 0xbf, 0xed, 0x68, 0x27, 0xfe, 0x4b, 0x81, 0x99, 0x10, 0x77, 0x83, 0x1a,
+	0x46, 0xac, 0x33, 0x94, 0xf1, 0x87, 0x76, 0xe2, 0x6c, 0xdd, 0xc7, 0x96, 0x65, 0xf2, 0xd2, 0x65,
+	0x99, 0xba, 0x7c, 0x59, 0xa6, 0x47, 0x97, 0xa5, 0xfa, 0x3b, 0x05, 0xf2, 0x61, 0x8f, 0x58, 0xc7,
+	0xff, 0x85, 0x53, 0xf0, 0x19, 0xa4, 0x35, 0x6a, 0x18, 0x41, 0xae, 0xdc, 0x1e, 0x19, 0xb4, 0x50,
+	0x24, 0xb0, 0xa7, 0xa2, 0x7e, 0xae, 0xc0, 0x6c, 0x88, 0xb5, 0x43, 0x84, 0xc3, 0x4e, 0xd0, 0x3a,
+	0xe4, 0x23, 0xe1, 0x08, 0xea, 0x69, 0x31, 0xd6, 0xf4, 0xc7, 0xc4, 0xe8, 0x51, 0x3c, 0xa4, 0x81,
+	0x3e, 0x84, 0x94, 0x63, 0x1d, 0x07, 0x99, 0x74, 0x6b, 0x1c, 0x28, 0x6c, 0x1d, 0x63, 0xa9, 0xa0,
+	0xde, 0x87, 0xb4, 0xb4, 0x88, 0x10, 0xa4, 0x42, 0x0e, 0x93, 0xcf, 0x28, 0x0f, 0x89, 0xbe, 0x7f,
+	0x12, 0x4c, 0x57, 0xff, 0x99, 0x80, 0x7c, 0x55, 0x3f, 0x22, 0xa6, 0x46, 0xf5, 0x17, 0xcc, 0x10,
+	0xd4, 0x41, 0x8f, 0x21, 0xd3, 0xa6, 0xa6, 0x4e, 0x1d, 0x7f, 0x8e, 0x59, 0x8a, 0xdd, 0x7a, 0x5b,
+	0x8a, 0x60, 0x5f, 0x14, 0x95, 0x60, 0x8a, 0xb4, 0x69, 0xab, 0xcb, 0xcc, 0x96, 0x4e, 0x4e, 0xbd,
+	0x8c, 0x4b, 0x62, 0x20, 0x6d, 0xba, 0xc3, 0xcc, 0x4d, 0x72, 0xca, 0xfb, 0x12, 0xe4, 0xc4, 0x93,
+	0x48, 0x0e, 0x24, 0xc8, 0x89, 0x94, 0x98, 0x87, 0xf4, 0x31, 0x71, 0x74, 0x2f, 0x0e, 0x59, 0xec,
+	0x2d, 0xd0, 0x73, 0xc8, 0xe9, 0xd4, 0x26, 0x8e, 0xe8, 0x52, 0x53, 0xb8, 0xd9, 0x93, 0x8c, 0x60,
+	0x0a, 0xda, 0xce, 0x66, 0x5f, 0x06, 0x87, 0xe5, 0xd1, 0x77, 0xa0, 0x60, 0x13, 0x21, 0x33, 0x90,
+	0x53, 0x21, 0x98, 0xd9, 0xe6, 0xc5, 0x8c, 0xb4, 0x71, 0xe3, 0x8c, 0x8d, 0x3d, 0x4f, 0xb0, 0xe1,
+	0xc9, 0xe1, 0x19, 0x3b, 0xb2, 0xe6, 0x68, 0x13, 0xf2, 0xdc, 0xa6, 0x1a, 0xeb, 0x52, 0xb3, 0x25,
+	0x4e, 0x6d, 0xca, 0x8b, 0x13, 0xd2, 0xd2, 0xf5, 0x33, 0x96, 0x1a, 0xbe, 0x58, 0xf3, 0xd4, 0xa6,
+	0x78, 0x9a, 0x87, 0x56, 0x5c, 0xfd, 0x47, 0x0a, 0x32, 0xbe, 0xab, 0xd7, 0xdd, 0xdc, 0xe6, 0xa2,
+	0xa5, 0xf7, 0x1c, 0x39, 0x65, 0xfa, 0x1e, 0xbf, 0x1e, 0xeb, 0xf1, 0x4d, 0x5f, 0xc8, 0x4d, 0x7d,
+	0x2e, 0x82, 0x15, 0xda, 0x80, 0x29, 0x87, 0xb6, 0x99, 0x65, 0xba, 0xbd, 0xdb, 0xa6, 0xd2, 0xf3,
+	0xf9, 0xb5, 0x52, 0xac, 0x09, 0x2c, 0x05, 0x1b, 0xae, 0x1c, 0xce, 0x39, 0x83, 0x05, 0x7a, 0x0e,
+	0x53, 0xcc, 0xb4, 0x7b, 0xa2, 0x75, 0xe4, 0x66, 0x8e, 0x1b, 0x9c, 0x71, 0xe9, 0x9a, 0x93, 0xf2,
+	0xf2, 0x99, 0xa3, 0x9b, 0x30, 0x25, 0x37, 0x0f, 0xd4, 0xbd, 0x00, 0xe6, 0x24, 0xcd, 0x17, 0x59,
+	0x84, 0x49, 0xe2, 0xe7, 0x99, 0x7f, 0x02, 0xf4, 0xd7, 0xe8, 0x39, 0x4c, 0xf8, 0xcf, 0x72, 0x30,
+	0x18, 0x99, 0xed, 0x91, 0x3c, 0xc5, 0x81, 0x0e, 0xfa, 0x7f, 0x48, 0x75, 0x2d, 0xdd, 0x1b, 0x11,
+	0xf2, 0xe3, 0xcb, 0x77, 0xc7, 0xd2, 0x29, 0x96, 0x1a, 0x2e, 0x6e, 0x37, 0x63, 0x23, 0x13, 0x43,
+	0x1a, 0xe7, 0xba, 0xcc, 0xec, 0x0f, 0x0a, 0xf7, 0x61, 0xb6, 0xc3, 0x74, 0xda, 0x0a, 0x1f, 0x57,
+	0xc5, 0xac, 0x7c, 0x81, 0x82, 0xcb, 0xa8, 0x85, 0xe8, 0xe8, 0x9b, 0x90, 0xd3, 0x89, 0xa0, 0xad,
+	0x43, 0x89, 0xb0, 0x08, 0xf2, 0x65, 0x62, 0x72, 0x95, 0x08, 0xea, 0xbf, 0x04, 0xe8, 0xfd, 0x67,
+	0xf7, 0xa4, 0xd3, 0x88, 0x41, 0x4d, 0x9d, 0x38, 0xad, 0x53, 0x4a, 0x9c, 0x62, 0x4e, 0xc2, 0x99,
+	0x0a, 0x88, 0xfb, 0x94, 0x38, 0x68, 0x05, 0x66, 0xdd, 0x12, 0xe2, 0xc2, 0x25, 0x52, 0xce, 0x5b,
+	0x9c, 0x6a, 0xc5, 0x29, 0x59, 0x4b, 0x33, 0x5d, 0x72, 0xd2, 0x08, 0xe8, 0x0d, 0xaa, 0xa9, 0x3f,
+	0x49, 0xc0, 0x02, 0xa6, 0x9c, 0x71, 0xe1, 0xfa, 0xa9, 0xe9, 0x50, 0x53, 0xc7, 0xf4, 0xb3, 0x1e,
+	0xe5, 0xc2, 0x2d, 0x72, 0x1f, 0xa4, 0x32, 0x04, 0x32, 0xec, 0x35, 0x1f, 0xa4, 0x2f, 0x3a, 0xfe,
+	0x94, 0x8d, 0x6b, 0x4f, 0xc9, 0xf8, 0xf6, 0xf4, 0x2d, 0x98, 0x64, 0xa6, 0xa0, 0xce, 0x11, 0x31,
+	0x64, 0x13, 0xc9, 0xaf, 0xa9, 0xb1, 0x10, 0x24, 0xea, 0x9a, 0x2f, 0x89, 0xfb, 0x3a, 0xee, 0x14,
+	0xc1, 0x59, 0xdb, 0x64, 0x87, 0x4c, 0x73, 0x5f, 0xae, 0x65, 0xd0, 0x23, 0x7f, 0x28, 0x4d, 0xe0,
+	0xd9, 0x30, 0xa7, 0xee, 0x32, 0xd4, 0x1f, 0x24, 0x20, 0x27, 0x4d, 0xad, 0xf7, 0xb4, 0xef, 0x53,
+	0x81, 0x16, 0x20, 0x63, 0x53, 0x87, 0x59, 0x41, 0xfb, 0xf4, 0x57, 0xa8, 0x0c, 0x73, 0x5c, 0x10,
+	0x47, 0xb4, 0x04, 0xeb, 0x52, 0x2e, 0x48, 0xd7, 0x96, 0x0e, 0xf6, 0x8e, 0xb3, 0x59, 0xc9, 0x6a,
+	0x06, 0x9c, 0x06, 0xd5, 0xdc, 0x70, 0x50, 0x53, 0x1f, 0x92, 0xf6, 0x8e, 0xb6, 0x19, 0x6a, 0xea,
+	0x11, 0xd9, 0x70, 0x0f, 0x4a, 0x5d, 0xb0, 0x23, 0xa7, 0x2f, 0xdd, 0x91, 0x33, 0xa3, 0x3a, 0xb2,
+	0xfa, 0xa3, 0x24, 0xcc, 0x0c, 0xa5, 0xc3, 0x3b, 0xea, 0xae, 0xf1, 0x23, 0x5d, 0xf2, 0x32, 0x23,
+	0x5d, 0x6a, 0x7c, 0x9a, 0xa4, 0xbf, 0x42, 0x9a, 0x3c, 0x83, 0x89, 0x03, 0x19, 0x71, 0xef, 0xd4,
+	0xcf, 0xad, 0x95, 0x46, 0xab, 0x7b, 0xa9, 0x81, 0x03, 0x05, 0x74, 0x1d, 0x40, 0xeb, 0xb0, 0x16,
+	0xff, 0xac, 0x47, 0x1c, 0xef, 0x74, 0x51, 0x70, 0x56, 0xeb, 0xb0, 0x86, 0x24, 0xa0, 0xf7, 0x60,
+	0xc2, 0xf6, 0x0e, 0x3c, 0x79, 0x6e, 0x28, 0x38, 0x63, 0x7b, 0x7d, 0xf7, 0x1a, 0x64, 0x75, 0xaa,
+	0x19, 0xcc, 0x64, 0x66, 0xdb, 0x3f, 0x2a, 0x06, 0x04, 0xf5, 0x0f, 0x0a, 0x2c, 0x0d, 0xa2, 0xb0,
+	0xe7, 0xd0, 0x23, 0xb7, 0x62, 0x35, 0xfa, 0x1f, 0x55, 0xe6, 0x4d, 0x98, 0x0a, 0x45, 0xc8, 0x1b,
+	0x1a, 0xb2, 0x38, 0x37, 0x08, 0x11, 0x8f, 0x78, 0x32, 0x79, 0x79, 0x4f, 0xaa, 0x7f, 0x4e, 0x42,
+	0x61, 0x80, 0xd6, 0x2f, 0xa3, 0x1b, 0x90, 0xeb, 0x58, 0xdc, 0x66, 0x22, 0x3c, 0x8a, 0x42, 0x40,
+	0xaa, 0xe9, 0xa1, 0x3a, 0x4b, 0x5c, 0xa4, 0xce, 0x92, 0x97, 0xaa, 0xb3, 0xd4, 0xf8, 0x3a, 0x4b,
+	0x0f, 0xd5, 0x59, 0x05, 0xfa, 0xb5, 0xd4, 0xd2, 0x0c, 0xc2, 0x39, 0x3b, 0x64, 0x54, 0xf7, 0xef,
+	0xa3, 0x28, 0x60, 0x6d, 0xf4, 0x39, 0xae, 0x67, 0xfb, 0x0a, 0x5d, 0xdd, 0x91, 0x69, 0x90, 0xc6,
+	0xb9, 0x80, 0xb6, 0xa3, 0x3b, 0x11, 0x91, 0x13, 0xdd, 0x09, 0xba, 0x48, 0x40, 0x7b, 0x3b, 0x24,
+	0x62, 0xeb, 0x4e, 0x31, 0x1b, 0x15, 0xd9, 0xd3, 0xbd, 0xc3, 0xd5, 0x2f, 0xe7, 0xae, 0xee, 0xf5,
+	0x8e, 0x04, 0x06, 0x9f, 0xb4, 0x13, 0x15, 0x38, 0xd1, 0xbd, 0xe6, 0x30, 0x10, 0x78, 0x1b, 0x15,
+	0x70, 0xf7, 0x98, 0x8a, 0x08, 0xec, 0xe9, 0x8e, 0xfa, 0x4b, 0x05, 0xe6, 0xe3, 0x52, 0x2f, 0x92,
+	0x1b, 0xca, 0x57, 0xa8, 0xb2, 0x8f, 0x06, 0x55, 0xe6, 0x8d, 0xab, 0x77, 0xe2, 0x2f, 0x8e, 0x43,
+	0xe9, 0xd3, 0x2f, 0x35, 0xb5, 0x0b, 0xf9, 0x8f, 0x19, 0x3d, 0xde, 0x20, 0x5a, 0x87, 0xca, 0xfb,
+	0xb5, 0x3b, 0xbc, 0x1e, 0x31, 0x7a, 0x1c, 0x0c, 0xaf, 0xee, 0xb3, 0x4b, 0xeb, 0x30, 0x11, 0x0c,
+	0x97, 0xf2, 0xd9, 0x4d, 0xb0, 0x2e, 0xe3, 0x9c, 0x06, 0x03, 0xa5, 0xbf, 0x42, 0x4b, 0x90, 0xed,
+	0x30, 0xd1, 0x92, 0x43, 0x92, 0x7f, 0x4b, 0x99, 0xec, 0x30, 0x81, 0xdd, 0xb5, 0x3b, 0xb5, 0x43,
+	0x68, 0xaf, 0xa7, 0x90, 0x76, 0xed, 0x07, 0x53, 0x7a, 0xfc, 0xf4, 0x11, 0xc5, 0x87, 0x3d, 0x8d,
+	0x77, 0x06, 0x69, 0xe5, 0x37, 0x0a, 0x4c, 0xf6, 0x67, 0xba, 0x39, 0x98, 0xd9, 0xab, 0x36, 0x9a,
+	0xad, 0x46, 0xed, 0x6d, 0x6b, 0xe7, 0xd5, 0x6e, 0xf3, 0x65, 0xa3, 0x70, 0x05, 0x21, 0xc8, 0x4b,
+	0xe2, 0xab, 0xdd, 0xad, 0xd6, 0xfe, 0x56, 0x15, 0x37, 0x0a, 0x4a, 0x9f, 0xd6, 0xfc, 0xe4, 0x95,
+	0x4f, 0x4b, 0xf4, 0x95, 0x5f, 0xbc, 0x7a, 0x83, 0x7d, 0x62, 0x12, 0xcd, 0x43, 0x41, 0x12, 0xb7,
+	0x6a, 0xdb, 0x2f, 0x9b, 0x3e, 0x35, 0x85, 0x16, 0x00, 0x05, 0xfb, 0x34, 0xb7, 0xb6, 0x76, 0x7d,
+	0x7a, 0x1a, 0xbd, 0x0f, 0x57, 0x3d, 0xb3, 0x2f, 0x6b, 0xb8, 0xb9, 0x1f, 0xb2, 0x9e, 0x59, 0xd9,
+	0x84, 0x5c, 0x68, 0x8a, 0x44, 0x39, 0x98, 0xd8, 0x78, 0xf5, 0x66, 0xb7, 0x89, 0xf7, 0x0b, 0x57,
+	0x10, 0x40, 0x46, 0x2e, 0xf6, 0x0b, 0x0a, 0xca, 0x03, 0x34, 0xde, 0xac, 0xb7, 0xfc, 0x75, 0x02,
+	0x4d, 0xc1, 0xe4, 0x8b, 0xea, 0x46, 0xad, 0x5e, 0x6b, 0xee, 0x17, 0x92, 0x2b, 0x77, 0x21, 0xe3,
+	0x5d, 0x20, 0xd0, 0x04, 0x24, 0xab, 0xf5, 0x7a, 0xe1, 0x0a, 0x9a, 0x84, 0xd4, 0x4e, 0xb5, 0xbe,
+	0x55, 0x50, 0x5c, 0x33, 0x2f, 0xb6, 0xe4, 0x73, 0x72, 0x65, 0x15, 0x66, 0x86, 0x46, 0x37, 0xd7,
+	0x52, 0xa3, 0x59, 0xdd, 0xdd, 0xac, 0xe2, 0xcd, 0xc2, 0x15, 0x77, 0xb5, 0x51, 0x6f, 0xd4, 0x5a,
+	0x3b, 0x8f, 0x9f, 0x16, 0x94, 0x95, 0x0f, 0x61, 0x3a, 0x92, 0xa3, 0x2e, 0x3e, 0xe9, 0xc0, 0xba,
+	0x8b, 0x6f, 0x1a, 0xb2, 0xaf, 0xdf, 0x54, 0x71, 0x73, 0x0b, 0xd7, 0xf7, 0xbd, 0x7d, 0xdc, 0xb7,
+	0xaa, 0xef, 0x17, 0x12, 0x6b, 0xbf, 0xcd, 0x46, 0x2e, 0x9e, 0xd5, 0xbd, 0x1a, 0xfa, 0x99, 0x02,
+	0xef, 0x6d, 0x53, 0x33, 0xf6, 0x73, 0xc9, 0x79, 0x87, 0xf2, 0xe2, 0xbd, 0x73, 0xbf, 0x9a, 0x84,
+	0xed, 0xa8, 0xf7, 0x7f, 0xf8, 0xb7, 0xbf, 0xff, 0x22, 0x71, 0x07, 0xdd, 0xf2, 0xbf, 0x5c, 0x4b,
+	0xb5, 0x4a, 0x48, 0x8d, 0x57, 0x82, 0x33, 0x9c, 0xa3, 0x9f, 0x2a, 0xb0, 0x10, 0x02, 0x74, 0x61,
+	0x3c, 0x17, 0xfe, 0x8a, 0xa3, 0xae, 0x48, 0x38, 0xb7, 0x91, 0x3a, 0x1e, 0x0e, 0xfa, 0x95, 0x02,
+	0xd7, 0xb6, 0x3d, 0xf5, 0xf8, 0x4f, 0x22, 0xe7, 0x62, 0x2a, 0x8f, 0xff, 0x32, 0x12, 0x71, 0xd4,
+	0x43, 0x89, 0x6c, 0x05, 0x2d, 0x8f, 0x46, 0x36, 0x74, 0x7f, 0xfe, 0x42, 0x81, 0xa5, 0x61, 0x7c,
+	0x17, 0x86, 0x77, 0xb9, 0x0f, 0x37, 0x6a, 0x45, 0xa2, 0xbb, 0x87, 0xee, 0x5e, 0x10, 0x1d, 0xfa,
+	0xb1, 0x02, 0xf3, 0xdb, 0xd4, 0x3c, 0xfb, 0xe5, 0xe0, 0x5c, 0x54, 0x1f, 0x8c, 0xbd, 0xda, 0x48,
+	0x23, 0xea, 0xb2, 0x84, 0xa3, 0xa2, 0xd2, 0x68, 0x38, 0x5d, 0x6f, 0xbb, 0xcf, 0x15, 0x40, 0xdb,
+	0xd4, 0x1c, 0x9e, 0x0a, 0xef, 0x8f, 0xb8, 0x3d, 0xc6, 0x5d, 0x25, 0x16, 0x6f, 0x5f, 0x44, 0x58,
+	0xbd, 0x2b, 0x31, 0xdd, 0x44, 0x37, 0x46, 0x63, 0x12, 0x72, 0xef, 0x5f, 0x7b, 0x65, 0x17, 0xdb,
+	0xa7, 0x1e, 0x8e, 0xd9, 0xea, 0xcc, 0x34, 0xb5, 0x78, 0xef, 0xc2, 0x1a, 0xea, 0x03, 0x89, 0xf0,
+	0x03, 0x74, 0xfb, 0x9c, 0xe4, 0x1f, 0x40, 0x39, 0x86, 0xe9, 0x6d, 0x2a, 0x42, 0x4d, 0x64, 0xa1,
+	0xec, 0xfd, 0xcb, 0x54, 0x0e, 0xfe, 0x65, 0x2a, 0x6f, 0xb9, 0xff, 0x32, 0x2d, 0xde, 0x88, 0x45,
+	0x30, 0x50, 0x54, 0x57, 0xe5, 0xbe, 0x77, 0xd1, 0x9d, 0xd1, 0xfb, 0x6a, 0xae, 0x74, 0x45, 0x7e,
+	0xaa, 0x5e, 0xff, 0x32, 0xf1, 0xf3, 0xea, 0x1f, 0x13, 0xe8, 0xaf, 0x0a, 0xcc, 0x85, 0x22, 0x5f,
+	0x6a, 0x50, 0xe7, 0x88, 0x69, 0x54, 0x25, 0x70, 0x27, 0xa4, 0x5a, 0xe2, 0x1e, 0xb9, 0xb4, 0x5a,
+	0xf2, 0x0d, 0x97, 0x6c, 0xc7, 0xfa, 0x94, 0x6a, 0x02, 0xdd, 0xec, 0x08, 0x61, 0xf3, 0x67, 0x95,
+	0x4a, 0x9b, 0x89, 0x4e, 0xef, 0xa0, 0xac, 0x59, 0xdd, 0x4a, 0x9b, 0xe9, 0xa7, 0x96, 0x19, 0x60,
+	0x58, 0xbc, 0xda, 0x66, 0x3a, 0xb5, 0xcc, 0x0e, 0xd1, 0xa8, 0xf3, 0xed, 0x76, 0x97, 0x30, 0xc3,
+	0x95, 0x5a, 0x79, 0x0d, 0xf3, 0xeb, 0x8d, 0xcd, 0xd2, 0xe3, 0xd5, 0x0d, 0x83, 0xf4, 0x38, 0x2d,
+	0xd5, 0x99, 0x46, 0x4d, 0x4e, 0xd1, 0xd3, 0xb1, 0x16, 0x2b, 0x07, 0x86, 0x75, 0x50, 0xe9, 0x12,
+	0x2e, 0xa8, 0x53, 0xa9, 0xd7, 0x36, 0xb6, 0x76, 0x1b, 0x5b, 0x65, 0x71, 0x22, 0xd6, 0x92, 0x8f,
+	0xca, 0x0f, 0x57, 0x92, 0x4a, 0x22, 0xb5, 0x56, 0x20, 0xb6, 0x6d, 0x30, 0x4d, 0xf6, 0xc1, 0xca,
+	0xa7, 0xdc, 0x32, 0x9f, 0x9d, 0xa1, 0xe0, 0x6f, 0x40, 0xf2, 0xc9, 0xc3, 0x27, 0xe8, 0x09, 0xac,
+	0x60, 0x2a, 0x7a, 0x8e, 0x49, 0xf5, 0xd2, 0x71, 0x87, 0x9a, 0x25, 0xd1, 0xa1, 0x25, 0x87, 0x72,
+	0xab, 0xe7, 0x68, 0xb4, 0xa4, 0x5b, 0x94, 0x97, 0x4c, 0x4b, 0x94, 0xe8, 0x09, 0xe3, 0xa2, 0x8c,
+	0x32, 0x90, 0xfa, 0x22, 0xa1, 0x64, 0xbe, 0x1b, 0xf7, 0xff, 0xe1, 0x41, 0x46, 0x06, 0xed, 0xf1,
+	0xbf, 0x07, 0x00, 0x45, 0x66, 0x2f, 0xab, 0x70, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
 
@@ -759,6 +1903,14 @@ type AntibiogramAPIClient interface {
 	GenAntimicrobialsAntibiogram(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*AntimicrobialsAntibiogram, error)
 	// Generates antibiogram report for a single antimicrobial
 	GenAntimicrobialAntibiogram(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*AntimicrobialAntibiogram, error)
+	// Generates antibiogram table of pathogens against antimicrobials
+	GenAntibiogramMatrix(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*AntibiogramMatrix, error)
+	// Generates susceptibility trend of a pathogen against an antimicrobial
+	GenResistanceTrend(ctx context.Context, in *ResistanceTrendRequest, opts ...grpc.CallOption) (*ResistanceTrend, error)
+	// Generates prevalence of MDR, XDR and PDR isolates per facility over time
+	GenResistancePrevalence(ctx context.Context, in *ResistancePrevalenceRequest, opts ...grpc.CallOption) (*ResistancePrevalence, error)
+	// Retrieves cache hit and miss statistics of antibiograms
+	GetCacheStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CacheStats, error)
 }
 
 type antibiogramAPIClient struct {
@@ -805,6 +1957,42 @@ func (c *antibiogramAPIClient) GenAntimicrobialAntibiogram(ctx context.Context,
 	return out, nil
 }
 
+func (c *antibiogramAPIClient) GenAntibiogramMatrix(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*AntibiogramMatrix, error) {
+	out := new(AntibiogramMatrix)
+	err := c.cc.Invoke(ctx, "/antibug.antibiogram.AntibiogramAPI/GenAntibiogramMatrix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *antibiogramAPIClient) GenResistanceTrend(ctx context.Context, in *ResistanceTrendRequest, opts ...grpc.CallOption) (*ResistanceTrend, error) {
+	out := new(ResistanceTrend)
+	err := c.cc.Invoke(ctx, "/antibug.antibiogram.AntibiogramAPI/GenResistanceTrend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *antibiogramAPIClient) GenResistancePrevalence(ctx context.Context, in *ResistancePrevalenceRequest, opts ...grpc.CallOption) (*ResistancePrevalence, error) {
+	out := new(ResistancePrevalence)
+	err := c.cc.Invoke(ctx, "/antibug.antibiogram.AntibiogramAPI/GenResistancePrevalence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *antibiogramAPIClient) GetCacheStats(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CacheStats, error) {
+	out := new(CacheStats)
+	err := c.cc.Invoke(ctx, "/antibug.antibiogram.AntibiogramAPI/GetCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AntibiogramAPIServer is the server API for AntibiogramAPI service.
 type AntibiogramAPIServer interface {
 	// Generates antibiogram report for multiple pathogens
@@ -815,6 +2003,14 @@ type AntibiogramAPIServer interface {
 	GenAntimicrobialsAntibiogram(context.Context, *Filter) (*AntimicrobialsAntibiogram, error)
 	// Generates antibiogram report for a single antimicrobial
 	GenAntimicrobialAntibiogram(context.Context, *Filter) (*AntimicrobialAntibiogram, error)
+	// Generates antibiogram table of pathogens against antimicrobials
+	GenAntibiogramMatrix(context.Context, *Filter) (*AntibiogramMatrix, error)
+	// Generates susceptibility trend of a pathogen against an antimicrobial
+	GenResistanceTrend(context.Context, *ResistanceTrendRequest) (*ResistanceTrend, error)
+	// Generates prevalence of MDR, XDR and PDR isolates per facility over time
+	GenResistancePrevalence(context.Context, *ResistancePrevalenceRequest) (*ResistancePrevalence, error)
+	// Retrieves cache hit and miss statistics of antibiograms
+	GetCacheStats(context.Context, *empty.Empty) (*CacheStats, error)
 }
 
 func RegisterAntibiogramAPIServer(s *grpc.Server, srv AntibiogramAPIServer) {
@@ -893,6 +2089,78 @@ func _AntibiogramAPI_GenAntimicrobialAntibiogram_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AntibiogramAPI_GenAntibiogramMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Filter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AntibiogramAPIServer).GenAntibiogramMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.antibiogram.AntibiogramAPI/GenAntibiogramMatrix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AntibiogramAPIServer).GenAntibiogramMatrix(ctx, req.(*Filter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AntibiogramAPI_GenResistanceTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResistanceTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AntibiogramAPIServer).GenResistanceTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.antibiogram.AntibiogramAPI/GenResistanceTrend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AntibiogramAPIServer).GenResistanceTrend(ctx, req.(*ResistanceTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AntibiogramAPI_GenResistancePrevalence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResistancePrevalenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AntibiogramAPIServer).GenResistancePrevalence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.antibiogram.AntibiogramAPI/GenResistancePrevalence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AntibiogramAPIServer).GenResistancePrevalence(ctx, req.(*ResistancePrevalenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AntibiogramAPI_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AntibiogramAPIServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.antibiogram.AntibiogramAPI/GetCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AntibiogramAPIServer).GetCacheStats(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AntibiogramAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "antibug.antibiogram.AntibiogramAPI",
 	HandlerType: (*AntibiogramAPIServer)(nil),
@@ -913,6 +2181,22 @@ var _AntibiogramAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenAntimicrobialAntibiogram",
 			Handler:    _AntibiogramAPI_GenAntimicrobialAntibiogram_Handler,
 		},
+		{
+			MethodName: "GenAntibiogramMatrix",
+			Handler:    _AntibiogramAPI_GenAntibiogramMatrix_Handler,
+		},
+		{
+			MethodName: "GenResistanceTrend",
+			Handler:    _AntibiogramAPI_GenResistanceTrend_Handler,
+		},
+		{
+			MethodName: "GenResistancePrevalence",
+			Handler:    _AntibiogramAPI_GenResistancePrevalence_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _AntibiogramAPI_GetCacheStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "antibiogram.proto",
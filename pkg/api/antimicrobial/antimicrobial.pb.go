@@ -53,6 +53,34 @@ func (AntimicrobialView) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9927f837201a5609, []int{0}
 }
 
+// AntimicrobialSort is the order of listed antimicrobials
+type AntimicrobialSort int32
+
+const (
+	// Order antimicrobials were added
+	AntimicrobialSort_SORT_BY_ID AntimicrobialSort = 0
+	// Alphabetical order of antimicrobial names
+	AntimicrobialSort_SORT_BY_NAME AntimicrobialSort = 1
+)
+
+var AntimicrobialSort_name = map[int32]string{
+	0: "SORT_BY_ID",
+	1: "SORT_BY_NAME",
+}
+
+var AntimicrobialSort_value = map[string]int32{
+	"SORT_BY_ID":   0,
+	"SORT_BY_NAME": 1,
+}
+
+func (x AntimicrobialSort) String() string {
+	return proto.EnumName(AntimicrobialSort_name, int32(x))
+}
+
+func (AntimicrobialSort) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9927f837201a5609, []int{1}
+}
+
 // Antimicrobial is a biological compound that acts against a microbe
 type Antimicrobial struct {
 	AntimicrobialId       int64               `protobuf:"varint,1,opt,name=antimicrobial_id,json=antimicrobialId,proto3" json:"antimicrobial_id,omitempty"`
@@ -632,12 +660,16 @@ func (m *DeleteAntimicrobialRequest) GetAntimicrobialId() string {
 
 // Request to retrieve a collection antimicrobial agents
 type ListAntimicrobialsRequest struct {
-	View                 AntimicrobialView `protobuf:"varint,1,opt,name=view,proto3,enum=antibug.antimicrobial.AntimicrobialView" json:"view,omitempty"`
-	PageToken            int32             `protobuf:"varint,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize             int32             `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	View AntimicrobialView `protobuf:"varint,1,opt,name=view,proto3,enum=antibug.antimicrobial.AntimicrobialView" json:"view,omitempty"`
+	// Opaque token of next_page_token from the previous page. Empty for the first page.
+	PageToken string            `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32             `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Sort      AntimicrobialSort `protobuf:"varint,5,opt,name=sort,proto3,enum=antibug.antimicrobial.AntimicrobialSort" json:"sort,omitempty"`
+	// Count antimicrobials in total_count
+	IncludeTotal         bool     `protobuf:"varint,6,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListAntimicrobialsRequest) Reset()         { *m = ListAntimicrobialsRequest{} }
@@ -672,11 +704,11 @@ func (m *ListAntimicrobialsRequest) GetView() AntimicrobialView {
 	return AntimicrobialView_FULL
 }
 
-func (m *ListAntimicrobialsRequest) GetPageToken() int32 {
+func (m *ListAntimicrobialsRequest) GetPageToken() string {
 	if m != nil {
 		return m.PageToken
 	}
-	return 0
+	return ""
 }
 
 func (m *ListAntimicrobialsRequest) GetPageSize() int32 {
@@ -686,12 +718,26 @@ func (m *ListAntimicrobialsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (m *ListAntimicrobialsRequest) GetSort() AntimicrobialSort {
+	if m != nil {
+		return m.Sort
+	}
+	return AntimicrobialSort_SORT_BY_ID
+}
+
+func (m *ListAntimicrobialsRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
 // Request to search for an Antimicrobial
 type SearchAntimicrobialsRequest struct {
 	View                 AntimicrobialView `protobuf:"varint,1,opt,name=view,proto3,enum=antibug.antimicrobial.AntimicrobialView" json:"view,omitempty"`
 	Query                string            `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 	Filter               bool              `protobuf:"varint,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	PageToken            int32             `protobuf:"varint,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageToken            string            `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	PageSize             int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -744,11 +790,11 @@ func (m *SearchAntimicrobialsRequest) GetFilter() bool {
 	return false
 }
 
-func (m *SearchAntimicrobialsRequest) GetPageToken() int32 {
+func (m *SearchAntimicrobialsRequest) GetPageToken() string {
 	if m != nil {
 		return m.PageToken
 	}
-	return 0
+	return ""
 }
 
 func (m *SearchAntimicrobialsRequest) GetPageSize() int32 {
@@ -760,11 +806,13 @@ func (m *SearchAntimicrobialsRequest) GetPageSize() int32 {
 
 // Antimicrobials contains a collection of antimicrobials
 type Antimicrobials struct {
-	Antimicrobials       []*Antimicrobial `protobuf:"bytes,1,rep,name=antimicrobials,proto3" json:"antimicrobials,omitempty"`
-	NextPageToken        int32            `protobuf:"varint,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Antimicrobials []*Antimicrobial `protobuf:"bytes,1,rep,name=antimicrobials,proto3" json:"antimicrobials,omitempty"`
+	// Empty on the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount           int64    `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Antimicrobials) Reset()         { *m = Antimicrobials{} }
@@ -799,10 +847,17 @@ func (m *Antimicrobials) GetAntimicrobials() []*Antimicrobial {
 	return nil
 }
 
-func (m *Antimicrobials) GetNextPageToken() int32 {
+func (m *Antimicrobials) GetNextPageToken() string {
 	if m != nil {
 		return m.NextPageToken
 	}
+	return ""
+}
+
+func (m *Antimicrobials) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
 	return 0
 }
 
@@ -856,6 +911,7 @@ func (m *GetAntimicrobialRequest) GetView() AntimicrobialView {
 
 func init() {
 	proto.RegisterEnum("antibug.antimicrobial.AntimicrobialView", AntimicrobialView_name, AntimicrobialView_value)
+	proto.RegisterEnum("antibug.antimicrobial.AntimicrobialSort", AntimicrobialSort_name, AntimicrobialSort_value)
 	proto.RegisterType((*Antimicrobial)(nil), "antibug.antimicrobial.Antimicrobial")
 	proto.RegisterType((*RepeatedString)(nil), "antibug.antimicrobial.RepeatedString")
 	proto.RegisterType((*PharmacologyInfo)(nil), "antibug.antimicrobial.PharmacologyInfo")
@@ -876,90 +932,97 @@ func init() {
 func init() { proto.RegisterFile("antimicrobial.proto", fileDescriptor_9927f837201a5609) }
 
 var fileDescriptor_9927f837201a5609 = []byte{
-	// 1325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x16, 0xbe, 0x94, 0x2d, 0x3f, 0x8e, 0x5f, 0xf2, 0xd8, 0xce, 0xe5, 0x95, 0xef, 0xc5, 0x65, 0xd9,
-	0xa6, 0x7e, 0xb4, 0x16, 0x6d, 0xc5, 0x28, 0x10, 0xa7, 0x40, 0xea, 0x38, 0x6e, 0xea, 0xc2, 0x49,
-	0x13, 0xca, 0xc9, 0xa2, 0x28, 0x20, 0x8c, 0xc8, 0x23, 0x6a, 0x12, 0x91, 0xc3, 0x70, 0x46, 0x7e,
-	0xa4, 0xe8, 0x26, 0x8b, 0xa2, 0xeb, 0x06, 0x05, 0x8a, 0x16, 0x28, 0xba, 0xe8, 0x2f, 0xe8, 0xbe,
-	0xcb, 0xee, 0x0b, 0xb4, 0x3f, 0xa1, 0xbb, 0xfe, 0x89, 0x82, 0x23, 0xca, 0x11, 0x2d, 0x31, 0x91,
-	0x12, 0x74, 0x65, 0xce, 0x99, 0xf3, 0x7d, 0xf3, 0xcd, 0x79, 0x79, 0x04, 0x0b, 0x34, 0x90, 0xcc,
-	0x67, 0x4e, 0xc4, 0x6b, 0x8c, 0x36, 0x4b, 0x61, 0xc4, 0x25, 0x27, 0x4b, 0xb1, 0xb1, 0xd6, 0xf2,
-	0x4a, 0xa9, 0xcd, 0xe2, 0x7f, 0x3d, 0xce, 0xbd, 0x26, 0x5a, 0x34, 0x64, 0x16, 0x0d, 0x02, 0x2e,
-	0xa9, 0x64, 0x3c, 0x10, 0x6d, 0x50, 0x71, 0x39, 0xd9, 0x55, 0xab, 0x5a, 0xab, 0x6e, 0xa1, 0x1f,
-	0xca, 0xb3, 0x64, 0xf3, 0x5d, 0xf5, 0xc7, 0xd9, 0xf0, 0x30, 0xd8, 0x10, 0x27, 0xd4, 0xf3, 0x30,
-	0xb2, 0x78, 0xa8, 0xe0, 0xbd, 0x54, 0xe6, 0x57, 0xe3, 0x30, 0xb3, 0xdb, 0x7d, 0x34, 0x59, 0x83,
-	0x42, 0x4a, 0x4b, 0x95, 0xb9, 0xba, 0x66, 0x68, 0xab, 0x23, 0xf6, 0x5c, 0xca, 0x7e, 0xe0, 0x92,
-	0x0d, 0x20, 0x69, 0xd7, 0x80, 0xfa, 0xa8, 0xe7, 0x0c, 0x6d, 0x75, 0xd2, 0x9e, 0x4f, 0xed, 0xdc,
-	0xa1, 0x3e, 0x92, 0x25, 0x18, 0x73, 0xaa, 0x2e, 0xab, 0xd7, 0xf5, 0x11, 0xe5, 0x92, 0x77, 0x6e,
-	0xb2, 0x7a, 0x9d, 0x6c, 0xc1, 0x22, 0x8f, 0x68, 0xb3, 0x5a, 0x63, 0x9c, 0x1e, 0x53, 0xd6, 0xa4,
-	0x35, 0xd6, 0x64, 0xf2, 0x4c, 0x1f, 0x55, 0x4e, 0x0b, 0xf1, 0xde, 0x8d, 0xf4, 0x96, 0xd2, 0x18,
-	0x86, 0x11, 0x3f, 0x65, 0x3e, 0x95, 0x58, 0x75, 0xb8, 0x90, 0x7a, 0x5e, 0xb9, 0xcf, 0x75, 0xd9,
-	0xf7, 0xb8, 0x90, 0xe4, 0x63, 0x98, 0xf1, 0x30, 0xc0, 0xf8, 0x80, 0x96, 0xa0, 0x1e, 0xea, 0x63,
-	0x86, 0xb6, 0x3a, 0x55, 0xbe, 0x5c, 0xea, 0x1b, 0xf8, 0x92, 0x8d, 0x21, 0x52, 0x89, 0x6e, 0x45,
-	0x46, 0x2c, 0xf0, 0xec, 0xe9, 0x04, 0x7b, 0x3f, 0x86, 0x92, 0x3b, 0x30, 0xe7, 0x46, 0x2d, 0xaf,
-	0xea, 0xf3, 0x80, 0x49, 0x1e, 0x3b, 0xe8, 0xe3, 0xc3, 0xb0, 0xcd, 0xc6, 0xe8, 0xdb, 0xe7, 0xe0,
-	0x98, 0x8f, 0xba, 0xc7, 0x18, 0x09, 0xac, 0x62, 0xbd, 0x8e, 0x8e, 0x14, 0xfa, 0xc4, 0x50, 0x7c,
-	0x09, 0x7a, 0xbf, 0x0d, 0x26, 0x47, 0x40, 0x7c, 0xfa, 0x90, 0x47, 0x55, 0x16, 0x48, 0x8c, 0xa8,
-	0xa3, 0x12, 0xad, 0x4f, 0x0e, 0x43, 0x39, 0xaf, 0x08, 0x0e, 0xba, 0xf0, 0xe4, 0x16, 0x4c, 0x87,
-	0x0d, 0x1a, 0xf9, 0xd4, 0xe1, 0x4d, 0xee, 0x9d, 0xe9, 0xa0, 0xf8, 0xde, 0xcc, 0xe0, 0xbb, 0xdb,
-	0xe5, 0x6a, 0xa7, 0x80, 0xe4, 0x33, 0xb8, 0x44, 0x5d, 0x97, 0xc5, 0xac, 0x71, 0x59, 0x05, 0x75,
-	0x1e, 0xf9, 0xaa, 0x18, 0xf5, 0xa9, 0x61, 0x24, 0x2e, 0x3d, 0x27, 0x39, 0x78, 0xce, 0x41, 0x1e,
-	0xc0, 0x7c, 0xac, 0xf8, 0x98, 0xc9, 0xb3, 0xaa, 0x08, 0xd1, 0x91, 0x51, 0xcb, 0xd7, 0xa7, 0x15,
-	0xf1, 0x5a, 0x06, 0x71, 0x25, 0x71, 0xfb, 0xa4, 0xbe, 0x9b, 0x20, 0xed, 0x42, 0x87, 0xa3, 0xb3,
-	0x47, 0xae, 0xc3, 0x38, 0xba, 0x71, 0xc6, 0x84, 0x3e, 0x33, 0x8c, 0xcc, 0x0e, 0x8a, 0xbc, 0x0d,
-	0x73, 0xad, 0xd0, 0x8d, 0xeb, 0x54, 0x32, 0x1f, 0xab, 0x02, 0x1d, 0x7d, 0x56, 0xf5, 0xd3, 0x4c,
-	0xdb, 0x7c, 0xc4, 0x7c, 0xac, 0xa0, 0x63, 0xae, 0xc2, 0x6c, 0x9a, 0x82, 0x5c, 0x82, 0xb1, 0x63,
-	0xda, 0x6c, 0xa1, 0xd0, 0x35, 0x63, 0x64, 0x75, 0xd2, 0x4e, 0x56, 0xe6, 0x0e, 0x14, 0xba, 0xc3,
-	0x1c, 0x47, 0x81, 0x14, 0x60, 0xe4, 0x11, 0x9e, 0xa9, 0x4e, 0x9d, 0xb4, 0xe3, 0x4f, 0xb2, 0x08,
-	0x79, 0xe5, 0x9f, 0x34, 0x64, 0x7b, 0x61, 0xd6, 0x61, 0xba, 0x1b, 0x4b, 0x1e, 0x00, 0xe9, 0x4e,
-	0x92, 0x4a, 0x4b, 0xfb, 0xbc, 0xa9, 0xf2, 0xca, 0x00, 0x39, 0x8e, 0x0f, 0xb7, 0xe7, 0xc3, 0x0b,
-	0x16, 0x61, 0x96, 0x61, 0xfa, 0xb6, 0x02, 0xa0, 0x50, 0xfa, 0x08, 0x8c, 0xaa, 0xe9, 0xd0, 0x16,
-	0xa8, 0xbe, 0xc9, 0x2c, 0xe4, 0x98, 0x9b, 0xc8, 0xcb, 0x31, 0xd7, 0xa4, 0x30, 0x71, 0x1e, 0xf6,
-	0x45, 0xc8, 0x7b, 0x11, 0x6f, 0x85, 0x09, 0xa0, 0xbd, 0x20, 0xd7, 0x61, 0xc2, 0x4f, 0x58, 0xf5,
-	0x9c, 0xd2, 0x98, 0x55, 0x87, 0xdd, 0x87, 0xdb, 0xe7, 0x20, 0xf3, 0x1e, 0x90, 0xde, 0xac, 0x93,
-	0x6b, 0x30, 0x71, 0x5e, 0x32, 0xed, 0xab, 0xff, 0xff, 0x25, 0x25, 0x63, 0x9f, 0x03, 0xcc, 0x06,
-	0x14, 0xf7, 0xa2, 0x38, 0x6d, 0xa9, 0x39, 0x6a, 0xe3, 0xe3, 0x16, 0xb6, 0xe7, 0x4f, 0x8a, 0x41,
-	0xdd, 0x67, 0xaa, 0xfc, 0x56, 0x06, 0x7f, 0x9a, 0x23, 0x0d, 0x35, 0x3f, 0x82, 0xe5, 0xbe, 0x27,
-	0x89, 0x90, 0x07, 0x02, 0x33, 0x27, 0xf7, 0x64, 0xcf, 0xe4, 0x36, 0x9f, 0x69, 0x50, 0xbc, 0xaf,
-	0xaa, 0xaf, 0xaf, 0xe8, 0xc1, 0x99, 0x7a, 0xef, 0x97, 0x7b, 0xf5, 0xfb, 0xdd, 0x82, 0xe2, 0x4d,
-	0x6c, 0xe2, 0x6b, 0x8b, 0x32, 0xbf, 0xd1, 0xe0, 0x3f, 0x87, 0x4c, 0xc8, 0x14, 0x8f, 0xe8, 0x10,
-	0xbd, 0x0f, 0xa3, 0xc7, 0x0c, 0x4f, 0x14, 0x78, 0xb6, 0xbc, 0x3a, 0x88, 0xd2, 0x07, 0x0c, 0x4f,
-	0x6c, 0x85, 0x22, 0xff, 0x03, 0x08, 0xa9, 0x87, 0x55, 0xc9, 0x1f, 0x61, 0xa0, 0x6e, 0x9b, 0xb7,
-	0x27, 0x63, 0xcb, 0x51, 0x6c, 0x20, 0xcb, 0xa0, 0x16, 0x55, 0xc1, 0x9e, 0xa0, 0xfa, 0x3f, 0x97,
-	0xb7, 0x27, 0x62, 0x43, 0x85, 0x3d, 0x41, 0xf3, 0x57, 0x0d, 0x96, 0x2b, 0x48, 0x23, 0xa7, 0xf1,
-	0x4f, 0x28, 0x5b, 0x84, 0xfc, 0xe3, 0x16, 0x46, 0x67, 0x9d, 0x86, 0x57, 0x8b, 0x78, 0x88, 0xd4,
-	0x59, 0x53, 0x62, 0xa4, 0xd4, 0x4c, 0xd8, 0xc9, 0xea, 0xc2, 0x3d, 0x46, 0x5f, 0x78, 0x8f, 0xfc,
-	0x85, 0x7b, 0x7c, 0xa9, 0xc1, 0x6c, 0xfa, 0x06, 0xe4, 0x10, 0x66, 0x53, 0x2a, 0x3b, 0x33, 0x64,
-	0xb0, 0x42, 0xb8, 0x80, 0x8d, 0x67, 0x66, 0x80, 0xa7, 0xb2, 0xda, 0x13, 0xe9, 0x99, 0xd8, 0x7c,
-	0xb7, 0xa3, 0xd2, 0x7c, 0xaa, 0xc1, 0xbf, 0x6f, 0xa1, 0x7c, 0xdd, 0x22, 0xee, 0xc4, 0x3d, 0xf7,
-	0x2a, 0x71, 0x5f, 0x5f, 0x81, 0xf9, 0x9e, 0x2d, 0x32, 0x01, 0xa3, 0x1f, 0xde, 0x3f, 0x3c, 0x2c,
-	0xfc, 0x2b, 0xfe, 0x3a, 0x3c, 0xa8, 0x1c, 0x15, 0xb4, 0xf2, 0x5f, 0xe3, 0x50, 0x48, 0x79, 0xee,
-	0xde, 0x3d, 0x20, 0x3f, 0x6b, 0xb0, 0xd0, 0xa7, 0xab, 0xc9, 0x56, 0x86, 0x8a, 0xec, 0x59, 0x53,
-	0x2c, 0x0f, 0x03, 0x69, 0x0f, 0x0d, 0x73, 0xfb, 0xe9, 0xef, 0x7f, 0x3e, 0xcb, 0x95, 0xcc, 0xb5,
-	0xe4, 0xad, 0xa9, 0xf0, 0x56, 0x3a, 0x1d, 0x56, 0xfb, 0x29, 0x60, 0x39, 0x8a, 0x67, 0x47, 0x5b,
-	0x27, 0xdf, 0x6b, 0xb0, 0xd0, 0x67, 0x7e, 0x64, 0x8a, 0xce, 0x9e, 0x35, 0xc5, 0x4b, 0xa5, 0xf6,
-	0x6b, 0xb6, 0xd4, 0x79, 0xcd, 0x96, 0xf6, 0xe3, 0xd7, 0xac, 0x79, 0x55, 0x09, 0xbb, 0x52, 0x2e,
-	0xbd, 0x48, 0xd8, 0xe7, 0x17, 0x33, 0xfc, 0x45, 0xac, 0xee, 0x5b, 0x0d, 0x16, 0xfa, 0x0c, 0x92,
-	0x4c, 0x75, 0xd9, 0x43, 0x27, 0x53, 0xdd, 0x7b, 0x4a, 0xdd, 0xe6, 0xfa, 0x90, 0xea, 0xc8, 0x0f,
-	0x1a, 0x90, 0xde, 0xc9, 0x44, 0x36, 0x33, 0x94, 0x65, 0x0e, 0xb1, 0xe2, 0xe5, 0x41, 0x8a, 0x54,
-	0x98, 0x96, 0xd2, 0xb9, 0x46, 0x56, 0x06, 0x48, 0x6f, 0x93, 0x09, 0x49, 0x7e, 0xd4, 0xa0, 0x70,
-	0xb1, 0xa3, 0x48, 0x29, 0xe3, 0xb0, 0x8c, 0xd6, 0x2b, 0x0e, 0xd4, 0xf4, 0x9d, 0x18, 0x92, 0x61,
-	0x63, 0xf8, 0x93, 0x06, 0x8b, 0xfd, 0xa6, 0x28, 0xc9, 0xaa, 0xff, 0x17, 0x8c, 0xdc, 0x41, 0xe3,
-	0xb8, 0xa5, 0xb4, 0xbe, 0x43, 0x06, 0x69, 0x13, 0xa1, 0x8e, 0xbb, 0xf1, 0x5b, 0xee, 0xeb, 0xdd,
-	0x5f, 0x72, 0xe4, 0x0f, 0x0d, 0x96, 0x52, 0x64, 0x86, 0xc0, 0xe8, 0x98, 0x39, 0x68, 0x3a, 0xb0,
-	0x42, 0xfb, 0x6d, 0x18, 0x1b, 0x46, 0x72, 0x80, 0x11, 0x46, 0xfc, 0x21, 0x3a, 0x92, 0xbc, 0xd1,
-	0x90, 0x32, 0x14, 0x3b, 0x96, 0xe5, 0x31, 0xd9, 0x68, 0xd5, 0x4a, 0x0e, 0xf7, 0x2d, 0x8f, 0xb9,
-	0x67, 0x3c, 0xe8, 0x68, 0x29, 0x2e, 0x79, 0xcc, 0x45, 0x1e, 0x34, 0xa8, 0x83, 0xd1, 0x07, 0x9e,
-	0x4f, 0x59, 0x33, 0xf6, 0x5a, 0xbf, 0x07, 0x8b, 0x37, 0x2a, 0x37, 0x8d, 0x2b, 0x1b, 0x7b, 0x4d,
-	0xda, 0x12, 0x68, 0x1c, 0x32, 0x07, 0xe3, 0xc7, 0xc2, 0xd5, 0x97, 0x32, 0x5a, 0xb5, 0x26, 0xaf,
-	0x59, 0x3e, 0x15, 0x12, 0x23, 0xeb, 0xf0, 0x60, 0x6f, 0xff, 0x4e, 0x65, 0xbf, 0x24, 0x4f, 0x65,
-	0x79, 0x64, 0xab, 0xb4, 0xb9, 0x3e, 0xa2, 0xe5, 0x46, 0xcb, 0xf1, 0xaf, 0xb0, 0x26, 0x73, 0xd4,
-	0x03, 0xdc, 0x7a, 0x28, 0x78, 0xb0, 0xd3, 0x63, 0xb1, 0xaf, 0xc1, 0xc8, 0xf6, 0xe6, 0x36, 0xd9,
-	0x86, 0x75, 0x1b, 0x65, 0x2b, 0x0a, 0xd0, 0x35, 0x4e, 0x1a, 0x18, 0x18, 0xb2, 0x81, 0x46, 0x84,
-	0x82, 0xb7, 0x22, 0x07, 0x0d, 0x97, 0xa3, 0x30, 0x02, 0x2e, 0x0d, 0x3c, 0x65, 0x42, 0x96, 0xc8,
-	0x18, 0x8c, 0x7e, 0x97, 0xd3, 0xc6, 0x3e, 0x4d, 0x3f, 0x10, 0x6a, 0x63, 0xaa, 0x0b, 0xaf, 0xfc,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0x33, 0xa7, 0xd5, 0x30, 0x4b, 0x0f, 0x00, 0x00,
+	// 1427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x1a, 0x0e, 0xf5, 0x61, 0xcb, 0xaf, 0x6d, 0x59, 0x1e, 0xdb, 0x59, 0xae, 0xbc, 0x8b, 0x70, 0x99,
+	0xcd, 0xfa, 0x63, 0xd7, 0x92, 0xad, 0x78, 0x17, 0x88, 0xb3, 0x40, 0xea, 0xaf, 0xa6, 0x0e, 0x1c,
+	0x27, 0xa1, 0x9c, 0x00, 0x2d, 0x0a, 0x08, 0x23, 0x72, 0x44, 0x4d, 0x42, 0x72, 0x18, 0x72, 0xe8,
+	0x8f, 0x14, 0xbd, 0xe4, 0xd4, 0x73, 0x73, 0x29, 0x5a, 0xa0, 0xe8, 0xa1, 0xd7, 0x5e, 0x7a, 0xef,
+	0x2f, 0xe8, 0xa5, 0x40, 0xfb, 0x13, 0x7a, 0xeb, 0x7f, 0x28, 0x8a, 0x19, 0x51, 0x8e, 0x68, 0x99,
+	0x8e, 0x94, 0xa0, 0x27, 0x6b, 0xde, 0x99, 0xe7, 0x79, 0x9f, 0x79, 0xdf, 0x99, 0x87, 0x63, 0x98,
+	0xc1, 0x1e, 0xa7, 0x2e, 0x35, 0x03, 0xd6, 0xa4, 0xd8, 0xa9, 0xf8, 0x01, 0xe3, 0x0c, 0xcd, 0x89,
+	0x60, 0x33, 0xb2, 0x2b, 0x89, 0xc9, 0xf2, 0xdf, 0x6c, 0xc6, 0x6c, 0x87, 0x54, 0xb1, 0x4f, 0xab,
+	0xd8, 0xf3, 0x18, 0xc7, 0x9c, 0x32, 0x2f, 0xec, 0x80, 0xca, 0xf3, 0xf1, 0xac, 0x1c, 0x35, 0xa3,
+	0x56, 0x95, 0xb8, 0x3e, 0x3f, 0x8d, 0x27, 0xff, 0x23, 0xff, 0x98, 0x2b, 0x36, 0xf1, 0x56, 0xc2,
+	0x63, 0x6c, 0xdb, 0x24, 0xa8, 0x32, 0x5f, 0xc2, 0xfb, 0xa9, 0xf4, 0xcf, 0x46, 0x61, 0x72, 0xb3,
+	0x37, 0x35, 0x5a, 0x82, 0x52, 0x42, 0x4b, 0x83, 0x5a, 0xaa, 0xa2, 0x29, 0x8b, 0x59, 0x63, 0x2a,
+	0x11, 0xdf, 0xb3, 0xd0, 0x0a, 0xa0, 0xe4, 0x52, 0x0f, 0xbb, 0x44, 0xcd, 0x68, 0xca, 0xe2, 0x98,
+	0x31, 0x9d, 0x98, 0x39, 0xc0, 0x2e, 0x41, 0x73, 0x30, 0x62, 0x36, 0x2c, 0xda, 0x6a, 0xa9, 0x59,
+	0xb9, 0x24, 0x6f, 0xee, 0xd0, 0x56, 0x0b, 0xad, 0xc1, 0x2c, 0x0b, 0xb0, 0xd3, 0x68, 0x52, 0x86,
+	0x8f, 0x30, 0x75, 0x70, 0x93, 0x3a, 0x94, 0x9f, 0xaa, 0x39, 0xb9, 0x68, 0x46, 0xcc, 0x6d, 0x25,
+	0xa7, 0xa4, 0x46, 0xdf, 0x0f, 0xd8, 0x09, 0x75, 0x31, 0x27, 0x0d, 0x93, 0x85, 0x5c, 0xcd, 0xcb,
+	0xe5, 0x53, 0x3d, 0xf1, 0x6d, 0x16, 0x72, 0x74, 0x0f, 0x26, 0x6d, 0xe2, 0x11, 0x91, 0x20, 0x0a,
+	0xb1, 0x4d, 0xd4, 0x11, 0x4d, 0x59, 0x1c, 0xaf, 0xdd, 0xa8, 0x5c, 0x58, 0xf8, 0x8a, 0x41, 0x7c,
+	0x82, 0x39, 0xb1, 0xea, 0x3c, 0xa0, 0x9e, 0x6d, 0x4c, 0xc4, 0xd8, 0xc7, 0x02, 0x8a, 0x0e, 0x60,
+	0xca, 0x0a, 0x22, 0xbb, 0xe1, 0x32, 0x8f, 0x72, 0x26, 0x16, 0xa8, 0xa3, 0xc3, 0xb0, 0x15, 0x05,
+	0xfa, 0xfe, 0x19, 0x58, 0xf0, 0x61, 0xeb, 0x88, 0x04, 0x21, 0x69, 0x90, 0x56, 0x8b, 0x98, 0x3c,
+	0x54, 0x0b, 0x43, 0xf1, 0xc5, 0xe8, 0xdd, 0x0e, 0x18, 0x1d, 0x02, 0x72, 0xf1, 0x53, 0x16, 0x34,
+	0xa8, 0xc7, 0x49, 0x80, 0x4d, 0xd9, 0x68, 0x75, 0x6c, 0x18, 0xca, 0x69, 0x49, 0xb0, 0xd7, 0x83,
+	0x47, 0x77, 0x61, 0xc2, 0x6f, 0xe3, 0xc0, 0xc5, 0x26, 0x73, 0x98, 0x7d, 0xaa, 0x82, 0xe4, 0xbb,
+	0x9e, 0xc2, 0xf7, 0xb0, 0x67, 0xa9, 0x91, 0x00, 0xa2, 0x8f, 0xe1, 0x2a, 0xb6, 0x2c, 0x2a, 0x58,
+	0xc5, 0xb1, 0xf2, 0x5a, 0x2c, 0x70, 0xe5, 0x61, 0x54, 0xc7, 0x87, 0x91, 0x38, 0xf7, 0x9a, 0x64,
+	0xef, 0x35, 0x07, 0x7a, 0x02, 0xd3, 0x42, 0xf1, 0x11, 0xe5, 0xa7, 0x8d, 0xd0, 0x27, 0x26, 0x0f,
+	0x22, 0x57, 0x9d, 0x90, 0xc4, 0x4b, 0x29, 0xc4, 0xf5, 0x78, 0xd9, 0x83, 0xd6, 0x66, 0x8c, 0x34,
+	0x4a, 0x5d, 0x8e, 0xee, 0x1c, 0xba, 0x03, 0xa3, 0xc4, 0x12, 0x1d, 0x0b, 0xd5, 0xc9, 0x61, 0x64,
+	0x76, 0x51, 0xe8, 0x5f, 0x30, 0x15, 0xf9, 0x96, 0x38, 0xa7, 0x9c, 0xba, 0xa4, 0x11, 0x12, 0x53,
+	0x2d, 0xca, 0xfb, 0x34, 0xd9, 0x09, 0x1f, 0x52, 0x97, 0xd4, 0x89, 0xa9, 0x2f, 0x42, 0x31, 0x49,
+	0x81, 0xae, 0xc2, 0xc8, 0x11, 0x76, 0x22, 0x12, 0xaa, 0x8a, 0x96, 0x5d, 0x1c, 0x33, 0xe2, 0x91,
+	0xbe, 0x01, 0xa5, 0xde, 0x32, 0x8b, 0x2a, 0xa0, 0x12, 0x64, 0x9f, 0x91, 0x53, 0x79, 0x53, 0xc7,
+	0x0c, 0xf1, 0x13, 0xcd, 0x42, 0x5e, 0xae, 0x8f, 0x2f, 0x64, 0x67, 0xa0, 0xb7, 0x60, 0xa2, 0x17,
+	0x8b, 0x9e, 0x00, 0xea, 0x6d, 0x92, 0x6c, 0x4b, 0x27, 0xdf, 0x78, 0x6d, 0x61, 0x80, 0x1e, 0x8b,
+	0xe4, 0xc6, 0xb4, 0x7f, 0x2e, 0x12, 0xea, 0x35, 0x98, 0xb8, 0x2f, 0x01, 0x24, 0x94, 0xfa, 0x10,
+	0xe4, 0xa4, 0x3b, 0x74, 0x04, 0xca, 0xdf, 0xa8, 0x08, 0x19, 0x6a, 0xc5, 0xf2, 0x32, 0xd4, 0xd2,
+	0x31, 0x14, 0xce, 0xca, 0x3e, 0x0b, 0x79, 0x3b, 0x60, 0x91, 0x1f, 0x03, 0x3a, 0x03, 0x74, 0x07,
+	0x0a, 0x6e, 0xcc, 0xaa, 0x66, 0xb4, 0xec, 0x25, 0xe7, 0xb0, 0x37, 0xb9, 0x71, 0x06, 0xd2, 0x1f,
+	0x01, 0xea, 0xef, 0x3a, 0xba, 0x0d, 0x85, 0xb3, 0x23, 0xd3, 0xd9, 0xfa, 0xb5, 0x37, 0x1c, 0x19,
+	0xe3, 0x0c, 0xa0, 0xb7, 0xa1, 0xbc, 0x1d, 0x88, 0xb6, 0x25, 0x7c, 0xd4, 0x20, 0xcf, 0x23, 0xd2,
+	0xf1, 0x9f, 0x04, 0x83, 0xdc, 0xcf, 0x78, 0xed, 0x9f, 0x29, 0xfc, 0x49, 0x8e, 0x24, 0x54, 0xff,
+	0x00, 0xe6, 0x2f, 0xcc, 0x14, 0xfa, 0xcc, 0x0b, 0x49, 0xaa, 0x73, 0x8f, 0xf5, 0x39, 0xb7, 0xfe,
+	0x4a, 0x81, 0xf2, 0x63, 0xdf, 0xea, 0xa7, 0xea, 0x88, 0x1e, 0x9c, 0xa9, 0x7f, 0x7f, 0x99, 0xb7,
+	0xdf, 0xdf, 0x5d, 0x28, 0xef, 0x10, 0x87, 0xbc, 0xb3, 0x28, 0xfd, 0x77, 0x05, 0xfe, 0xba, 0x4f,
+	0x43, 0x9e, 0xe0, 0x09, 0xbb, 0x44, 0xff, 0x87, 0xdc, 0x11, 0x25, 0xc7, 0x12, 0x5c, 0xac, 0x2d,
+	0x0e, 0xa2, 0xf4, 0x09, 0x25, 0xc7, 0x86, 0x44, 0xa1, 0xbf, 0x03, 0xf8, 0xd8, 0x26, 0x0d, 0xce,
+	0x9e, 0x11, 0x2f, 0xfe, 0x48, 0x8d, 0x89, 0xc8, 0xa1, 0x08, 0xa0, 0x79, 0x90, 0x83, 0x46, 0x48,
+	0x5f, 0x10, 0xf9, 0x9d, 0xcb, 0x1b, 0x05, 0x11, 0xa8, 0xd3, 0x17, 0x44, 0x64, 0x0e, 0x59, 0xd0,
+	0xf9, 0x56, 0x0d, 0x98, 0xb9, 0xce, 0x02, 0x6e, 0x48, 0x14, 0xba, 0x0e, 0x93, 0xd4, 0x33, 0x9d,
+	0xc8, 0x12, 0xc9, 0x39, 0x76, 0xe4, 0xa7, 0xac, 0x60, 0x4c, 0xc4, 0xc1, 0x43, 0x11, 0xbb, 0x97,
+	0x2b, 0x64, 0x4a, 0x59, 0xfd, 0x47, 0x05, 0xe6, 0xeb, 0x04, 0x07, 0x66, 0xfb, 0xcf, 0x28, 0xc1,
+	0x2c, 0xe4, 0x9f, 0x47, 0x24, 0x38, 0xed, 0x3a, 0x8b, 0x1c, 0x08, 0xb7, 0x6a, 0x51, 0x87, 0x93,
+	0x40, 0x6e, 0xbb, 0x60, 0xc4, 0xa3, 0x73, 0x05, 0x1b, 0xb9, 0xb4, 0x60, 0xf9, 0x64, 0xc1, 0xee,
+	0xe5, 0x0a, 0xb9, 0x52, 0x5e, 0xff, 0x4e, 0x81, 0x62, 0x72, 0x1f, 0x68, 0x1f, 0x8a, 0x09, 0xad,
+	0x5d, 0xcb, 0x1a, 0xec, 0xdc, 0x9d, 0xc3, 0x0a, 0x8b, 0xf6, 0xc8, 0x09, 0x6f, 0xf4, 0xe8, 0xec,
+	0x3c, 0x51, 0x26, 0x45, 0xf8, 0xe1, 0x99, 0xd6, 0x6b, 0x30, 0x2e, 0x2b, 0xdf, 0x30, 0x59, 0xe4,
+	0x71, 0xd9, 0xfc, 0xac, 0x01, 0x32, 0xb4, 0x2d, 0x22, 0x71, 0xf5, 0x5f, 0x2a, 0xf0, 0x97, 0xbb,
+	0x84, 0xbf, 0xeb, 0xd5, 0xea, 0x36, 0x29, 0xf3, 0x36, 0x4d, 0x5a, 0x5e, 0x80, 0xe9, 0xbe, 0x29,
+	0x54, 0x80, 0xdc, 0xfb, 0x8f, 0xf7, 0xf7, 0x4b, 0x57, 0xc4, 0xaf, 0xfd, 0xbd, 0xfa, 0x61, 0x49,
+	0x59, 0xfe, 0x2f, 0x4c, 0xf7, 0x9d, 0x38, 0x54, 0x04, 0xa8, 0x3f, 0x30, 0x0e, 0x1b, 0x5b, 0x1f,
+	0x36, 0xf6, 0x76, 0x4a, 0x57, 0x50, 0x09, 0x26, 0xba, 0xe3, 0x83, 0xcd, 0xfb, 0xbb, 0x25, 0xa5,
+	0xf6, 0xdb, 0x28, 0x94, 0x12, 0xb8, 0xcd, 0x87, 0x7b, 0xe8, 0x7b, 0x05, 0x66, 0x2e, 0xb0, 0x28,
+	0xb4, 0x96, 0x22, 0x3e, 0xdd, 0x38, 0xcb, 0xb5, 0x61, 0x20, 0x1d, 0x07, 0xd4, 0xd7, 0x5f, 0xfe,
+	0xfc, 0xeb, 0xab, 0x4c, 0x45, 0x5f, 0x8a, 0x1f, 0xce, 0x12, 0x5f, 0x4d, 0x36, 0xbb, 0xda, 0x79,
+	0xd7, 0x54, 0x4d, 0xc9, 0xb3, 0xa1, 0x2c, 0xa3, 0xaf, 0x14, 0x98, 0xb9, 0xc0, 0x0c, 0x53, 0x45,
+	0xa7, 0x1b, 0x67, 0xf9, 0x6a, 0xa5, 0xf3, 0x34, 0xaf, 0x74, 0x9f, 0xe6, 0x95, 0x5d, 0xf1, 0x34,
+	0xd7, 0x6f, 0x49, 0x61, 0x37, 0x6b, 0x95, 0xcb, 0x84, 0x7d, 0x72, 0xfe, 0x60, 0x7c, 0x2a, 0xd4,
+	0x7d, 0xa1, 0xc0, 0xcc, 0x05, 0xae, 0x98, 0xaa, 0x2e, 0xdd, 0x41, 0x53, 0xd5, 0xfd, 0x4f, 0xaa,
+	0x5b, 0x5d, 0x1e, 0x52, 0x1d, 0xfa, 0x5a, 0x01, 0xd4, 0x6f, 0xb3, 0x68, 0x35, 0x45, 0x59, 0xaa,
+	0x23, 0x97, 0x6f, 0x0c, 0x72, 0xb6, 0x43, 0xbd, 0x2a, 0x75, 0x2e, 0xa1, 0x85, 0x01, 0xda, 0xeb,
+	0xd0, 0x90, 0xa3, 0x6f, 0x14, 0x28, 0x9d, 0xbf, 0x88, 0xa8, 0x92, 0x92, 0x2c, 0xe5, 0xc6, 0x96,
+	0x07, 0xb2, 0x94, 0x6e, 0x0d, 0xd1, 0xb0, 0x35, 0xfc, 0x56, 0x81, 0xd9, 0x8b, 0x9c, 0x1a, 0xa5,
+	0x9d, 0xff, 0x4b, 0x6c, 0x7d, 0xd0, 0x3a, 0xae, 0x49, 0xad, 0xff, 0x46, 0x83, 0x5c, 0x93, 0x50,
+	0xa6, 0xdb, 0xfa, 0x29, 0xf3, 0xf9, 0xe6, 0x0f, 0x19, 0xf4, 0x8b, 0x02, 0x73, 0x09, 0x32, 0x2d,
+	0x24, 0xc1, 0x11, 0x35, 0x89, 0x6e, 0xc2, 0x02, 0xbe, 0x68, 0x42, 0x5b, 0xd1, 0xe2, 0x04, 0x9a,
+	0x1f, 0xb0, 0xa7, 0xc4, 0xe4, 0xe8, 0x1f, 0x6d, 0xce, 0xfd, 0x70, 0xa3, 0x5a, 0xb5, 0x29, 0x6f,
+	0x47, 0xcd, 0x8a, 0xc9, 0xdc, 0xaa, 0x4d, 0xad, 0x53, 0xe6, 0x75, 0xb5, 0x94, 0xe7, 0x6c, 0x6a,
+	0x11, 0xe6, 0xb5, 0xb1, 0x49, 0x82, 0xf7, 0x6c, 0x17, 0x53, 0x47, 0xac, 0x5a, 0x7e, 0x04, 0xb3,
+	0x5b, 0xf5, 0x1d, 0xed, 0xe6, 0xca, 0xb6, 0x83, 0xa3, 0x90, 0x68, 0xfb, 0xd4, 0x24, 0xe2, 0xe5,
+	0x73, 0xeb, 0x8d, 0x8c, 0xd5, 0xa6, 0xc3, 0x9a, 0x55, 0x17, 0x87, 0x9c, 0x04, 0xd5, 0xfd, 0xbd,
+	0xed, 0xdd, 0x83, 0xfa, 0x6e, 0x85, 0x9f, 0xf0, 0x5a, 0x76, 0xad, 0xb2, 0xba, 0x9c, 0x55, 0x32,
+	0xb9, 0x9a, 0xf8, 0x97, 0xd2, 0xa1, 0xa6, 0xfc, 0x6f, 0xa2, 0xfa, 0x34, 0x64, 0xde, 0x46, 0x5f,
+	0xc4, 0xb8, 0x0d, 0xd9, 0xf5, 0xd5, 0x75, 0xb4, 0x0e, 0xcb, 0x06, 0xe1, 0x51, 0xe0, 0x11, 0x4b,
+	0x3b, 0x6e, 0x13, 0x4f, 0xe3, 0x6d, 0xa2, 0x05, 0x24, 0x64, 0x51, 0x60, 0x12, 0xcd, 0x62, 0x24,
+	0xd4, 0x3c, 0xc6, 0x35, 0x72, 0x42, 0x43, 0x5e, 0x41, 0x23, 0x90, 0xfb, 0x32, 0xa3, 0x8c, 0x7c,
+	0x94, 0x7c, 0xed, 0x34, 0x47, 0xe4, 0x2d, 0xbc, 0xf9, 0xc7, 0x00, 0x21, 0xcd, 0xbb, 0x41, 0x18,
+	0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -25,6 +25,179 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Department is the kind of ward where the patient was cared for
+type Department int32
+
+const (
+	Department_DEPARTMENT_UNSPECIFIED Department = 0
+	Department_INTENSIVE_CARE         Department = 1
+	Department_NEONATAL               Department = 2
+	Department_PAEDIATRICS            Department = 3
+	Department_SURGICAL               Department = 4
+	Department_MEDICAL                Department = 5
+	Department_MATERNITY              Department = 6
+	Department_EMERGENCY              Department = 7
+	Department_OUTPATIENT_CLINIC      Department = 8
+)
+
+var Department_name = map[int32]string{
+	0: "DEPARTMENT_UNSPECIFIED",
+	1: "INTENSIVE_CARE",
+	2: "NEONATAL",
+	3: "PAEDIATRICS",
+	4: "SURGICAL",
+	5: "MEDICAL",
+	6: "MATERNITY",
+	7: "EMERGENCY",
+	8: "OUTPATIENT_CLINIC",
+}
+
+var Department_value = map[string]int32{
+	"DEPARTMENT_UNSPECIFIED": 0,
+	"INTENSIVE_CARE":         1,
+	"NEONATAL":               2,
+	"PAEDIATRICS":            3,
+	"SURGICAL":               4,
+	"MEDICAL":                5,
+	"MATERNITY":              6,
+	"EMERGENCY":              7,
+	"OUTPATIENT_CLINIC":      8,
+}
+
+func (x Department) String() string {
+	return proto.EnumName(Department_name, int32(x))
+}
+
+func (Department) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{0}
+}
+
+// PatientSetting is where the patient was when the specimen was collected
+type PatientSetting int32
+
+const (
+	PatientSetting_SETTING_UNSPECIFIED PatientSetting = 0
+	PatientSetting_INPATIENT           PatientSetting = 1
+	PatientSetting_OUTPATIENT          PatientSetting = 2
+	// Specimens from the community e.g surveys
+	PatientSetting_COMMUNITY PatientSetting = 3
+)
+
+var PatientSetting_name = map[int32]string{
+	0: "SETTING_UNSPECIFIED",
+	1: "INPATIENT",
+	2: "OUTPATIENT",
+	3: "COMMUNITY",
+}
+
+var PatientSetting_value = map[string]int32{
+	"SETTING_UNSPECIFIED": 0,
+	"INPATIENT":           1,
+	"OUTPATIENT":          2,
+	"COMMUNITY":           3,
+}
+
+func (x PatientSetting) String() string {
+	return proto.EnumName(PatientSetting_name, int32(x))
+}
+
+func (PatientSetting) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{1}
+}
+
+// SpecimenType is the kind of specimen that was cultured
+type SpecimenType int32
+
+const (
+	SpecimenType_SPECIMEN_UNSPECIFIED SpecimenType = 0
+	SpecimenType_BLOOD                SpecimenType = 1
+	SpecimenType_URINE                SpecimenType = 2
+	SpecimenType_CEREBROSPINAL_FLUID  SpecimenType = 3
+	SpecimenType_SPUTUM               SpecimenType = 4
+	SpecimenType_WOUND_SWAB           SpecimenType = 5
+	SpecimenType_PUS                  SpecimenType = 6
+	SpecimenType_STOOL                SpecimenType = 7
+	SpecimenType_TISSUE               SpecimenType = 8
+	SpecimenType_GENITAL_SWAB         SpecimenType = 9
+	SpecimenType_OTHER_SPECIMEN       SpecimenType = 10
+)
+
+var SpecimenType_name = map[int32]string{
+	0:  "SPECIMEN_UNSPECIFIED",
+	1:  "BLOOD",
+	2:  "URINE",
+	3:  "CEREBROSPINAL_FLUID",
+	4:  "SPUTUM",
+	5:  "WOUND_SWAB",
+	6:  "PUS",
+	7:  "STOOL",
+	8:  "TISSUE",
+	9:  "GENITAL_SWAB",
+	10: "OTHER_SPECIMEN",
+}
+
+var SpecimenType_value = map[string]int32{
+	"SPECIMEN_UNSPECIFIED": 0,
+	"BLOOD":                1,
+	"URINE":                2,
+	"CEREBROSPINAL_FLUID":  3,
+	"SPUTUM":               4,
+	"WOUND_SWAB":           5,
+	"PUS":                  6,
+	"STOOL":                7,
+	"TISSUE":               8,
+	"GENITAL_SWAB":         9,
+	"OTHER_SPECIMEN":       10,
+}
+
+func (x SpecimenType) String() string {
+	return proto.EnumName(SpecimenType_name, int32(x))
+}
+
+func (SpecimenType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{2}
+}
+
+// CultureStatus is the stage of the specimen of a culture in the laboratory
+type CultureStatus int32
+
+const (
+	// Default status. Susceptibility results are final
+	CultureStatus_FINAL CultureStatus = 0
+	// The specimen was received by the laboratory
+	CultureStatus_RECEIVED CultureStatus = 1
+	// Growth was detected in the culture
+	CultureStatus_GROWTH_DETECTED CultureStatus = 2
+	// Organisms were identified. Susceptibility results if any are preliminary
+	CultureStatus_PRELIMINARY CultureStatus = 3
+	// Final results were changed
+	CultureStatus_AMENDED CultureStatus = 4
+)
+
+var CultureStatus_name = map[int32]string{
+	0: "FINAL",
+	1: "RECEIVED",
+	2: "GROWTH_DETECTED",
+	3: "PRELIMINARY",
+	4: "AMENDED",
+}
+
+var CultureStatus_value = map[string]int32{
+	"FINAL":           0,
+	"RECEIVED":        1,
+	"GROWTH_DETECTED": 2,
+	"PRELIMINARY":     3,
+	"AMENDED":         4,
+}
+
+func (x CultureStatus) String() string {
+	return proto.EnumName(CultureStatus_name, int32(x))
+}
+
+func (CultureStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{3}
+}
+
 // Label is tag/boundary of antimicrobial used for culturing based on its action against the pathogen
 type Label int32
 
@@ -58,23 +231,43 @@ func (x Label) String() string {
 }
 
 func (Label) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{0}
+	return fileDescriptor_f015e82c8f4873ba, []int{4}
 }
 
 // TestMethod is method used to conduct the testing
 type TestMethod int32
 
 const (
-	// Default test method. Widely used
-	TestMethod_DISK_DIFFUSION TestMethod = 0
+	// Not given. Created cultures default to disk diffusion and updated cultures keep their test method
+	TestMethod_TEST_METHOD_UNSPECIFIED TestMethod = 0
+	// Default test method. Widely used. Results carry a disk diameter
+	TestMethod_DISK_DIFFUSION TestMethod = 5
+	// Serial dilutions in broth. Results carry an MIC
+	TestMethod_BROTH_MICRODILUTION TestMethod = 1
+	// Gradient strips such as Etest. Results carry an MIC
+	TestMethod_GRADIENT_STRIP TestMethod = 2
+	// VITEK automated system. Results carry an MIC
+	TestMethod_VITEK TestMethod = 3
+	// Phoenix automated system. Results carry an MIC
+	TestMethod_PHOENIX TestMethod = 4
 )
 
 var TestMethod_name = map[int32]string{
-	0: "DISK_DIFFUSION",
+	0: "TEST_METHOD_UNSPECIFIED",
+	5: "DISK_DIFFUSION",
+	1: "BROTH_MICRODILUTION",
+	2: "GRADIENT_STRIP",
+	3: "VITEK",
+	4: "PHOENIX",
 }
 
 var TestMethod_value = map[string]int32{
-	"DISK_DIFFUSION": 0,
+	"TEST_METHOD_UNSPECIFIED": 0,
+	"DISK_DIFFUSION":          5,
+	"BROTH_MICRODILUTION":     1,
+	"GRADIENT_STRIP":          2,
+	"VITEK":                   3,
+	"PHOENIX":                 4,
 }
 
 func (x TestMethod) String() string {
@@ -82,7 +275,115 @@ func (x TestMethod) String() string {
 }
 
 func (TestMethod) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{1}
+	return fileDescriptor_f015e82c8f4873ba, []int{5}
+}
+
+// Comparator qualifies off-scale measurements
+type Comparator int32
+
+const (
+	Comparator_EQUAL                 Comparator = 0
+	Comparator_LESS_THAN             Comparator = 1
+	Comparator_LESS_THAN_OR_EQUAL    Comparator = 2
+	Comparator_GREATER_THAN          Comparator = 3
+	Comparator_GREATER_THAN_OR_EQUAL Comparator = 4
+)
+
+var Comparator_name = map[int32]string{
+	0: "EQUAL",
+	1: "LESS_THAN",
+	2: "LESS_THAN_OR_EQUAL",
+	3: "GREATER_THAN",
+	4: "GREATER_THAN_OR_EQUAL",
+}
+
+var Comparator_value = map[string]int32{
+	"EQUAL":                 0,
+	"LESS_THAN":             1,
+	"LESS_THAN_OR_EQUAL":    2,
+	"GREATER_THAN":          3,
+	"GREATER_THAN_OR_EQUAL": 4,
+}
+
+func (x Comparator) String() string {
+	return proto.EnumName(Comparator_name, int32(x))
+}
+
+func (Comparator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{6}
+}
+
+// ResistanceClass classifies an isolate by the antimicrobial categories it is non-susceptible to.
+// Definitions follow Magiorakos et al. (ECDC/CDC, 2012).
+type ResistanceClass int32
+
+const (
+	// The pathogen has no category definitions or too few categories were tested
+	ResistanceClass_UNCLASSIFIED ResistanceClass = 0
+	// Non-susceptible to agents in fewer than three categories
+	ResistanceClass_NON_MDR ResistanceClass = 1
+	// Multidrug-resistant. Non-susceptible to at least one agent in three or more categories
+	ResistanceClass_MDR ResistanceClass = 2
+	// Extensively drug-resistant. Non-susceptible to at least one agent in all but two or fewer categories
+	ResistanceClass_XDR ResistanceClass = 3
+	// Pandrug-resistant. Non-susceptible to all agents in all categories
+	ResistanceClass_PDR ResistanceClass = 4
+)
+
+var ResistanceClass_name = map[int32]string{
+	0: "UNCLASSIFIED",
+	1: "NON_MDR",
+	2: "MDR",
+	3: "XDR",
+	4: "PDR",
+}
+
+var ResistanceClass_value = map[string]int32{
+	"UNCLASSIFIED": 0,
+	"NON_MDR":      1,
+	"MDR":          2,
+	"XDR":          3,
+	"PDR":          4,
+}
+
+func (x ResistanceClass) String() string {
+	return proto.EnumName(ResistanceClass_name, int32(x))
+}
+
+func (ResistanceClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{7}
+}
+
+// RevisionOperation is the operation that created a revision of a culture
+type RevisionOperation int32
+
+const (
+	RevisionOperation_CREATED  RevisionOperation = 0
+	RevisionOperation_UPDATED  RevisionOperation = 1
+	RevisionOperation_DELETED  RevisionOperation = 2
+	RevisionOperation_RESTORED RevisionOperation = 3
+)
+
+var RevisionOperation_name = map[int32]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "DELETED",
+	3: "RESTORED",
+}
+
+var RevisionOperation_value = map[string]int32{
+	"CREATED":  0,
+	"UPDATED":  1,
+	"DELETED":  2,
+	"RESTORED": 3,
+}
+
+func (x RevisionOperation) String() string {
+	return proto.EnumName(RevisionOperation_name, int32(x))
+}
+
+func (RevisionOperation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{8}
 }
 
 // ListTarget is the culture target
@@ -120,29 +421,126 @@ func (x ListTarget) String() string {
 }
 
 func (ListTarget) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{2}
+	return fileDescriptor_f015e82c8f4873ba, []int{9}
+}
+
+// CultureSort is the order of listed cultures
+type CultureSort int32
+
+const (
+	// Latest results first
+	CultureSort_RESULTS_NEWEST_FIRST CultureSort = 0
+	// Earliest results first
+	CultureSort_RESULTS_OLDEST_FIRST CultureSort = 1
+)
+
+var CultureSort_name = map[int32]string{
+	0: "RESULTS_NEWEST_FIRST",
+	1: "RESULTS_OLDEST_FIRST",
+}
+
+var CultureSort_value = map[string]int32{
+	"RESULTS_NEWEST_FIRST": 0,
+	"RESULTS_OLDEST_FIRST": 1,
+}
+
+func (x CultureSort) String() string {
+	return proto.EnumName(CultureSort_name, int32(x))
+}
+
+func (CultureSort) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{10}
+}
+
+// ImportFormat is the layout of files of cultures to import
+type ImportFormat int32
+
+const (
+	// Comma separated values with a header row. Columns are named by the mapping.
+	ImportFormat_CSV ImportFormat = 0
+	// Tab separated WHONET export. Columns default to WHONET field names and antimicrobial codes.
+	ImportFormat_WHONET ImportFormat = 1
+)
+
+var ImportFormat_name = map[int32]string{
+	0: "CSV",
+	1: "WHONET",
+}
+
+var ImportFormat_value = map[string]int32{
+	"CSV":    0,
+	"WHONET": 1,
+}
+
+func (x ImportFormat) String() string {
+	return proto.EnumName(ImportFormat_name, int32(x))
+}
+
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{11}
+}
+
+// ExportFormat is the file format of exported results
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_CSV ExportFormat = 0
+	// One JSON object per line
+	ExportFormat_EXPORT_NDJSON  ExportFormat = 1
+	ExportFormat_EXPORT_PARQUET ExportFormat = 2
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "EXPORT_CSV",
+	1: "EXPORT_NDJSON",
+	2: "EXPORT_PARQUET",
+}
+
+var ExportFormat_value = map[string]int32{
+	"EXPORT_CSV":     0,
+	"EXPORT_NDJSON":  1,
+	"EXPORT_PARQUET": 2,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{12}
 }
 
 // Culture is a lab result after culturing process
 type Culture struct {
-	CultureId            string           `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
-	LabTechId            string           `protobuf:"bytes,2,opt,name=lab_tech_id,json=labTechId,proto3" json:"lab_tech_id,omitempty"`
-	HospitalId           string           `protobuf:"bytes,3,opt,name=hospital_id,json=hospitalId,proto3" json:"hospital_id,omitempty"`
-	CountyCode           string           `protobuf:"bytes,4,opt,name=county_code,json=countyCode,proto3" json:"county_code,omitempty"`
-	SubCountyCode        string           `protobuf:"bytes,5,opt,name=sub_county_code,json=subCountyCode,proto3" json:"sub_county_code,omitempty"`
-	PatientId            string           `protobuf:"bytes,6,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
-	PatientGender        string           `protobuf:"bytes,7,opt,name=patient_gender,json=patientGender,proto3" json:"patient_gender,omitempty"`
-	PatientAge           int32            `protobuf:"varint,8,opt,name=patient_age,json=patientAge,proto3" json:"patient_age,omitempty"`
-	Editors              []string         `protobuf:"bytes,9,rep,name=editors,proto3" json:"editors,omitempty"`
-	TestMethod           TestMethod       `protobuf:"varint,10,opt,name=test_method,json=testMethod,proto3,enum=antibug.culture.TestMethod" json:"test_method,omitempty"`
-	CultureSource        string           `protobuf:"bytes,11,opt,name=culture_source,json=cultureSource,proto3" json:"culture_source,omitempty"`
-	PathogensFound       []string         `protobuf:"bytes,12,rep,name=pathogens_found,json=pathogensFound,proto3" json:"pathogens_found,omitempty"`
-	AntimicrobialsUsed   []string         `protobuf:"bytes,13,rep,name=antimicrobials_used,json=antimicrobialsUsed,proto3" json:"antimicrobials_used,omitempty"`
-	CultureResults       []*LabTestResult `protobuf:"bytes,14,rep,name=culture_results,json=cultureResults,proto3" json:"culture_results,omitempty"`
-	ResultsTimestampSec  int64            `protobuf:"varint,15,opt,name=results_timestamp_sec,json=resultsTimestampSec,proto3" json:"results_timestamp_sec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	CultureId     string `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	LabTechId     string `protobuf:"bytes,2,opt,name=lab_tech_id,json=labTechId,proto3" json:"lab_tech_id,omitempty"`
+	HospitalId    string `protobuf:"bytes,3,opt,name=hospital_id,json=hospitalId,proto3" json:"hospital_id,omitempty"`
+	CountyCode    string `protobuf:"bytes,4,opt,name=county_code,json=countyCode,proto3" json:"county_code,omitempty"`
+	SubCountyCode string `protobuf:"bytes,5,opt,name=sub_county_code,json=subCountyCode,proto3" json:"sub_county_code,omitempty"`
+	// Patient ids are replaced by pseudonyms when cultures are saved
+	PatientId              string                   `protobuf:"bytes,6,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	PatientGender          string                   `protobuf:"bytes,7,opt,name=patient_gender,json=patientGender,proto3" json:"patient_gender,omitempty"`
+	PatientAge             int32                    `protobuf:"varint,8,opt,name=patient_age,json=patientAge,proto3" json:"patient_age,omitempty"`
+	Editors                []string                 `protobuf:"bytes,9,rep,name=editors,proto3" json:"editors,omitempty"`
+	TestMethod             TestMethod               `protobuf:"varint,10,opt,name=test_method,json=testMethod,proto3,enum=antibug.culture.TestMethod" json:"test_method,omitempty"`
+	CultureSource          string                   `protobuf:"bytes,11,opt,name=culture_source,json=cultureSource,proto3" json:"culture_source,omitempty"`
+	PathogensFound         []string                 `protobuf:"bytes,12,rep,name=pathogens_found,json=pathogensFound,proto3" json:"pathogens_found,omitempty"`
+	AntimicrobialsUsed     []string                 `protobuf:"bytes,13,rep,name=antimicrobials_used,json=antimicrobialsUsed,proto3" json:"antimicrobials_used,omitempty"`
+	CultureResults         []*LabTestResult         `protobuf:"bytes,14,rep,name=culture_results,json=cultureResults,proto3" json:"culture_results,omitempty"`
+	ResultsTimestampSec    int64                    `protobuf:"varint,15,opt,name=results_timestamp_sec,json=resultsTimestampSec,proto3" json:"results_timestamp_sec,omitempty"`
+	IsolateClassifications []*IsolateClassification `protobuf:"bytes,16,rep,name=isolate_classifications,json=isolateClassifications,proto3" json:"isolate_classifications,omitempty"`
+	// Stage of the specimen. Only final and amended results are counted in antibiograms.
+	Status CultureStatus `protobuf:"varint,17,opt,name=status,proto3,enum=antibug.culture.CultureStatus" json:"status,omitempty"`
+	// Ward of the facility where the patient was when the specimen was collected e.g "Ward 4B"
+	Ward           string         `protobuf:"bytes,18,opt,name=ward,proto3" json:"ward,omitempty"`
+	Department     Department     `protobuf:"varint,19,opt,name=department,proto3,enum=antibug.culture.Department" json:"department,omitempty"`
+	PatientSetting PatientSetting `protobuf:"varint,20,opt,name=patient_setting,json=patientSetting,proto3,enum=antibug.culture.PatientSetting" json:"patient_setting,omitempty"`
+	SpecimenType   SpecimenType   `protobuf:"varint,21,opt,name=specimen_type,json=specimenType,proto3,enum=antibug.culture.SpecimenType" json:"specimen_type,omitempty"`
+	// Zero when the collection time is unknown
+	SpecimenCollectedTimestampSec int64    `protobuf:"varint,22,opt,name=specimen_collected_timestamp_sec,json=specimenCollectedTimestampSec,proto3" json:"specimen_collected_timestamp_sec,omitempty"`
+	XXX_NoUnkeyedLiteral          struct{} `json:"-"`
+	XXX_unrecognized              []byte   `json:"-"`
+	XXX_sizecache                 int32    `json:"-"`
 }
 
 func (m *Culture) Reset()         { *m = Culture{} }
@@ -237,7 +635,7 @@ func (m *Culture) GetTestMethod() TestMethod {
 	if m != nil {
 		return m.TestMethod
 	}
-	return TestMethod_DISK_DIFFUSION
+	return TestMethod_TEST_METHOD_UNSPECIFIED
 }
 
 func (m *Culture) GetCultureSource() string {
@@ -275,6 +673,55 @@ func (m *Culture) GetResultsTimestampSec() int64 {
 	return 0
 }
 
+func (m *Culture) GetIsolateClassifications() []*IsolateClassification {
+	if m != nil {
+		return m.IsolateClassifications
+	}
+	return nil
+}
+
+func (m *Culture) GetStatus() CultureStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CultureStatus_FINAL
+}
+
+func (m *Culture) GetWard() string {
+	if m != nil {
+		return m.Ward
+	}
+	return ""
+}
+
+func (m *Culture) GetDepartment() Department {
+	if m != nil {
+		return m.Department
+	}
+	return Department_DEPARTMENT_UNSPECIFIED
+}
+
+func (m *Culture) GetPatientSetting() PatientSetting {
+	if m != nil {
+		return m.PatientSetting
+	}
+	return PatientSetting_SETTING_UNSPECIFIED
+}
+
+func (m *Culture) GetSpecimenType() SpecimenType {
+	if m != nil {
+		return m.SpecimenType
+	}
+	return SpecimenType_SPECIMEN_UNSPECIFIED
+}
+
+func (m *Culture) GetSpecimenCollectedTimestampSec() int64 {
+	if m != nil {
+		return m.SpecimenCollectedTimestampSec
+	}
+	return 0
+}
+
 // Pathogen is a micro-organism causing infection
 type Pathogen struct {
 	PathogenId           string   `protobuf:"bytes,1,opt,name=pathogen_id,json=pathogenId,proto3" json:"pathogen_id,omitempty"`
@@ -387,27 +834,94 @@ func (m *Antimicrobial) GetAntimicrobialClass() string {
 	return ""
 }
 
-// LabTestResult is a single result obtained after the culturing process
-type LabTestResult struct {
-	PathogenName         string   `protobuf:"bytes,1,opt,name=pathogen_name,json=pathogenName,proto3" json:"pathogen_name,omitempty"`
-	PathogenId           string   `protobuf:"bytes,2,opt,name=pathogen_id,json=pathogenId,proto3" json:"pathogen_id,omitempty"`
-	AntimicrobialId      string   `protobuf:"bytes,3,opt,name=antimicrobial_id,json=antimicrobialId,proto3" json:"antimicrobial_id,omitempty"`
-	AntimicrobialName    string   `protobuf:"bytes,4,opt,name=antimicrobial_name,json=antimicrobialName,proto3" json:"antimicrobial_name,omitempty"`
-	DiskDiameter         string   `protobuf:"bytes,5,opt,name=disk_diameter,json=diskDiameter,proto3" json:"disk_diameter,omitempty"`
-	NonDiffusionResult   string   `protobuf:"bytes,6,opt,name=non_diffusion_result,json=nonDiffusionResult,proto3" json:"non_diffusion_result,omitempty"`
-	ResultComment        string   `protobuf:"bytes,7,opt,name=result_comment,json=resultComment,proto3" json:"result_comment,omitempty"`
-	SusceptibilityScore  float32  `protobuf:"fixed32,8,opt,name=susceptibility_score,json=susceptibilityScore,proto3" json:"susceptibility_score,omitempty"`
-	Label                Label    `protobuf:"varint,9,opt,name=label,proto3,enum=antibug.culture.Label" json:"label,omitempty"`
+// MIC is the minimum inhibitory concentration of an antimicrobial against a pathogen
+type MIC struct {
+	Value      float64    `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Comparator Comparator `protobuf:"varint,2,opt,name=comparator,proto3,enum=antibug.culture.Comparator" json:"comparator,omitempty"`
+	// Unit of the value e.g mg/L or ug/mL
+	Unit                 string   `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *MIC) Reset()         { *m = MIC{} }
+func (m *MIC) String() string { return proto.CompactTextString(m) }
+func (*MIC) ProtoMessage()    {}
+func (*MIC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{3}
+}
+
+func (m *MIC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MIC.Unmarshal(m, b)
+}
+func (m *MIC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MIC.Marshal(b, m, deterministic)
+}
+func (m *MIC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MIC.Merge(m, src)
+}
+func (m *MIC) XXX_Size() int {
+	return xxx_messageInfo_MIC.Size(m)
+}
+func (m *MIC) XXX_DiscardUnknown() {
+	xxx_messageInfo_MIC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MIC proto.InternalMessageInfo
+
+func (m *MIC) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *MIC) GetComparator() Comparator {
+	if m != nil {
+		return m.Comparator
+	}
+	return Comparator_EQUAL
+}
+
+func (m *MIC) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
+}
+
+// LabTestResult is a single result obtained after the culturing process
+type LabTestResult struct {
+	PathogenName        string  `protobuf:"bytes,1,opt,name=pathogen_name,json=pathogenName,proto3" json:"pathogen_name,omitempty"`
+	PathogenId          string  `protobuf:"bytes,2,opt,name=pathogen_id,json=pathogenId,proto3" json:"pathogen_id,omitempty"`
+	AntimicrobialId     string  `protobuf:"bytes,3,opt,name=antimicrobial_id,json=antimicrobialId,proto3" json:"antimicrobial_id,omitempty"`
+	AntimicrobialName   string  `protobuf:"bytes,4,opt,name=antimicrobial_name,json=antimicrobialName,proto3" json:"antimicrobial_name,omitempty"`
+	DiskDiameter        string  `protobuf:"bytes,5,opt,name=disk_diameter,json=diskDiameter,proto3" json:"disk_diameter,omitempty"`
+	NonDiffusionResult  string  `protobuf:"bytes,6,opt,name=non_diffusion_result,json=nonDiffusionResult,proto3" json:"non_diffusion_result,omitempty"`
+	ResultComment       string  `protobuf:"bytes,7,opt,name=result_comment,json=resultComment,proto3" json:"result_comment,omitempty"`
+	SusceptibilityScore float32 `protobuf:"fixed32,8,opt,name=susceptibility_score,json=susceptibilityScore,proto3" json:"susceptibility_score,omitempty"`
+	Label               Label   `protobuf:"varint,9,opt,name=label,proto3,enum=antibug.culture.Label" json:"label,omitempty"`
+	// Label entered by the lab technician. Label is interpreted from breakpoints when available.
+	ReportedLabel Label `protobuf:"varint,10,opt,name=reported_label,json=reportedLabel,proto3,enum=antibug.culture.Label" json:"reported_label,omitempty"`
+	// Reported label differs from the label interpreted from breakpoints
+	LabelDisagreement bool `protobuf:"varint,11,opt,name=label_disagreement,json=labelDisagreement,proto3" json:"label_disagreement,omitempty"`
+	// Breakpoint table used to interpret the result e.g "CLSI M100-ED30"
+	BreakpointVersion string `protobuf:"bytes,12,opt,name=breakpoint_version,json=breakpointVersion,proto3" json:"breakpoint_version,omitempty"`
+	// Required for test methods other than disk diffusion
+	Mic *MIC `protobuf:"bytes,13,opt,name=mic,proto3" json:"mic,omitempty"`
+	// Expert rules the result violated when it was saved
+	RuleAnnotations      []*RuleAnnotation `protobuf:"bytes,14,rep,name=rule_annotations,json=ruleAnnotations,proto3" json:"rule_annotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
 func (m *LabTestResult) Reset()         { *m = LabTestResult{} }
 func (m *LabTestResult) String() string { return proto.CompactTextString(m) }
 func (*LabTestResult) ProtoMessage()    {}
 func (*LabTestResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{3}
+	return fileDescriptor_f015e82c8f4873ba, []int{4}
 }
 
 func (m *LabTestResult) XXX_Unmarshal(b []byte) error {
@@ -491,91 +1005,294 @@ func (m *LabTestResult) GetLabel() Label {
 	return Label_SUSCEPTIBLE
 }
 
-// CreateCultureRequest is request to add a culture
-type CreateCultureRequest struct {
-	Culture              *Culture `protobuf:"bytes,1,opt,name=culture,proto3" json:"culture,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *LabTestResult) GetReportedLabel() Label {
+	if m != nil {
+		return m.ReportedLabel
+	}
+	return Label_SUSCEPTIBLE
 }
 
-func (m *CreateCultureRequest) Reset()         { *m = CreateCultureRequest{} }
-func (m *CreateCultureRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateCultureRequest) ProtoMessage()    {}
-func (*CreateCultureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{4}
+func (m *LabTestResult) GetLabelDisagreement() bool {
+	if m != nil {
+		return m.LabelDisagreement
+	}
+	return false
 }
 
-func (m *CreateCultureRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCultureRequest.Unmarshal(m, b)
-}
-func (m *CreateCultureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCultureRequest.Marshal(b, m, deterministic)
-}
-func (m *CreateCultureRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCultureRequest.Merge(m, src)
-}
-func (m *CreateCultureRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateCultureRequest.Size(m)
-}
-func (m *CreateCultureRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCultureRequest.DiscardUnknown(m)
+func (m *LabTestResult) GetBreakpointVersion() string {
+	if m != nil {
+		return m.BreakpointVersion
+	}
+	return ""
 }
 
-var xxx_messageInfo_CreateCultureRequest proto.InternalMessageInfo
+func (m *LabTestResult) GetMic() *MIC {
+	if m != nil {
+		return m.Mic
+	}
+	return nil
+}
 
-func (m *CreateCultureRequest) GetCulture() *Culture {
+func (m *LabTestResult) GetRuleAnnotations() []*RuleAnnotation {
 	if m != nil {
-		return m.Culture
+		return m.RuleAnnotations
 	}
 	return nil
 }
 
-// CreateCultureResponse is response from CreateCultureRequest call
-type CreateCultureResponse struct {
-	CultureId            string   `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+// RuleAnnotation is a violation of an expert rule by a result that was saved with a warning
+type RuleAnnotation struct {
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// Rule set of the rule e.g "Antibug Expert Rules 2020.1"
+	RuleSet string `protobuf:"bytes,2,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The label was changed to the label expected by the rule. The entered label is the reported label.
+	Inferred             bool     `protobuf:"varint,4,opt,name=inferred,proto3" json:"inferred,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateCultureResponse) Reset()         { *m = CreateCultureResponse{} }
-func (m *CreateCultureResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateCultureResponse) ProtoMessage()    {}
-func (*CreateCultureResponse) Descriptor() ([]byte, []int) {
+func (m *RuleAnnotation) Reset()         { *m = RuleAnnotation{} }
+func (m *RuleAnnotation) String() string { return proto.CompactTextString(m) }
+func (*RuleAnnotation) ProtoMessage()    {}
+func (*RuleAnnotation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f015e82c8f4873ba, []int{5}
 }
 
-func (m *CreateCultureResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCultureResponse.Unmarshal(m, b)
+func (m *RuleAnnotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleAnnotation.Unmarshal(m, b)
 }
-func (m *CreateCultureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCultureResponse.Marshal(b, m, deterministic)
+func (m *RuleAnnotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleAnnotation.Marshal(b, m, deterministic)
 }
-func (m *CreateCultureResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCultureResponse.Merge(m, src)
+func (m *RuleAnnotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleAnnotation.Merge(m, src)
 }
-func (m *CreateCultureResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateCultureResponse.Size(m)
+func (m *RuleAnnotation) XXX_Size() int {
+	return xxx_messageInfo_RuleAnnotation.Size(m)
 }
-func (m *CreateCultureResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCultureResponse.DiscardUnknown(m)
+func (m *RuleAnnotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleAnnotation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCultureResponse proto.InternalMessageInfo
+var xxx_messageInfo_RuleAnnotation proto.InternalMessageInfo
 
-func (m *CreateCultureResponse) GetCultureId() string {
+func (m *RuleAnnotation) GetRuleId() string {
 	if m != nil {
-		return m.CultureId
+		return m.RuleId
 	}
 	return ""
 }
 
-// UpdateCultureRequest is request to update a culture resource
-type UpdateCultureRequest struct {
-	CultureId            string   `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
-	EditorId             string   `protobuf:"bytes,2,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
-	Culture              *Culture `protobuf:"bytes,3,opt,name=culture,proto3" json:"culture,omitempty"`
+func (m *RuleAnnotation) GetRuleSet() string {
+	if m != nil {
+		return m.RuleSet
+	}
+	return ""
+}
+
+func (m *RuleAnnotation) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *RuleAnnotation) GetInferred() bool {
+	if m != nil {
+		return m.Inferred
+	}
+	return false
+}
+
+// IsolateClassification is the resistance class of a pathogen isolated in a culture
+type IsolateClassification struct {
+	PathogenId               string          `protobuf:"bytes,1,opt,name=pathogen_id,json=pathogenId,proto3" json:"pathogen_id,omitempty"`
+	PathogenName             string          `protobuf:"bytes,2,opt,name=pathogen_name,json=pathogenName,proto3" json:"pathogen_name,omitempty"`
+	ResistanceClass          ResistanceClass `protobuf:"varint,3,opt,name=resistance_class,json=resistanceClass,proto3,enum=antibug.culture.ResistanceClass" json:"resistance_class,omitempty"`
+	CategoriesTested         int32           `protobuf:"varint,4,opt,name=categories_tested,json=categoriesTested,proto3" json:"categories_tested,omitempty"`
+	NonSusceptibleCategories []string        `protobuf:"bytes,5,rep,name=non_susceptible_categories,json=nonSusceptibleCategories,proto3" json:"non_susceptible_categories,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}        `json:"-"`
+	XXX_unrecognized         []byte          `json:"-"`
+	XXX_sizecache            int32           `json:"-"`
+}
+
+func (m *IsolateClassification) Reset()         { *m = IsolateClassification{} }
+func (m *IsolateClassification) String() string { return proto.CompactTextString(m) }
+func (*IsolateClassification) ProtoMessage()    {}
+func (*IsolateClassification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{6}
+}
+
+func (m *IsolateClassification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsolateClassification.Unmarshal(m, b)
+}
+func (m *IsolateClassification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsolateClassification.Marshal(b, m, deterministic)
+}
+func (m *IsolateClassification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsolateClassification.Merge(m, src)
+}
+func (m *IsolateClassification) XXX_Size() int {
+	return xxx_messageInfo_IsolateClassification.Size(m)
+}
+func (m *IsolateClassification) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsolateClassification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsolateClassification proto.InternalMessageInfo
+
+func (m *IsolateClassification) GetPathogenId() string {
+	if m != nil {
+		return m.PathogenId
+	}
+	return ""
+}
+
+func (m *IsolateClassification) GetPathogenName() string {
+	if m != nil {
+		return m.PathogenName
+	}
+	return ""
+}
+
+func (m *IsolateClassification) GetResistanceClass() ResistanceClass {
+	if m != nil {
+		return m.ResistanceClass
+	}
+	return ResistanceClass_UNCLASSIFIED
+}
+
+func (m *IsolateClassification) GetCategoriesTested() int32 {
+	if m != nil {
+		return m.CategoriesTested
+	}
+	return 0
+}
+
+func (m *IsolateClassification) GetNonSusceptibleCategories() []string {
+	if m != nil {
+		return m.NonSusceptibleCategories
+	}
+	return nil
+}
+
+// CreateCultureRequest is request to add a culture
+type CreateCultureRequest struct {
+	Culture *Culture `protobuf:"bytes,1,opt,name=culture,proto3" json:"culture,omitempty"`
+	// Retries with the same key return the culture created by the first request
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Saves the culture even when a culture of the same specimen exists
+	AllowDuplicate       bool     `protobuf:"varint,3,opt,name=allow_duplicate,json=allowDuplicate,proto3" json:"allow_duplicate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCultureRequest) Reset()         { *m = CreateCultureRequest{} }
+func (m *CreateCultureRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCultureRequest) ProtoMessage()    {}
+func (*CreateCultureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{7}
+}
+
+func (m *CreateCultureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCultureRequest.Unmarshal(m, b)
+}
+func (m *CreateCultureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCultureRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCultureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCultureRequest.Merge(m, src)
+}
+func (m *CreateCultureRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCultureRequest.Size(m)
+}
+func (m *CreateCultureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCultureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCultureRequest proto.InternalMessageInfo
+
+func (m *CreateCultureRequest) GetCulture() *Culture {
+	if m != nil {
+		return m.Culture
+	}
+	return nil
+}
+
+func (m *CreateCultureRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *CreateCultureRequest) GetAllowDuplicate() bool {
+	if m != nil {
+		return m.AllowDuplicate
+	}
+	return false
+}
+
+// CreateCultureResponse is response from CreateCultureRequest call
+type CreateCultureResponse struct {
+	CultureId string `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	// Expert rules violated by results of the culture
+	Warnings             []*RuleAnnotation `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateCultureResponse) Reset()         { *m = CreateCultureResponse{} }
+func (m *CreateCultureResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCultureResponse) ProtoMessage()    {}
+func (*CreateCultureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{8}
+}
+
+func (m *CreateCultureResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCultureResponse.Unmarshal(m, b)
+}
+func (m *CreateCultureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCultureResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateCultureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCultureResponse.Merge(m, src)
+}
+func (m *CreateCultureResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCultureResponse.Size(m)
+}
+func (m *CreateCultureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCultureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCultureResponse proto.InternalMessageInfo
+
+func (m *CreateCultureResponse) GetCultureId() string {
+	if m != nil {
+		return m.CultureId
+	}
+	return ""
+}
+
+func (m *CreateCultureResponse) GetWarnings() []*RuleAnnotation {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+// UpdateCultureRequest is request to update a culture resource
+type UpdateCultureRequest struct {
+	CultureId string `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	// Deprecated: the editor is the actor in the token
+	EditorId string   `protobuf:"bytes,2,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+	Culture  *Culture `protobuf:"bytes,3,opt,name=culture,proto3" json:"culture,omitempty"`
+	// Why the culture was changed. It is recorded in the revision.
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -585,7 +1302,7 @@ func (m *UpdateCultureRequest) Reset()         { *m = UpdateCultureRequest{} }
 func (m *UpdateCultureRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateCultureRequest) ProtoMessage()    {}
 func (*UpdateCultureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{6}
+	return fileDescriptor_f015e82c8f4873ba, []int{9}
 }
 
 func (m *UpdateCultureRequest) XXX_Unmarshal(b []byte) error {
@@ -627,9 +1344,59 @@ func (m *UpdateCultureRequest) GetCulture() *Culture {
 	return nil
 }
 
+func (m *UpdateCultureRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// UpdateCultureResponse is the response of updating a culture
+type UpdateCultureResponse struct {
+	// Expert rules violated by changed results of the culture
+	Warnings             []*RuleAnnotation `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateCultureResponse) Reset()         { *m = UpdateCultureResponse{} }
+func (m *UpdateCultureResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateCultureResponse) ProtoMessage()    {}
+func (*UpdateCultureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{10}
+}
+
+func (m *UpdateCultureResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCultureResponse.Unmarshal(m, b)
+}
+func (m *UpdateCultureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCultureResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateCultureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCultureResponse.Merge(m, src)
+}
+func (m *UpdateCultureResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateCultureResponse.Size(m)
+}
+func (m *UpdateCultureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCultureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCultureResponse proto.InternalMessageInfo
+
+func (m *UpdateCultureResponse) GetWarnings() []*RuleAnnotation {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 // DeleteCultureRequest is request to delete a culture resource
 type DeleteCultureRequest struct {
-	CultureId            string   `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	CultureId string `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	// Why the culture was deleted. It is recorded in the revision.
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -639,7 +1406,7 @@ func (m *DeleteCultureRequest) Reset()         { *m = DeleteCultureRequest{} }
 func (m *DeleteCultureRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteCultureRequest) ProtoMessage()    {}
 func (*DeleteCultureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{7}
+	return fileDescriptor_f015e82c8f4873ba, []int{11}
 }
 
 func (m *DeleteCultureRequest) XXX_Unmarshal(b []byte) error {
@@ -667,382 +1434,1682 @@ func (m *DeleteCultureRequest) GetCultureId() string {
 	return ""
 }
 
-// DateFilter is filter option by date
-type DateFilter struct {
-	StartTimestampSec    int64    `protobuf:"varint,1,opt,name=start_timestamp_sec,json=startTimestampSec,proto3" json:"start_timestamp_sec,omitempty"`
-	EndTimestampSec      int64    `protobuf:"varint,2,opt,name=end_timestamp_sec,json=endTimestampSec,proto3" json:"end_timestamp_sec,omitempty"`
-	Filter               bool     `protobuf:"varint,3,opt,name=filter,proto3" json:"filter,omitempty"`
+func (m *DeleteCultureRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// TransitionCultureRequest is request to move a culture to another status
+type TransitionCultureRequest struct {
+	CultureId string        `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	Status    CultureStatus `protobuf:"varint,2,opt,name=status,proto3,enum=antibug.culture.CultureStatus" json:"status,omitempty"`
+	// Why the culture was moved. It is recorded in the revision.
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DateFilter) Reset()         { *m = DateFilter{} }
-func (m *DateFilter) String() string { return proto.CompactTextString(m) }
-func (*DateFilter) ProtoMessage()    {}
-func (*DateFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{8}
+func (m *TransitionCultureRequest) Reset()         { *m = TransitionCultureRequest{} }
+func (m *TransitionCultureRequest) String() string { return proto.CompactTextString(m) }
+func (*TransitionCultureRequest) ProtoMessage()    {}
+func (*TransitionCultureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{12}
 }
 
-func (m *DateFilter) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DateFilter.Unmarshal(m, b)
+func (m *TransitionCultureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransitionCultureRequest.Unmarshal(m, b)
 }
-func (m *DateFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DateFilter.Marshal(b, m, deterministic)
+func (m *TransitionCultureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransitionCultureRequest.Marshal(b, m, deterministic)
 }
-func (m *DateFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DateFilter.Merge(m, src)
+func (m *TransitionCultureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransitionCultureRequest.Merge(m, src)
 }
-func (m *DateFilter) XXX_Size() int {
-	return xxx_messageInfo_DateFilter.Size(m)
+func (m *TransitionCultureRequest) XXX_Size() int {
+	return xxx_messageInfo_TransitionCultureRequest.Size(m)
 }
-func (m *DateFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_DateFilter.DiscardUnknown(m)
+func (m *TransitionCultureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransitionCultureRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DateFilter proto.InternalMessageInfo
+var xxx_messageInfo_TransitionCultureRequest proto.InternalMessageInfo
 
-func (m *DateFilter) GetStartTimestampSec() int64 {
+func (m *TransitionCultureRequest) GetCultureId() string {
 	if m != nil {
-		return m.StartTimestampSec
+		return m.CultureId
 	}
-	return 0
+	return ""
 }
 
-func (m *DateFilter) GetEndTimestampSec() int64 {
+func (m *TransitionCultureRequest) GetStatus() CultureStatus {
 	if m != nil {
-		return m.EndTimestampSec
+		return m.Status
 	}
-	return 0
+	return CultureStatus_FINAL
 }
 
-func (m *DateFilter) GetFilter() bool {
+func (m *TransitionCultureRequest) GetReason() string {
 	if m != nil {
-		return m.Filter
+		return m.Reason
 	}
-	return false
+	return ""
 }
 
-// ListCultureFilter applies filter criteria to listing cultures
-type ListCultureFilter struct {
-	DateFilter           *DateFilter `protobuf:"bytes,1,opt,name=date_filter,json=dateFilter,proto3" json:"date_filter,omitempty"`
-	ListTarget           ListTarget  `protobuf:"varint,2,opt,name=list_target,json=listTarget,proto3,enum=antibug.culture.ListTarget" json:"list_target,omitempty"`
-	TargetIds            []string    `protobuf:"bytes,3,rep,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+// ReidentifyPatientRequest is request to retrieve the patient id behind a pseudonym
+type ReidentifyPatientRequest struct {
+	Pseudonym            string   `protobuf:"bytes,1,opt,name=pseudonym,proto3" json:"pseudonym,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListCultureFilter) Reset()         { *m = ListCultureFilter{} }
-func (m *ListCultureFilter) String() string { return proto.CompactTextString(m) }
-func (*ListCultureFilter) ProtoMessage()    {}
-func (*ListCultureFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{9}
+func (m *ReidentifyPatientRequest) Reset()         { *m = ReidentifyPatientRequest{} }
+func (m *ReidentifyPatientRequest) String() string { return proto.CompactTextString(m) }
+func (*ReidentifyPatientRequest) ProtoMessage()    {}
+func (*ReidentifyPatientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{13}
 }
 
-func (m *ListCultureFilter) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListCultureFilter.Unmarshal(m, b)
+func (m *ReidentifyPatientRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReidentifyPatientRequest.Unmarshal(m, b)
 }
-func (m *ListCultureFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListCultureFilter.Marshal(b, m, deterministic)
+func (m *ReidentifyPatientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReidentifyPatientRequest.Marshal(b, m, deterministic)
 }
-func (m *ListCultureFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListCultureFilter.Merge(m, src)
+func (m *ReidentifyPatientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReidentifyPatientRequest.Merge(m, src)
 }
-func (m *ListCultureFilter) XXX_Size() int {
-	return xxx_messageInfo_ListCultureFilter.Size(m)
+func (m *ReidentifyPatientRequest) XXX_Size() int {
+	return xxx_messageInfo_ReidentifyPatientRequest.Size(m)
 }
-func (m *ListCultureFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListCultureFilter.DiscardUnknown(m)
+func (m *ReidentifyPatientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReidentifyPatientRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListCultureFilter proto.InternalMessageInfo
+var xxx_messageInfo_ReidentifyPatientRequest proto.InternalMessageInfo
 
-func (m *ListCultureFilter) GetDateFilter() *DateFilter {
+func (m *ReidentifyPatientRequest) GetPseudonym() string {
 	if m != nil {
-		return m.DateFilter
+		return m.Pseudonym
 	}
-	return nil
+	return ""
 }
 
-func (m *ListCultureFilter) GetListTarget() ListTarget {
+// PatientIdentity is a patient id and its pseudonym. Cultures only store pseudonyms.
+type PatientIdentity struct {
+	Pseudonym            string   `protobuf:"bytes,1,opt,name=pseudonym,proto3" json:"pseudonym,omitempty"`
+	PatientId            string   `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PatientIdentity) Reset()         { *m = PatientIdentity{} }
+func (m *PatientIdentity) String() string { return proto.CompactTextString(m) }
+func (*PatientIdentity) ProtoMessage()    {}
+func (*PatientIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{14}
+}
+
+func (m *PatientIdentity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PatientIdentity.Unmarshal(m, b)
+}
+func (m *PatientIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PatientIdentity.Marshal(b, m, deterministic)
+}
+func (m *PatientIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatientIdentity.Merge(m, src)
+}
+func (m *PatientIdentity) XXX_Size() int {
+	return xxx_messageInfo_PatientIdentity.Size(m)
+}
+func (m *PatientIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatientIdentity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatientIdentity proto.InternalMessageInfo
+
+func (m *PatientIdentity) GetPseudonym() string {
 	if m != nil {
-		return m.ListTarget
+		return m.Pseudonym
 	}
-	return ListTarget_ALL
+	return ""
 }
 
-func (m *ListCultureFilter) GetTargetIds() []string {
+func (m *PatientIdentity) GetPatientId() string {
 	if m != nil {
-		return m.TargetIds
+		return m.PatientId
+	}
+	return ""
+}
+
+// FieldChange is a field of a culture that changed in a revision. Values are JSON encoded.
+// Fields of results are named by pathogen and antimicrobial e.g culture_results[eco/CIP].label
+type FieldChange struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue             string   `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue             string   `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldChange) Reset()         { *m = FieldChange{} }
+func (m *FieldChange) String() string { return proto.CompactTextString(m) }
+func (*FieldChange) ProtoMessage()    {}
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{15}
+}
+
+func (m *FieldChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldChange.Unmarshal(m, b)
+}
+func (m *FieldChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldChange.Marshal(b, m, deterministic)
+}
+func (m *FieldChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldChange.Merge(m, src)
+}
+func (m *FieldChange) XXX_Size() int {
+	return xxx_messageInfo_FieldChange.Size(m)
+}
+func (m *FieldChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldChange proto.InternalMessageInfo
+
+func (m *FieldChange) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *FieldChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *FieldChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+// CultureRevision is an immutable record of a change to a culture
+type CultureRevision struct {
+	CultureId string `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	// Revisions of a culture are numbered from 1
+	RevisionNumber int32             `protobuf:"varint,2,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	Operation      RevisionOperation `protobuf:"varint,3,opt,name=operation,proto3,enum=antibug.culture.RevisionOperation" json:"operation,omitempty"`
+	ActorId        string            `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason         string            `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	TimestampSec   int64             `protobuf:"varint,6,opt,name=timestamp_sec,json=timestampSec,proto3" json:"timestamp_sec,omitempty"`
+	Changes        []*FieldChange    `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	// The culture after the change, or before it for deletes
+	Culture *Culture `protobuf:"bytes,8,opt,name=culture,proto3" json:"culture,omitempty"`
+	// Revision whose culture was restored
+	RestoredRevision     int32    `protobuf:"varint,9,opt,name=restored_revision,json=restoredRevision,proto3" json:"restored_revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CultureRevision) Reset()         { *m = CultureRevision{} }
+func (m *CultureRevision) String() string { return proto.CompactTextString(m) }
+func (*CultureRevision) ProtoMessage()    {}
+func (*CultureRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{16}
+}
+
+func (m *CultureRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CultureRevision.Unmarshal(m, b)
+}
+func (m *CultureRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CultureRevision.Marshal(b, m, deterministic)
+}
+func (m *CultureRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CultureRevision.Merge(m, src)
+}
+func (m *CultureRevision) XXX_Size() int {
+	return xxx_messageInfo_CultureRevision.Size(m)
+}
+func (m *CultureRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_CultureRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CultureRevision proto.InternalMessageInfo
+
+func (m *CultureRevision) GetCultureId() string {
+	if m != nil {
+		return m.CultureId
+	}
+	return ""
+}
+
+func (m *CultureRevision) GetRevisionNumber() int32 {
+	if m != nil {
+		return m.RevisionNumber
+	}
+	return 0
+}
+
+func (m *CultureRevision) GetOperation() RevisionOperation {
+	if m != nil {
+		return m.Operation
+	}
+	return RevisionOperation_CREATED
+}
+
+func (m *CultureRevision) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *CultureRevision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CultureRevision) GetTimestampSec() int64 {
+	if m != nil {
+		return m.TimestampSec
+	}
+	return 0
+}
+
+func (m *CultureRevision) GetChanges() []*FieldChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *CultureRevision) GetCulture() *Culture {
+	if m != nil {
+		return m.Culture
+	}
+	return nil
+}
+
+func (m *CultureRevision) GetRestoredRevision() int32 {
+	if m != nil {
+		return m.RestoredRevision
+	}
+	return 0
+}
+
+// ListCultureRevisionsRequest is request to retrieve revisions of a culture, latest first
+type ListCultureRevisionsRequest struct {
+	CultureId string `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	// Opaque token of next_page_token from the previous page. Empty for the first page.
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCultureRevisionsRequest) Reset()         { *m = ListCultureRevisionsRequest{} }
+func (m *ListCultureRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCultureRevisionsRequest) ProtoMessage()    {}
+func (*ListCultureRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{17}
+}
+
+func (m *ListCultureRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCultureRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ListCultureRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCultureRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCultureRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCultureRevisionsRequest.Merge(m, src)
+}
+func (m *ListCultureRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCultureRevisionsRequest.Size(m)
+}
+func (m *ListCultureRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCultureRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCultureRevisionsRequest proto.InternalMessageInfo
+
+func (m *ListCultureRevisionsRequest) GetCultureId() string {
+	if m != nil {
+		return m.CultureId
+	}
+	return ""
+}
+
+func (m *ListCultureRevisionsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListCultureRevisionsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+// CultureRevisions is collection of revisions of a culture
+type CultureRevisions struct {
+	Revisions []*CultureRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// Empty on the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CultureRevisions) Reset()         { *m = CultureRevisions{} }
+func (m *CultureRevisions) String() string { return proto.CompactTextString(m) }
+func (*CultureRevisions) ProtoMessage()    {}
+func (*CultureRevisions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{18}
+}
+
+func (m *CultureRevisions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CultureRevisions.Unmarshal(m, b)
+}
+func (m *CultureRevisions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CultureRevisions.Marshal(b, m, deterministic)
+}
+func (m *CultureRevisions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CultureRevisions.Merge(m, src)
+}
+func (m *CultureRevisions) XXX_Size() int {
+	return xxx_messageInfo_CultureRevisions.Size(m)
+}
+func (m *CultureRevisions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CultureRevisions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CultureRevisions proto.InternalMessageInfo
+
+func (m *CultureRevisions) GetRevisions() []*CultureRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *CultureRevisions) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+// GetCultureRevisionRequest is request to retrieve a revision of a culture
+type GetCultureRevisionRequest struct {
+	CultureId            string   `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	RevisionNumber       int32    `protobuf:"varint,2,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCultureRevisionRequest) Reset()         { *m = GetCultureRevisionRequest{} }
+func (m *GetCultureRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCultureRevisionRequest) ProtoMessage()    {}
+func (*GetCultureRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{19}
+}
+
+func (m *GetCultureRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCultureRevisionRequest.Unmarshal(m, b)
+}
+func (m *GetCultureRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCultureRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCultureRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCultureRevisionRequest.Merge(m, src)
+}
+func (m *GetCultureRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCultureRevisionRequest.Size(m)
+}
+func (m *GetCultureRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCultureRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCultureRevisionRequest proto.InternalMessageInfo
+
+func (m *GetCultureRevisionRequest) GetCultureId() string {
+	if m != nil {
+		return m.CultureId
+	}
+	return ""
+}
+
+func (m *GetCultureRevisionRequest) GetRevisionNumber() int32 {
+	if m != nil {
+		return m.RevisionNumber
+	}
+	return 0
+}
+
+// RestoreCultureRevisionRequest is request to restore a culture to a previous revision.
+// Deleted cultures are restored too.
+type RestoreCultureRevisionRequest struct {
+	CultureId            string   `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	RevisionNumber       int32    `protobuf:"varint,2,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreCultureRevisionRequest) Reset()         { *m = RestoreCultureRevisionRequest{} }
+func (m *RestoreCultureRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreCultureRevisionRequest) ProtoMessage()    {}
+func (*RestoreCultureRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{20}
+}
+
+func (m *RestoreCultureRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreCultureRevisionRequest.Unmarshal(m, b)
+}
+func (m *RestoreCultureRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreCultureRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreCultureRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreCultureRevisionRequest.Merge(m, src)
+}
+func (m *RestoreCultureRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreCultureRevisionRequest.Size(m)
+}
+func (m *RestoreCultureRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreCultureRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreCultureRevisionRequest proto.InternalMessageInfo
+
+func (m *RestoreCultureRevisionRequest) GetCultureId() string {
+	if m != nil {
+		return m.CultureId
+	}
+	return ""
+}
+
+func (m *RestoreCultureRevisionRequest) GetRevisionNumber() int32 {
+	if m != nil {
+		return m.RevisionNumber
+	}
+	return 0
+}
+
+func (m *RestoreCultureRevisionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// DateFilter is filter option by date
+type DateFilter struct {
+	StartTimestampSec    int64    `protobuf:"varint,1,opt,name=start_timestamp_sec,json=startTimestampSec,proto3" json:"start_timestamp_sec,omitempty"`
+	EndTimestampSec      int64    `protobuf:"varint,2,opt,name=end_timestamp_sec,json=endTimestampSec,proto3" json:"end_timestamp_sec,omitempty"`
+	Filter               bool     `protobuf:"varint,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DateFilter) Reset()         { *m = DateFilter{} }
+func (m *DateFilter) String() string { return proto.CompactTextString(m) }
+func (*DateFilter) ProtoMessage()    {}
+func (*DateFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{21}
+}
+
+func (m *DateFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DateFilter.Unmarshal(m, b)
+}
+func (m *DateFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DateFilter.Marshal(b, m, deterministic)
+}
+func (m *DateFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DateFilter.Merge(m, src)
+}
+func (m *DateFilter) XXX_Size() int {
+	return xxx_messageInfo_DateFilter.Size(m)
+}
+func (m *DateFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DateFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DateFilter proto.InternalMessageInfo
+
+func (m *DateFilter) GetStartTimestampSec() int64 {
+	if m != nil {
+		return m.StartTimestampSec
+	}
+	return 0
+}
+
+func (m *DateFilter) GetEndTimestampSec() int64 {
+	if m != nil {
+		return m.EndTimestampSec
+	}
+	return 0
+}
+
+func (m *DateFilter) GetFilter() bool {
+	if m != nil {
+		return m.Filter
+	}
+	return false
+}
+
+// ListCultureFilter applies filter criteria to listing cultures
+type ListCultureFilter struct {
+	DateFilter *DateFilter `protobuf:"bytes,1,opt,name=date_filter,json=dateFilter,proto3" json:"date_filter,omitempty"`
+	ListTarget ListTarget  `protobuf:"varint,2,opt,name=list_target,json=listTarget,proto3,enum=antibug.culture.ListTarget" json:"list_target,omitempty"`
+	TargetIds  []string    `protobuf:"bytes,3,rep,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`
+	// Cultures with any of the statuses. All statuses when empty.
+	Statuses             []CultureStatus `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=antibug.culture.CultureStatus" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListCultureFilter) Reset()         { *m = ListCultureFilter{} }
+func (m *ListCultureFilter) String() string { return proto.CompactTextString(m) }
+func (*ListCultureFilter) ProtoMessage()    {}
+func (*ListCultureFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{22}
+}
+
+func (m *ListCultureFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCultureFilter.Unmarshal(m, b)
+}
+func (m *ListCultureFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCultureFilter.Marshal(b, m, deterministic)
+}
+func (m *ListCultureFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCultureFilter.Merge(m, src)
+}
+func (m *ListCultureFilter) XXX_Size() int {
+	return xxx_messageInfo_ListCultureFilter.Size(m)
+}
+func (m *ListCultureFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCultureFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCultureFilter proto.InternalMessageInfo
+
+func (m *ListCultureFilter) GetDateFilter() *DateFilter {
+	if m != nil {
+		return m.DateFilter
+	}
+	return nil
+}
+
+func (m *ListCultureFilter) GetListTarget() ListTarget {
+	if m != nil {
+		return m.ListTarget
+	}
+	return ListTarget_ALL
+}
+
+func (m *ListCultureFilter) GetTargetIds() []string {
+	if m != nil {
+		return m.TargetIds
+	}
+	return nil
+}
+
+func (m *ListCultureFilter) GetStatuses() []CultureStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// ListCulturesRequest is request to retrieve a collection of culture
+type ListCulturesRequest struct {
+	// Opaque token of next_page_token from the previous page. Empty for the first page.
+	PageToken string             `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32              `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter    *ListCultureFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort      CultureSort        `protobuf:"varint,5,opt,name=sort,proto3,enum=antibug.culture.CultureSort" json:"sort,omitempty"`
+	// Count cultures matching the filter in total_count
+	IncludeTotal         bool     `protobuf:"varint,6,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCulturesRequest) Reset()         { *m = ListCulturesRequest{} }
+func (m *ListCulturesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCulturesRequest) ProtoMessage()    {}
+func (*ListCulturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{23}
+}
+
+func (m *ListCulturesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCulturesRequest.Unmarshal(m, b)
+}
+func (m *ListCulturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCulturesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCulturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCulturesRequest.Merge(m, src)
+}
+func (m *ListCulturesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCulturesRequest.Size(m)
+}
+func (m *ListCulturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCulturesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCulturesRequest proto.InternalMessageInfo
+
+func (m *ListCulturesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListCulturesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListCulturesRequest) GetFilter() *ListCultureFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListCulturesRequest) GetSort() CultureSort {
+	if m != nil {
+		return m.Sort
+	}
+	return CultureSort_RESULTS_NEWEST_FIRST
+}
+
+func (m *ListCulturesRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
+// Cultures is collection of cultures
+type Cultures struct {
+	Cultures []*Culture `protobuf:"bytes,1,rep,name=cultures,proto3" json:"cultures,omitempty"`
+	// Empty on the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount           int64    `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Cultures) Reset()         { *m = Cultures{} }
+func (m *Cultures) String() string { return proto.CompactTextString(m) }
+func (*Cultures) ProtoMessage()    {}
+func (*Cultures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{24}
+}
+
+func (m *Cultures) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Cultures.Unmarshal(m, b)
+}
+func (m *Cultures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Cultures.Marshal(b, m, deterministic)
+}
+func (m *Cultures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cultures.Merge(m, src)
+}
+func (m *Cultures) XXX_Size() int {
+	return xxx_messageInfo_Cultures.Size(m)
+}
+func (m *Cultures) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cultures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cultures proto.InternalMessageInfo
+
+func (m *Cultures) GetCultures() []*Culture {
+	if m != nil {
+		return m.Cultures
 	}
 	return nil
 }
 
-// ListCulturesRequest is request to retrieve a collection of culture
-type ListCulturesRequest struct {
-	PageToken            int32              `protobuf:"varint,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize             int32              `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Filter               *ListCultureFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *Cultures) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *Cultures) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+// GetCultureRequest is request to retrieve a culture resource
+type GetCultureRequest struct {
+	CultureId            string   `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCultureRequest) Reset()         { *m = GetCultureRequest{} }
+func (m *GetCultureRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCultureRequest) ProtoMessage()    {}
+func (*GetCultureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{25}
+}
+
+func (m *GetCultureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCultureRequest.Unmarshal(m, b)
+}
+func (m *GetCultureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCultureRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCultureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCultureRequest.Merge(m, src)
+}
+func (m *GetCultureRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCultureRequest.Size(m)
+}
+func (m *GetCultureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCultureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCultureRequest proto.InternalMessageInfo
+
+func (m *GetCultureRequest) GetCultureId() string {
+	if m != nil {
+		return m.CultureId
+	}
+	return ""
+}
+
+// ImportResultColumn maps a column of measurements to an antimicrobial
+type ImportResultColumn struct {
+	Column               string     `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	AntimicrobialId      string     `protobuf:"bytes,2,opt,name=antimicrobial_id,json=antimicrobialId,proto3" json:"antimicrobial_id,omitempty"`
+	AntimicrobialName    string     `protobuf:"bytes,3,opt,name=antimicrobial_name,json=antimicrobialName,proto3" json:"antimicrobial_name,omitempty"`
+	TestMethod           TestMethod `protobuf:"varint,4,opt,name=test_method,json=testMethod,proto3,enum=antibug.culture.TestMethod" json:"test_method,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ImportResultColumn) Reset()         { *m = ImportResultColumn{} }
+func (m *ImportResultColumn) String() string { return proto.CompactTextString(m) }
+func (*ImportResultColumn) ProtoMessage()    {}
+func (*ImportResultColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{26}
+}
+
+func (m *ImportResultColumn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResultColumn.Unmarshal(m, b)
+}
+func (m *ImportResultColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResultColumn.Marshal(b, m, deterministic)
+}
+func (m *ImportResultColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResultColumn.Merge(m, src)
+}
+func (m *ImportResultColumn) XXX_Size() int {
+	return xxx_messageInfo_ImportResultColumn.Size(m)
+}
+func (m *ImportResultColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResultColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResultColumn proto.InternalMessageInfo
+
+func (m *ImportResultColumn) GetColumn() string {
+	if m != nil {
+		return m.Column
+	}
+	return ""
+}
+
+func (m *ImportResultColumn) GetAntimicrobialId() string {
+	if m != nil {
+		return m.AntimicrobialId
+	}
+	return ""
+}
+
+func (m *ImportResultColumn) GetAntimicrobialName() string {
+	if m != nil {
+		return m.AntimicrobialName
+	}
+	return ""
+}
+
+func (m *ImportResultColumn) GetTestMethod() TestMethod {
+	if m != nil {
+		return m.TestMethod
+	}
+	return TestMethod_TEST_METHOD_UNSPECIFIED
+}
+
+// ImportMapping maps columns of an import file to culture fields.
+// Field keys are lab_tech_id, hospital_id, county_code, sub_county_code, patient_id, patient_gender,
+// patient_age, culture_source, results_date, pathogen_id, pathogen_name and ward.
+type ImportMapping struct {
+	// Column of each field
+	Fields map[string]string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Values of fields missing in the file
+	Defaults map[string]string `protobuf:"bytes,2,rep,name=defaults,proto3" json:"defaults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Columns of results. Each row is a culture of one isolate with a result per non-empty column.
+	ResultColumns []*ImportResultColumn `protobuf:"bytes,3,rep,name=result_columns,json=resultColumns,proto3" json:"result_columns,omitempty"`
+	// Names of pathogen codes used in the pathogen id column
+	PathogenNames map[string]string `protobuf:"bytes,4,rep,name=pathogen_names,json=pathogenNames,proto3" json:"pathogen_names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Go time layout of results date. Defaults to 2006-01-02
+	DateLayout           string   `protobuf:"bytes,5,opt,name=date_layout,json=dateLayout,proto3" json:"date_layout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportMapping) Reset()         { *m = ImportMapping{} }
+func (m *ImportMapping) String() string { return proto.CompactTextString(m) }
+func (*ImportMapping) ProtoMessage()    {}
+func (*ImportMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{27}
+}
+
+func (m *ImportMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportMapping.Unmarshal(m, b)
+}
+func (m *ImportMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportMapping.Marshal(b, m, deterministic)
+}
+func (m *ImportMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportMapping.Merge(m, src)
+}
+func (m *ImportMapping) XXX_Size() int {
+	return xxx_messageInfo_ImportMapping.Size(m)
+}
+func (m *ImportMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportMapping proto.InternalMessageInfo
+
+func (m *ImportMapping) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ImportMapping) GetDefaults() map[string]string {
+	if m != nil {
+		return m.Defaults
+	}
+	return nil
+}
+
+func (m *ImportMapping) GetResultColumns() []*ImportResultColumn {
+	if m != nil {
+		return m.ResultColumns
+	}
+	return nil
+}
+
+func (m *ImportMapping) GetPathogenNames() map[string]string {
+	if m != nil {
+		return m.PathogenNames
+	}
+	return nil
+}
+
+func (m *ImportMapping) GetDateLayout() string {
+	if m != nil {
+		return m.DateLayout
+	}
+	return ""
+}
+
+// ImportOptions configures an import. It is the first message of the stream.
+type ImportOptions struct {
+	Format  ImportFormat   `protobuf:"varint,1,opt,name=format,proto3,enum=antibug.culture.ImportFormat" json:"format,omitempty"`
+	Mapping *ImportMapping `protobuf:"bytes,2,opt,name=mapping,proto3" json:"mapping,omitempty"`
+	// Number of cultures inserted per transaction. Defaults to 100
+	BatchSize            int32    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportOptions) Reset()         { *m = ImportOptions{} }
+func (m *ImportOptions) String() string { return proto.CompactTextString(m) }
+func (*ImportOptions) ProtoMessage()    {}
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{28}
+}
+
+func (m *ImportOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportOptions.Unmarshal(m, b)
+}
+func (m *ImportOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportOptions.Marshal(b, m, deterministic)
+}
+func (m *ImportOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportOptions.Merge(m, src)
+}
+func (m *ImportOptions) XXX_Size() int {
+	return xxx_messageInfo_ImportOptions.Size(m)
+}
+func (m *ImportOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportOptions proto.InternalMessageInfo
+
+func (m *ImportOptions) GetFormat() ImportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ImportFormat_CSV
+}
+
+func (m *ImportOptions) GetMapping() *ImportMapping {
+	if m != nil {
+		return m.Mapping
+	}
+	return nil
+}
+
+func (m *ImportOptions) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+// ImportCulturesRequest is a message in a stream of an import file
+type ImportCulturesRequest struct {
+	// Types that are valid to be assigned to Payload:
+	//	*ImportCulturesRequest_Options
+	//	*ImportCulturesRequest_Chunk
+	Payload              isImportCulturesRequest_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ImportCulturesRequest) Reset()         { *m = ImportCulturesRequest{} }
+func (m *ImportCulturesRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportCulturesRequest) ProtoMessage()    {}
+func (*ImportCulturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{29}
+}
+
+func (m *ImportCulturesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCulturesRequest.Unmarshal(m, b)
+}
+func (m *ImportCulturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCulturesRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportCulturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCulturesRequest.Merge(m, src)
+}
+func (m *ImportCulturesRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportCulturesRequest.Size(m)
+}
+func (m *ImportCulturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCulturesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCulturesRequest proto.InternalMessageInfo
+
+type isImportCulturesRequest_Payload interface {
+	isImportCulturesRequest_Payload()
+}
+
+type ImportCulturesRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof" json:"options,omitempty"`
+}
+type ImportCulturesRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof" json:"chunk,omitempty"`
+}
+
+func (*ImportCulturesRequest_Options) isImportCulturesRequest_Payload() {}
+func (*ImportCulturesRequest_Chunk) isImportCulturesRequest_Payload()   {}
+
+func (m *ImportCulturesRequest) GetPayload() isImportCulturesRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ImportCulturesRequest) GetOptions() *ImportOptions {
+	if x, ok := m.GetPayload().(*ImportCulturesRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (m *ImportCulturesRequest) GetChunk() []byte {
+	if x, ok := m.GetPayload().(*ImportCulturesRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ImportCulturesRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ImportCulturesRequest_Options)(nil),
+		(*ImportCulturesRequest_Chunk)(nil),
+	}
+}
+
+// ImportRowError is the reason a row was not imported
+type ImportRowError struct {
+	// Record in the file. The header is row 1.
+	Row                  int64    `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportRowError) Reset()         { *m = ImportRowError{} }
+func (m *ImportRowError) String() string { return proto.CompactTextString(m) }
+func (*ImportRowError) ProtoMessage()    {}
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{30}
+}
+
+func (m *ImportRowError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportRowError.Unmarshal(m, b)
+}
+func (m *ImportRowError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportRowError.Marshal(b, m, deterministic)
+}
+func (m *ImportRowError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRowError.Merge(m, src)
+}
+func (m *ImportRowError) XXX_Size() int {
+	return xxx_messageInfo_ImportRowError.Size(m)
+}
+func (m *ImportRowError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRowError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRowError proto.InternalMessageInfo
+
+func (m *ImportRowError) GetRow() int64 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ImportRowError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// ImportCulturesResponse is report of an import
+type ImportCulturesResponse struct {
+	RowsRead     int64 `protobuf:"varint,1,opt,name=rows_read,json=rowsRead,proto3" json:"rows_read,omitempty"`
+	RowsImported int64 `protobuf:"varint,2,opt,name=rows_imported,json=rowsImported,proto3" json:"rows_imported,omitempty"`
+	RowsFailed   int64 `protobuf:"varint,3,opt,name=rows_failed,json=rowsFailed,proto3" json:"rows_failed,omitempty"`
+	// Errors of the first 1000 failed rows
+	Errors               []*ImportRowError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportCulturesResponse) Reset()         { *m = ImportCulturesResponse{} }
+func (m *ImportCulturesResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportCulturesResponse) ProtoMessage()    {}
+func (*ImportCulturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{31}
+}
+
+func (m *ImportCulturesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCulturesResponse.Unmarshal(m, b)
+}
+func (m *ImportCulturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCulturesResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportCulturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCulturesResponse.Merge(m, src)
+}
+func (m *ImportCulturesResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportCulturesResponse.Size(m)
+}
+func (m *ImportCulturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCulturesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCulturesResponse proto.InternalMessageInfo
+
+func (m *ImportCulturesResponse) GetRowsRead() int64 {
+	if m != nil {
+		return m.RowsRead
+	}
+	return 0
+}
+
+func (m *ImportCulturesResponse) GetRowsImported() int64 {
+	if m != nil {
+		return m.RowsImported
+	}
+	return 0
+}
+
+func (m *ImportCulturesResponse) GetRowsFailed() int64 {
+	if m != nil {
+		return m.RowsFailed
+	}
+	return 0
+}
+
+func (m *ImportCulturesResponse) GetErrors() []*ImportRowError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+// DemographicFilter selects cultures by demographics of patients
+type DemographicFilter struct {
+	// male or female. All patients when empty.
+	PatientGender string `protobuf:"bytes,1,opt,name=patient_gender,json=patientGender,proto3" json:"patient_gender,omitempty"`
+	// Ages are compared in whole years
+	AgeMinDays           int64    `protobuf:"varint,2,opt,name=age_min_days,json=ageMinDays,proto3" json:"age_min_days,omitempty"`
+	AgeMaxDays           int64    `protobuf:"varint,3,opt,name=age_max_days,json=ageMaxDays,proto3" json:"age_max_days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListCulturesRequest) Reset()         { *m = ListCulturesRequest{} }
-func (m *ListCulturesRequest) String() string { return proto.CompactTextString(m) }
-func (*ListCulturesRequest) ProtoMessage()    {}
-func (*ListCulturesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{10}
+func (m *DemographicFilter) Reset()         { *m = DemographicFilter{} }
+func (m *DemographicFilter) String() string { return proto.CompactTextString(m) }
+func (*DemographicFilter) ProtoMessage()    {}
+func (*DemographicFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{32}
 }
 
-func (m *ListCulturesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListCulturesRequest.Unmarshal(m, b)
+func (m *DemographicFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DemographicFilter.Unmarshal(m, b)
 }
-func (m *ListCulturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListCulturesRequest.Marshal(b, m, deterministic)
+func (m *DemographicFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DemographicFilter.Marshal(b, m, deterministic)
 }
-func (m *ListCulturesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListCulturesRequest.Merge(m, src)
+func (m *DemographicFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemographicFilter.Merge(m, src)
 }
-func (m *ListCulturesRequest) XXX_Size() int {
-	return xxx_messageInfo_ListCulturesRequest.Size(m)
+func (m *DemographicFilter) XXX_Size() int {
+	return xxx_messageInfo_DemographicFilter.Size(m)
 }
-func (m *ListCulturesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListCulturesRequest.DiscardUnknown(m)
+func (m *DemographicFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemographicFilter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListCulturesRequest proto.InternalMessageInfo
+var xxx_messageInfo_DemographicFilter proto.InternalMessageInfo
 
-func (m *ListCulturesRequest) GetPageToken() int32 {
+func (m *DemographicFilter) GetPatientGender() string {
 	if m != nil {
-		return m.PageToken
+		return m.PatientGender
 	}
-	return 0
+	return ""
 }
 
-func (m *ListCulturesRequest) GetPageSize() int32 {
+func (m *DemographicFilter) GetAgeMinDays() int64 {
 	if m != nil {
-		return m.PageSize
+		return m.AgeMinDays
 	}
 	return 0
 }
 
-func (m *ListCulturesRequest) GetFilter() *ListCultureFilter {
+func (m *DemographicFilter) GetAgeMaxDays() int64 {
 	if m != nil {
-		return m.Filter
+		return m.AgeMaxDays
 	}
-	return nil
+	return 0
 }
 
-// Cultures is collection of cultures
-type Cultures struct {
-	Cultures             []*Culture `protobuf:"bytes,1,rep,name=cultures,proto3" json:"cultures,omitempty"`
-	NextPageToken        int32      `protobuf:"varint,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+// ExportCulturesRequest is request to export results of cultures
+type ExportCulturesRequest struct {
+	Filter               *ListCultureFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Demographics         *DemographicFilter `protobuf:"bytes,2,opt,name=demographics,proto3" json:"demographics,omitempty"`
+	Format               ExportFormat       `protobuf:"varint,3,opt,name=format,proto3,enum=antibug.culture.ExportFormat" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *Cultures) Reset()         { *m = Cultures{} }
-func (m *Cultures) String() string { return proto.CompactTextString(m) }
-func (*Cultures) ProtoMessage()    {}
-func (*Cultures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{11}
+func (m *ExportCulturesRequest) Reset()         { *m = ExportCulturesRequest{} }
+func (m *ExportCulturesRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportCulturesRequest) ProtoMessage()    {}
+func (*ExportCulturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{33}
 }
 
-func (m *Cultures) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Cultures.Unmarshal(m, b)
+func (m *ExportCulturesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportCulturesRequest.Unmarshal(m, b)
 }
-func (m *Cultures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Cultures.Marshal(b, m, deterministic)
+func (m *ExportCulturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportCulturesRequest.Marshal(b, m, deterministic)
 }
-func (m *Cultures) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Cultures.Merge(m, src)
+func (m *ExportCulturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportCulturesRequest.Merge(m, src)
 }
-func (m *Cultures) XXX_Size() int {
-	return xxx_messageInfo_Cultures.Size(m)
+func (m *ExportCulturesRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportCulturesRequest.Size(m)
 }
-func (m *Cultures) XXX_DiscardUnknown() {
-	xxx_messageInfo_Cultures.DiscardUnknown(m)
+func (m *ExportCulturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportCulturesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Cultures proto.InternalMessageInfo
+var xxx_messageInfo_ExportCulturesRequest proto.InternalMessageInfo
 
-func (m *Cultures) GetCultures() []*Culture {
+func (m *ExportCulturesRequest) GetFilter() *ListCultureFilter {
 	if m != nil {
-		return m.Cultures
+		return m.Filter
 	}
 	return nil
 }
 
-func (m *Cultures) GetNextPageToken() int32 {
+func (m *ExportCulturesRequest) GetDemographics() *DemographicFilter {
 	if m != nil {
-		return m.NextPageToken
+		return m.Demographics
 	}
-	return 0
+	return nil
 }
 
-// GetCultureRequest is request to retrieve a culture resource
-type GetCultureRequest struct {
-	CultureId            string   `protobuf:"bytes,1,opt,name=culture_id,json=cultureId,proto3" json:"culture_id,omitempty"`
+func (m *ExportCulturesRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_EXPORT_CSV
+}
+
+// ExportChunk is a chunk of the exported file. The file has one row per lab test result.
+type ExportChunk struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Media type of the file. Only set in the first chunk.
+	ContentType          string   `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCultureRequest) Reset()         { *m = GetCultureRequest{} }
-func (m *GetCultureRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCultureRequest) ProtoMessage()    {}
-func (*GetCultureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f015e82c8f4873ba, []int{12}
+func (m *ExportChunk) Reset()         { *m = ExportChunk{} }
+func (m *ExportChunk) String() string { return proto.CompactTextString(m) }
+func (*ExportChunk) ProtoMessage()    {}
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f015e82c8f4873ba, []int{34}
 }
 
-func (m *GetCultureRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCultureRequest.Unmarshal(m, b)
+func (m *ExportChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportChunk.Unmarshal(m, b)
 }
-func (m *GetCultureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCultureRequest.Marshal(b, m, deterministic)
+func (m *ExportChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportChunk.Marshal(b, m, deterministic)
 }
-func (m *GetCultureRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCultureRequest.Merge(m, src)
+func (m *ExportChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportChunk.Merge(m, src)
 }
-func (m *GetCultureRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCultureRequest.Size(m)
+func (m *ExportChunk) XXX_Size() int {
+	return xxx_messageInfo_ExportChunk.Size(m)
 }
-func (m *GetCultureRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCultureRequest.DiscardUnknown(m)
+func (m *ExportChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportChunk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCultureRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportChunk proto.InternalMessageInfo
 
-func (m *GetCultureRequest) GetCultureId() string {
+func (m *ExportChunk) GetData() []byte {
 	if m != nil {
-		return m.CultureId
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ExportChunk) GetContentType() string {
+	if m != nil {
+		return m.ContentType
 	}
 	return ""
 }
 
 func init() {
+	proto.RegisterEnum("antibug.culture.Department", Department_name, Department_value)
+	proto.RegisterEnum("antibug.culture.PatientSetting", PatientSetting_name, PatientSetting_value)
+	proto.RegisterEnum("antibug.culture.SpecimenType", SpecimenType_name, SpecimenType_value)
+	proto.RegisterEnum("antibug.culture.CultureStatus", CultureStatus_name, CultureStatus_value)
 	proto.RegisterEnum("antibug.culture.Label", Label_name, Label_value)
 	proto.RegisterEnum("antibug.culture.TestMethod", TestMethod_name, TestMethod_value)
+	proto.RegisterEnum("antibug.culture.Comparator", Comparator_name, Comparator_value)
+	proto.RegisterEnum("antibug.culture.ResistanceClass", ResistanceClass_name, ResistanceClass_value)
+	proto.RegisterEnum("antibug.culture.RevisionOperation", RevisionOperation_name, RevisionOperation_value)
 	proto.RegisterEnum("antibug.culture.ListTarget", ListTarget_name, ListTarget_value)
+	proto.RegisterEnum("antibug.culture.CultureSort", CultureSort_name, CultureSort_value)
+	proto.RegisterEnum("antibug.culture.ImportFormat", ImportFormat_name, ImportFormat_value)
+	proto.RegisterEnum("antibug.culture.ExportFormat", ExportFormat_name, ExportFormat_value)
 	proto.RegisterType((*Culture)(nil), "antibug.culture.Culture")
 	proto.RegisterType((*Pathogen)(nil), "antibug.culture.Pathogen")
 	proto.RegisterType((*Antimicrobial)(nil), "antibug.culture.Antimicrobial")
+	proto.RegisterType((*MIC)(nil), "antibug.culture.MIC")
 	proto.RegisterType((*LabTestResult)(nil), "antibug.culture.LabTestResult")
+	proto.RegisterType((*RuleAnnotation)(nil), "antibug.culture.RuleAnnotation")
+	proto.RegisterType((*IsolateClassification)(nil), "antibug.culture.IsolateClassification")
 	proto.RegisterType((*CreateCultureRequest)(nil), "antibug.culture.CreateCultureRequest")
 	proto.RegisterType((*CreateCultureResponse)(nil), "antibug.culture.CreateCultureResponse")
 	proto.RegisterType((*UpdateCultureRequest)(nil), "antibug.culture.UpdateCultureRequest")
+	proto.RegisterType((*UpdateCultureResponse)(nil), "antibug.culture.UpdateCultureResponse")
 	proto.RegisterType((*DeleteCultureRequest)(nil), "antibug.culture.DeleteCultureRequest")
+	proto.RegisterType((*TransitionCultureRequest)(nil), "antibug.culture.TransitionCultureRequest")
+	proto.RegisterType((*ReidentifyPatientRequest)(nil), "antibug.culture.ReidentifyPatientRequest")
+	proto.RegisterType((*PatientIdentity)(nil), "antibug.culture.PatientIdentity")
+	proto.RegisterType((*FieldChange)(nil), "antibug.culture.FieldChange")
+	proto.RegisterType((*CultureRevision)(nil), "antibug.culture.CultureRevision")
+	proto.RegisterType((*ListCultureRevisionsRequest)(nil), "antibug.culture.ListCultureRevisionsRequest")
+	proto.RegisterType((*CultureRevisions)(nil), "antibug.culture.CultureRevisions")
+	proto.RegisterType((*GetCultureRevisionRequest)(nil), "antibug.culture.GetCultureRevisionRequest")
+	proto.RegisterType((*RestoreCultureRevisionRequest)(nil), "antibug.culture.RestoreCultureRevisionRequest")
 	proto.RegisterType((*DateFilter)(nil), "antibug.culture.DateFilter")
 	proto.RegisterType((*ListCultureFilter)(nil), "antibug.culture.ListCultureFilter")
 	proto.RegisterType((*ListCulturesRequest)(nil), "antibug.culture.ListCulturesRequest")
 	proto.RegisterType((*Cultures)(nil), "antibug.culture.Cultures")
 	proto.RegisterType((*GetCultureRequest)(nil), "antibug.culture.GetCultureRequest")
+	proto.RegisterType((*ImportResultColumn)(nil), "antibug.culture.ImportResultColumn")
+	proto.RegisterType((*ImportMapping)(nil), "antibug.culture.ImportMapping")
+	proto.RegisterMapType((map[string]string)(nil), "antibug.culture.ImportMapping.DefaultsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "antibug.culture.ImportMapping.FieldsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "antibug.culture.ImportMapping.PathogenNamesEntry")
+	proto.RegisterType((*ImportOptions)(nil), "antibug.culture.ImportOptions")
+	proto.RegisterType((*ImportCulturesRequest)(nil), "antibug.culture.ImportCulturesRequest")
+	proto.RegisterType((*ImportRowError)(nil), "antibug.culture.ImportRowError")
+	proto.RegisterType((*ImportCulturesResponse)(nil), "antibug.culture.ImportCulturesResponse")
+	proto.RegisterType((*DemographicFilter)(nil), "antibug.culture.DemographicFilter")
+	proto.RegisterType((*ExportCulturesRequest)(nil), "antibug.culture.ExportCulturesRequest")
+	proto.RegisterType((*ExportChunk)(nil), "antibug.culture.ExportChunk")
 }
 
 func init() { proto.RegisterFile("culture.proto", fileDescriptor_f015e82c8f4873ba) }
 
 var fileDescriptor_f015e82c8f4873ba = []byte{
-	// 1539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4f, 0x73, 0xdb, 0xc6,
-	0x15, 0x37, 0x48, 0xfd, 0x7d, 0x14, 0x45, 0x6a, 0x25, 0x7b, 0x58, 0xb9, 0x4e, 0x11, 0x38, 0x4e,
-	0x14, 0x26, 0x22, 0x1d, 0xc6, 0xed, 0x4c, 0x5d, 0x1f, 0x4a, 0x91, 0xb4, 0x83, 0x96, 0xa1, 0x54,
-	0x90, 0x3a, 0xa4, 0x17, 0x14, 0x04, 0x9e, 0x40, 0x24, 0x20, 0x16, 0xc5, 0x2e, 0x12, 0xcb, 0x1d,
-	0xcf, 0x74, 0x7a, 0xe8, 0x4c, 0xa7, 0xd3, 0x1e, 0xda, 0x5b, 0xbe, 0x43, 0xfb, 0x01, 0x7a, 0xee,
-	0x37, 0xe8, 0x57, 0xe8, 0xbd, 0x5f, 0xa1, 0xb3, 0x8b, 0x85, 0x48, 0x8a, 0x94, 0x65, 0x9f, 0x44,
-	0xfc, 0xde, 0x6f, 0xf7, 0xfd, 0xde, 0xdb, 0xf7, 0xde, 0xae, 0xa0, 0xec, 0xa6, 0x21, 0x4f, 0x13,
-	0x6c, 0xc4, 0x09, 0xe5, 0x94, 0x54, 0x9c, 0x88, 0x07, 0xe3, 0xd4, 0x6f, 0x28, 0xf8, 0xf0, 0xbe,
-	0x4f, 0xa9, 0x1f, 0x62, 0x53, 0x9a, 0xc7, 0xe9, 0x45, 0x13, 0xa7, 0x31, 0xbf, 0xcc, 0xd8, 0x87,
-	0x3f, 0x54, 0x46, 0x27, 0x0e, 0x9a, 0x4e, 0x14, 0x51, 0xee, 0xf0, 0x80, 0x46, 0x4c, 0x59, 0x3f,
-	0x95, 0x7f, 0xdc, 0x63, 0x1f, 0xa3, 0x63, 0xf6, 0x9d, 0xe3, 0xfb, 0x98, 0x34, 0x69, 0x2c, 0x19,
-	0xcb, 0x6c, 0xe3, 0x7f, 0x6b, 0xb0, 0xd9, 0xc9, 0x9c, 0x92, 0x07, 0x00, 0xca, 0xbf, 0x1d, 0x78,
-	0x35, 0x4d, 0xd7, 0x8e, 0xb6, 0xad, 0x6d, 0x85, 0x98, 0x1e, 0x79, 0x0f, 0x4a, 0xa1, 0x33, 0xb6,
-	0x39, 0xba, 0x13, 0x61, 0x2f, 0x64, 0xf6, 0xd0, 0x19, 0x8f, 0xd0, 0x9d, 0x98, 0x1e, 0xf9, 0x11,
-	0x94, 0x26, 0x94, 0xc5, 0x01, 0x77, 0x42, 0x61, 0x2f, 0x4a, 0x3b, 0xe4, 0x50, 0x46, 0x70, 0x69,
-	0x1a, 0xf1, 0x4b, 0xdb, 0xa5, 0x1e, 0xd6, 0xd6, 0x32, 0x42, 0x06, 0x75, 0xa8, 0x87, 0xe4, 0x43,
-	0xa8, 0xb0, 0x74, 0x6c, 0xcf, 0x93, 0xd6, 0x25, 0xa9, 0xcc, 0xd2, 0x71, 0x67, 0xc6, 0x7b, 0x00,
-	0x10, 0x3b, 0x3c, 0xc0, 0x88, 0x0b, 0x47, 0x1b, 0x99, 0x10, 0x85, 0x98, 0x1e, 0x79, 0x04, 0xbb,
-	0xb9, 0xd9, 0xc7, 0xc8, 0xc3, 0xa4, 0xb6, 0x99, 0xed, 0xa2, 0xd0, 0x17, 0x12, 0x14, 0x72, 0x72,
-	0x9a, 0xe3, 0x63, 0x6d, 0x4b, 0xd7, 0x8e, 0xd6, 0xad, 0x7c, 0xe3, 0xb6, 0x8f, 0xa4, 0x06, 0x9b,
-	0xe8, 0x05, 0x9c, 0x26, 0xac, 0xb6, 0xad, 0x17, 0x8f, 0xb6, 0xad, 0xfc, 0x93, 0x3c, 0x83, 0x12,
-	0x47, 0xc6, 0xed, 0x29, 0xf2, 0x09, 0xf5, 0x6a, 0xa0, 0x6b, 0x47, 0xbb, 0xad, 0xfb, 0x8d, 0x6b,
-	0xa7, 0xd8, 0x18, 0x21, 0xe3, 0x5f, 0x4a, 0x8a, 0x05, 0xfc, 0xea, 0xb7, 0xd0, 0x97, 0xe7, 0x99,
-	0xd1, 0x34, 0x71, 0xb1, 0x56, 0xca, 0xf4, 0x29, 0x74, 0x28, 0x41, 0xf2, 0x11, 0x54, 0x62, 0x87,
-	0x4f, 0xa8, 0x8f, 0x11, 0xb3, 0x2f, 0x68, 0x1a, 0x79, 0xb5, 0x1d, 0x29, 0x63, 0xf7, 0x0a, 0x7e,
-	0x2e, 0x50, 0xd2, 0x84, 0x7d, 0xe1, 0x79, 0x1a, 0xb8, 0x09, 0x1d, 0x07, 0x4e, 0xc8, 0xec, 0x94,
-	0xa1, 0x57, 0x2b, 0x4b, 0x32, 0x59, 0x34, 0x9d, 0x33, 0xf4, 0xc8, 0x0b, 0xa8, 0xe4, 0x02, 0x12,
-	0x64, 0x69, 0xc8, 0x59, 0x6d, 0x57, 0x2f, 0x1e, 0x95, 0x5a, 0xef, 0x2d, 0x85, 0xd0, 0x17, 0xc7,
-	0xcb, 0xb8, 0x25, 0x69, 0x56, 0xae, 0x3b, 0xfb, 0x64, 0xa4, 0x05, 0x77, 0xd5, 0x06, 0x36, 0x0f,
-	0xa6, 0xc8, 0xb8, 0x33, 0x8d, 0x6d, 0x86, 0x6e, 0xad, 0xa2, 0x6b, 0x47, 0x45, 0x6b, 0x5f, 0x19,
-	0x47, 0xb9, 0x6d, 0x88, 0xae, 0xf1, 0x1a, 0xb6, 0xce, 0x94, 0x7e, 0x75, 0x04, 0xf2, 0xf7, 0xac,
-	0xe4, 0x20, 0x87, 0x4c, 0x8f, 0x3c, 0x84, 0xf2, 0x15, 0x21, 0x72, 0xa6, 0xa8, 0xaa, 0x6e, 0x27,
-	0x07, 0x07, 0xce, 0x14, 0xc9, 0x27, 0xb0, 0x77, 0x45, 0x72, 0x1d, 0x8e, 0x3e, 0x4d, 0x2e, 0x55,
-	0xf9, 0x55, 0x73, 0x43, 0x47, 0xe1, 0xc6, 0xf7, 0x1a, 0x94, 0xdb, 0xf3, 0x29, 0x21, 0x1f, 0x43,
-	0x75, 0x21, 0x47, 0x33, 0x25, 0x95, 0x05, 0xdc, 0xf4, 0xc8, 0x31, 0x2c, 0xa6, 0x73, 0x5e, 0xd3,
-	0xde, 0x82, 0x45, 0x0a, 0xbb, 0x7e, 0x30, 0xb6, 0x1b, 0x3a, 0x8c, 0x29, 0x69, 0x8b, 0x3b, 0x75,
-	0x84, 0xc5, 0xf8, 0x6b, 0x11, 0xca, 0x0b, 0x19, 0x5f, 0x4e, 0x80, 0xb6, 0x22, 0x01, 0xd7, 0xd2,
-	0x58, 0x58, 0x4a, 0xe3, 0xaa, 0x10, 0x8b, 0xef, 0x12, 0xe2, 0xda, 0x4d, 0x21, 0x3e, 0x84, 0xb2,
-	0x17, 0xb0, 0x6f, 0x6c, 0x2f, 0x70, 0xa6, 0xc8, 0x31, 0x51, 0x0d, 0xbb, 0x23, 0xc0, 0xae, 0xc2,
-	0xc8, 0x63, 0x38, 0x88, 0x68, 0x64, 0x7b, 0xc1, 0xc5, 0x45, 0xca, 0x02, 0x1a, 0xa9, 0xaa, 0x53,
-	0x9d, 0x4b, 0x22, 0x1a, 0x75, 0x73, 0x93, 0x0a, 0xfb, 0x11, 0xec, 0x66, 0x1c, 0xdb, 0xa5, 0xd3,
-	0x29, 0x46, 0x3c, 0x6f, 0xe1, 0x0c, 0xed, 0x64, 0x20, 0xf9, 0x0c, 0x0e, 0x58, 0xca, 0x5c, 0x8c,
-	0x79, 0x30, 0x0e, 0xc2, 0x80, 0x5f, 0xda, 0xcc, 0xa5, 0x49, 0xd6, 0xcb, 0x05, 0x6b, 0x7f, 0xd1,
-	0x36, 0x14, 0x26, 0xf2, 0x29, 0xac, 0x87, 0xce, 0x18, 0xc3, 0xda, 0xb6, 0x6c, 0xda, 0x7b, 0xab,
-	0x2a, 0x1e, 0x43, 0x2b, 0x23, 0x19, 0xbf, 0x80, 0x83, 0x4e, 0x82, 0x0e, 0xc7, 0x4e, 0x5e, 0xf8,
-	0xbf, 0x4d, 0x91, 0x71, 0xd2, 0x82, 0x4d, 0xc5, 0x97, 0x07, 0x52, 0x6a, 0xd5, 0x96, 0xf6, 0xc9,
-	0x57, 0xe4, 0x44, 0xe3, 0x27, 0x70, 0xf7, 0xda, 0x5e, 0x2c, 0xa6, 0x11, 0xbb, 0x6d, 0xee, 0x1a,
-	0x7f, 0xd4, 0xe0, 0xe0, 0x3c, 0xf6, 0x96, 0x45, 0xdc, 0x32, 0xaf, 0xef, 0xc3, 0x76, 0x36, 0xaf,
-	0x66, 0x35, 0xb1, 0x95, 0x01, 0xa6, 0x37, 0x1f, 0x40, 0xf1, 0x6d, 0x03, 0xf8, 0x31, 0x1c, 0x74,
-	0x31, 0xc4, 0x77, 0xd4, 0x61, 0xfc, 0x5e, 0x03, 0xe8, 0x3a, 0x1c, 0x9f, 0x07, 0xa1, 0x28, 0x86,
-	0x06, 0xec, 0x33, 0xee, 0x24, 0xfc, 0xda, 0xc4, 0xd0, 0xe4, 0xc4, 0xd8, 0x93, 0xa6, 0xf9, 0x79,
-	0x41, 0xea, 0xb0, 0x87, 0x91, 0x77, 0x8d, 0x5d, 0x90, 0xec, 0x0a, 0x46, 0xde, 0x02, 0xf7, 0x1e,
-	0x6c, 0x5c, 0x48, 0x2f, 0x32, 0xa8, 0x2d, 0x4b, 0x7d, 0x19, 0xff, 0xd4, 0x60, 0xaf, 0x1f, 0x30,
-	0xae, 0x84, 0x2b, 0x25, 0xcf, 0xa0, 0x24, 0xb2, 0x6a, 0xab, 0x25, 0xd9, 0x41, 0x2e, 0x4f, 0xf1,
-	0x99, 0x76, 0x0b, 0xbc, 0x59, 0x1c, 0xcf, 0xa0, 0x14, 0x06, 0x8c, 0xdb, 0xdc, 0x49, 0x7c, 0xe4,
-	0x52, 0xd1, 0xaa, 0x3b, 0x40, 0xb8, 0x1d, 0x49, 0x8a, 0x05, 0xe1, 0xd5, 0x6f, 0x91, 0xb3, 0x6c,
-	0xa1, 0x1d, 0x78, 0x62, 0x22, 0x88, 0x51, 0xbd, 0x9d, 0x21, 0xa6, 0xc7, 0x8c, 0xbf, 0x68, 0xb0,
-	0x3f, 0x27, 0x98, 0xcd, 0xa5, 0x3a, 0x76, 0x7c, 0xb4, 0x39, 0xfd, 0x06, 0x23, 0xa9, 0x78, 0x5d,
-	0xdc, 0x7c, 0x3e, 0x8e, 0x04, 0x20, 0x8e, 0x5c, 0x9a, 0x59, 0xf0, 0x2a, 0x1b, 0x4b, 0xeb, 0xd6,
-	0x96, 0x00, 0x86, 0xc1, 0x2b, 0x24, 0x4f, 0x17, 0x92, 0x53, 0x6a, 0x19, 0x2b, 0xb5, 0x2e, 0xa4,
-	0xe8, 0x2a, 0x81, 0x13, 0xd8, 0xca, 0xa5, 0x90, 0x27, 0xb0, 0xa5, 0x16, 0xb0, 0x9a, 0x26, 0xaf,
-	0x8d, 0x9b, 0x6b, 0xe7, 0x8a, 0x29, 0xee, 0xf6, 0x08, 0x5f, 0x72, 0x7b, 0x4e, 0x7e, 0x26, 0xb0,
-	0x2c, 0xe0, 0xb3, 0x3c, 0x04, 0xa3, 0x05, 0x7b, 0x2f, 0x90, 0xbf, 0x53, 0x85, 0xd5, 0x4f, 0x61,
-	0x5d, 0x76, 0x2d, 0xa9, 0x40, 0x69, 0x78, 0x3e, 0xec, 0xf4, 0xce, 0x46, 0xe6, 0x49, 0xbf, 0x57,
-	0xbd, 0x43, 0x0e, 0xa0, 0xda, 0x3d, 0x1d, 0xf6, 0xec, 0x79, 0xb4, 0x40, 0xaa, 0xb0, 0x63, 0x0e,
-	0x46, 0x3d, 0xeb, 0xcb, 0x5e, 0xd7, 0x6c, 0x8f, 0x7a, 0xd5, 0x22, 0x29, 0xc3, 0xb6, 0xd5, 0x1b,
-	0x9a, 0xc3, 0x51, 0x7b, 0x30, 0xaa, 0xae, 0xd5, 0x75, 0x80, 0xd9, 0xdd, 0x4d, 0x08, 0xec, 0x76,
-	0xcd, 0xe1, 0x2f, 0xed, 0xae, 0xf9, 0xfc, 0xf9, 0xf9, 0xd0, 0x3c, 0x1d, 0x54, 0xef, 0xd4, 0x7f,
-	0x03, 0x30, 0x3b, 0x59, 0xb2, 0x09, 0xc5, 0x76, 0xbf, 0x5f, 0xbd, 0x43, 0x00, 0x36, 0x3a, 0xa7,
-	0xe7, 0x83, 0xd1, 0x57, 0x55, 0x8d, 0xec, 0x02, 0x0c, 0xcf, 0x4f, 0x6c, 0xf5, 0x5d, 0x20, 0x3b,
-	0xb0, 0xf5, 0xc5, 0xe9, 0xf0, 0xcc, 0x1c, 0xb5, 0xfb, 0xd5, 0x22, 0x29, 0xc1, 0xe6, 0x59, 0x7b,
-	0x64, 0xf6, 0x84, 0x3f, 0xe1, 0xa1, 0xdf, 0x3e, 0xb1, 0x47, 0xbd, 0xce, 0x17, 0x03, 0xb3, 0x63,
-	0xb6, 0x07, 0xd5, 0xf5, 0xd6, 0x9f, 0xd6, 0x01, 0x54, 0x1a, 0xda, 0x67, 0x26, 0xf9, 0xb3, 0x06,
-	0xe5, 0x85, 0xf1, 0x41, 0x1e, 0x2d, 0x67, 0x7d, 0xc5, 0xa8, 0x3a, 0xfc, 0xf0, 0x36, 0x5a, 0x36,
-	0x85, 0x8c, 0x4f, 0xfe, 0xf0, 0x9f, 0xff, 0xfe, 0xbd, 0xf0, 0xc8, 0xd0, 0xd5, 0xbb, 0x52, 0xae,
-	0x69, 0xe6, 0xe7, 0xd7, 0x74, 0x5c, 0xf1, 0x6c, 0x6c, 0x3a, 0x9e, 0xf7, 0x54, 0xab, 0x93, 0xd7,
-	0x50, 0x5e, 0x18, 0x49, 0x2b, 0xc4, 0xac, 0x1a, 0x59, 0x87, 0xf7, 0x1a, 0xd9, 0xdb, 0xb5, 0x91,
-	0x3f, 0x6c, 0x1b, 0x3d, 0xf1, 0xb0, 0x35, 0x8e, 0xa5, 0xf3, 0x8f, 0x5a, 0xc6, 0x6a, 0xe7, 0xbf,
-	0x9b, 0x9d, 0xfe, 0x6b, 0xe1, 0xfe, 0x15, 0x94, 0x17, 0x26, 0xd1, 0x0a, 0xf7, 0xab, 0x26, 0xd5,
-	0x8d, 0xee, 0xeb, 0xd2, 0xfd, 0x07, 0xf5, 0xb7, 0x70, 0x4f, 0x5e, 0xc1, 0xce, 0x7c, 0x67, 0x92,
-	0x0f, 0xde, 0xd4, 0x46, 0x79, 0xe3, 0x1e, 0xfe, 0xe0, 0xa6, 0x16, 0x61, 0xc6, 0xc7, 0xd2, 0xf9,
-	0x43, 0xf2, 0xfe, 0x1b, 0x13, 0x2f, 0x66, 0x07, 0xf9, 0x16, 0x60, 0xd6, 0x1c, 0x64, 0xb9, 0x81,
-	0x97, 0x3a, 0xe7, 0xf0, 0xc6, 0xd6, 0xcc, 0x63, 0x26, 0x6f, 0x11, 0xf3, 0xc9, 0xbf, 0x0a, 0x7f,
-	0x6b, 0xff, 0xa3, 0x40, 0xfe, 0xad, 0x41, 0x45, 0x2d, 0xd7, 0x87, 0x98, 0x7c, 0x1b, 0xb8, 0x68,
-	0x7c, 0x05, 0x0f, 0x72, 0xa8, 0x7d, 0x66, 0xea, 0xc7, 0xba, 0xda, 0x49, 0x8f, 0x13, 0xfa, 0x35,
-	0xba, 0x9c, 0xbc, 0x3f, 0xe1, 0x3c, 0x66, 0x4f, 0x9b, 0x4d, 0x3f, 0xe0, 0x93, 0x74, 0xdc, 0x70,
-	0xe9, 0xb4, 0xe9, 0x07, 0xde, 0xa5, 0x28, 0xa6, 0x8c, 0x7a, 0x78, 0xd7, 0x0f, 0x3c, 0xa4, 0xd1,
-	0xc4, 0x71, 0x31, 0xf9, 0xb9, 0x3f, 0x75, 0x82, 0x50, 0xb0, 0xea, 0xbf, 0x82, 0x83, 0x93, 0x61,
-	0x57, 0xff, 0xfc, 0xb8, 0x13, 0x3a, 0x29, 0x43, 0xbd, 0x1f, 0xb8, 0x28, 0xee, 0xcb, 0x9f, 0xde,
-	0xba, 0x63, 0x73, 0x1c, 0xd2, 0x71, 0x73, 0xea, 0x30, 0x8e, 0x49, 0xb3, 0x6f, 0x76, 0x7a, 0x83,
-	0x61, 0xaf, 0xc1, 0x5f, 0xf2, 0x56, 0xf1, 0xb3, 0xc6, 0xe3, 0x7a, 0x51, 0x2b, 0xac, 0xb5, 0xaa,
-	0x4e, 0x1c, 0x87, 0x81, 0x2b, 0xff, 0x19, 0x6a, 0x7e, 0xcd, 0x68, 0xf4, 0x74, 0x09, 0xb1, 0x7e,
-	0x06, 0xc5, 0x27, 0x8f, 0x9f, 0x90, 0x27, 0x50, 0xb7, 0x90, 0xa7, 0x49, 0x84, 0x9e, 0xfe, 0xdd,
-	0x04, 0x23, 0x9d, 0x4f, 0x50, 0x4f, 0x30, 0x7b, 0xbb, 0xeb, 0x1e, 0x45, 0xa6, 0x47, 0x94, 0xeb,
-	0xf8, 0x32, 0x60, 0xbc, 0x41, 0x36, 0x60, 0xed, 0xfb, 0x82, 0xb6, 0xf1, 0xeb, 0xfc, 0xda, 0x1c,
-	0x6f, 0xc8, 0x62, 0xfb, 0xfc, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x4d, 0x24, 0xd5, 0xf4,
-	0x0d, 0x00, 0x00,
+	// 3877 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x5b, 0x73, 0xe3, 0x46,
+	0x76, 0x1e, 0x90, 0xba, 0x50, 0x87, 0xa2, 0x04, 0xf5, 0x48, 0x32, 0xad, 0xf1, 0xec, 0xd2, 0x98,
+	0xf5, 0x78, 0x4c, 0x7b, 0x24, 0x5b, 0x9e, 0xdd, 0xd8, 0x63, 0x67, 0x63, 0x8a, 0x84, 0x24, 0xcc,
+	0xf0, 0xe6, 0x06, 0x38, 0x97, 0xa4, 0x52, 0x08, 0x44, 0xb4, 0x28, 0xec, 0x80, 0x00, 0x03, 0x34,
+	0x47, 0x23, 0xbb, 0xbc, 0x71, 0x6d, 0x55, 0x1e, 0x52, 0x49, 0x2a, 0x55, 0xd9, 0xca, 0x43, 0xb6,
+	0x92, 0xdd, 0xf7, 0xa4, 0x52, 0x95, 0x87, 0xbc, 0x24, 0x95, 0xc7, 0xfd, 0x05, 0xc9, 0x5f, 0xc8,
+	0x53, 0x7e, 0x45, 0xaa, 0x2f, 0xe0, 0x9d, 0xba, 0x54, 0x92, 0x27, 0xa1, 0x4f, 0x7f, 0xdd, 0xfd,
+	0xf5, 0xe9, 0xd3, 0xa7, 0xcf, 0x39, 0x14, 0xe4, 0xda, 0x7d, 0x9f, 0xf6, 0x23, 0xb2, 0xdb, 0x8b,
+	0x42, 0x1a, 0xa2, 0x75, 0x27, 0xa0, 0xde, 0x49, 0xbf, 0xb3, 0x2b, 0xc5, 0x3b, 0x77, 0x3a, 0x61,
+	0xd8, 0xf1, 0xc9, 0x1e, 0xef, 0x3e, 0xe9, 0x9f, 0xee, 0x91, 0x6e, 0x8f, 0x5e, 0x08, 0xf4, 0xce,
+	0x3b, 0xb2, 0xd3, 0xe9, 0x79, 0x7b, 0x4e, 0x10, 0x84, 0xd4, 0xa1, 0x5e, 0x18, 0xc4, 0xb2, 0xf7,
+	0x23, 0xfe, 0xa7, 0xfd, 0xb0, 0x43, 0x82, 0x87, 0xf1, 0xb9, 0xd3, 0xe9, 0x90, 0x68, 0x2f, 0xec,
+	0x71, 0xc4, 0x34, 0x5a, 0xfb, 0xe7, 0x0c, 0x2c, 0x97, 0xc5, 0xa2, 0xe8, 0x2e, 0x80, 0x5c, 0xdf,
+	0xf6, 0xdc, 0xbc, 0x52, 0x50, 0x1e, 0xac, 0xe0, 0x15, 0x29, 0x31, 0x5c, 0xf4, 0x03, 0xc8, 0xfa,
+	0xce, 0x89, 0x4d, 0x49, 0xfb, 0x8c, 0xf5, 0xa7, 0x44, 0xbf, 0xef, 0x9c, 0x58, 0xa4, 0x7d, 0x66,
+	0xb8, 0xe8, 0x87, 0x90, 0x3d, 0x0b, 0xe3, 0x9e, 0x47, 0x1d, 0x9f, 0xf5, 0xa7, 0x79, 0x3f, 0x24,
+	0x22, 0x01, 0x68, 0x87, 0xfd, 0x80, 0x5e, 0xd8, 0xed, 0xd0, 0x25, 0xf9, 0x05, 0x01, 0x10, 0xa2,
+	0x72, 0xe8, 0x12, 0x74, 0x1f, 0xd6, 0xe3, 0xfe, 0x89, 0x3d, 0x0a, 0x5a, 0xe4, 0xa0, 0x5c, 0xdc,
+	0x3f, 0x29, 0x0f, 0x71, 0x77, 0x01, 0x7a, 0x0e, 0xf5, 0x48, 0x40, 0xd9, 0x42, 0x4b, 0x82, 0x88,
+	0x94, 0x18, 0x2e, 0x7a, 0x0f, 0xd6, 0x92, 0xee, 0x0e, 0x09, 0x5c, 0x12, 0xe5, 0x97, 0xc5, 0x2c,
+	0x52, 0x7a, 0xc4, 0x85, 0x8c, 0x4e, 0x02, 0x73, 0x3a, 0x24, 0x9f, 0x29, 0x28, 0x0f, 0x16, 0x71,
+	0x32, 0x71, 0xa9, 0x43, 0x50, 0x1e, 0x96, 0x89, 0xeb, 0xd1, 0x30, 0x8a, 0xf3, 0x2b, 0x85, 0xf4,
+	0x83, 0x15, 0x9c, 0x34, 0xd1, 0x97, 0x90, 0xa5, 0x24, 0xa6, 0x76, 0x97, 0xd0, 0xb3, 0xd0, 0xcd,
+	0x43, 0x41, 0x79, 0xb0, 0xb6, 0x7f, 0x67, 0x77, 0xe2, 0x14, 0x77, 0x2d, 0x12, 0xd3, 0x1a, 0x87,
+	0x60, 0xa0, 0x83, 0x6f, 0xc6, 0x2f, 0xd1, 0x73, 0x1c, 0xf6, 0xa3, 0x36, 0xc9, 0x67, 0x05, 0x3f,
+	0x29, 0x35, 0xb9, 0x10, 0xbd, 0x0f, 0xeb, 0x3d, 0x87, 0x9e, 0x85, 0x1d, 0x12, 0xc4, 0xf6, 0x69,
+	0xd8, 0x0f, 0xdc, 0xfc, 0x2a, 0xa7, 0xb1, 0x36, 0x10, 0x1f, 0x32, 0x29, 0xda, 0x83, 0xdb, 0x6c,
+	0xe5, 0xae, 0xd7, 0x8e, 0xc2, 0x13, 0xcf, 0xf1, 0x63, 0xbb, 0x1f, 0x13, 0x37, 0x9f, 0xe3, 0x60,
+	0x34, 0xde, 0xd5, 0x8a, 0x89, 0x8b, 0x8e, 0x60, 0x3d, 0x21, 0x10, 0x91, 0xb8, 0xef, 0xd3, 0x38,
+	0xbf, 0x56, 0x48, 0x3f, 0xc8, 0xee, 0xff, 0x60, 0x6a, 0x0b, 0x55, 0x76, 0xbc, 0x31, 0xc5, 0x1c,
+	0x86, 0x13, 0xde, 0xa2, 0x19, 0xa3, 0x7d, 0xd8, 0x92, 0x13, 0xd8, 0xd4, 0xeb, 0x92, 0x98, 0x3a,
+	0xdd, 0x9e, 0x1d, 0x93, 0x76, 0x7e, 0xbd, 0xa0, 0x3c, 0x48, 0xe3, 0xdb, 0xb2, 0xd3, 0x4a, 0xfa,
+	0x4c, 0xd2, 0x46, 0x36, 0xbc, 0xe5, 0xc5, 0xa1, 0xef, 0x50, 0x62, 0xb7, 0x7d, 0x27, 0x8e, 0xbd,
+	0x53, 0xaf, 0x2d, 0x4c, 0x32, 0xaf, 0x72, 0x12, 0xf7, 0xa7, 0x48, 0x18, 0x02, 0x5f, 0x1e, 0x83,
+	0xe3, 0x6d, 0x6f, 0x96, 0x38, 0x46, 0x3f, 0x81, 0xa5, 0x98, 0x3a, 0xb4, 0x1f, 0xe7, 0x37, 0xf8,
+	0xb9, 0x4c, 0x6f, 0x4a, 0x1a, 0xbc, 0xc9, 0x51, 0x58, 0xa2, 0x11, 0x82, 0x85, 0x73, 0x27, 0x72,
+	0xf3, 0x88, 0x1f, 0x06, 0xff, 0x46, 0x5f, 0x00, 0xb8, 0xa4, 0xe7, 0x44, 0xb4, 0x4b, 0x02, 0x9a,
+	0xbf, 0x3d, 0xe7, 0x9c, 0x2b, 0x03, 0x08, 0x1e, 0x81, 0xa3, 0x63, 0x58, 0x97, 0xd6, 0x64, 0xc7,
+	0x84, 0x52, 0x2f, 0xe8, 0xe4, 0x37, 0xf9, 0x0c, 0x3f, 0x9c, 0x9a, 0xa1, 0x29, 0x70, 0xa6, 0x80,
+	0xe1, 0xb5, 0xde, 0x58, 0x1b, 0x1d, 0x40, 0x2e, 0xee, 0x91, 0xb6, 0xd7, 0x25, 0x81, 0x4d, 0x2f,
+	0x7a, 0x24, 0xbf, 0xc5, 0xe7, 0xb9, 0x3b, 0x35, 0x8f, 0x29, 0x51, 0xd6, 0x45, 0x8f, 0xe0, 0xd5,
+	0x78, 0xa4, 0x85, 0x8e, 0xa0, 0x30, 0x98, 0xa3, 0x1d, 0xfa, 0x3e, 0x69, 0x53, 0xe2, 0x4e, 0x1c,
+	0xdb, 0x36, 0x3f, 0xb6, 0xbb, 0x09, 0xae, 0x9c, 0xc0, 0x46, 0x0f, 0x50, 0xfb, 0x0e, 0x32, 0x4d,
+	0x69, 0x80, 0xf2, 0x0e, 0xf1, 0xef, 0xa1, 0xcf, 0x80, 0x44, 0x64, 0xb8, 0xe8, 0x1e, 0xe4, 0x06,
+	0x80, 0xc0, 0xe9, 0x12, 0xe9, 0x36, 0x56, 0x13, 0x61, 0xdd, 0xe9, 0x12, 0xf4, 0x21, 0x6c, 0x0c,
+	0x40, 0x6d, 0x87, 0x92, 0x4e, 0x18, 0x5d, 0x48, 0xff, 0xa1, 0x26, 0x1d, 0x65, 0x29, 0xd7, 0x7e,
+	0xa5, 0x40, 0xae, 0x34, 0x6a, 0xd3, 0xe8, 0x03, 0x50, 0xc7, 0x8c, 0x7c, 0xc8, 0x64, 0x7d, 0x4c,
+	0x6e, 0xb8, 0xe8, 0x21, 0x8c, 0xdf, 0x87, 0x51, 0x4e, 0x1b, 0x63, 0x3d, 0x9c, 0xd8, 0xe4, 0xcd,
+	0x12, 0x16, 0x2b, 0xa9, 0x8d, 0xcf, 0xc4, 0xad, 0x50, 0xf3, 0x21, 0x5d, 0x33, 0xca, 0x68, 0x13,
+	0x16, 0x5f, 0x3b, 0x7e, 0x9f, 0x70, 0x1a, 0x0a, 0x16, 0x0d, 0x66, 0x4c, 0xed, 0xb0, 0xdb, 0x73,
+	0x22, 0x87, 0x86, 0x51, 0x3e, 0x35, 0xc7, 0x98, 0xca, 0x03, 0x08, 0x1e, 0x81, 0x33, 0xeb, 0xec,
+	0x07, 0x1e, 0x95, 0x6b, 0xf3, 0x6f, 0xed, 0x37, 0x8b, 0x90, 0x1b, 0xbb, 0xa0, 0xd3, 0xea, 0x56,
+	0x66, 0xa8, 0x7b, 0xe2, 0xd0, 0x52, 0x53, 0x87, 0x36, 0x4b, 0xa1, 0xe9, 0x9b, 0x28, 0x74, 0x61,
+	0x9e, 0x42, 0xef, 0x41, 0xce, 0xf5, 0xe2, 0x57, 0xb6, 0xeb, 0x39, 0x5d, 0x42, 0x49, 0x24, 0xfd,
+	0xfb, 0x2a, 0x13, 0x56, 0xa4, 0x0c, 0x7d, 0x0c, 0x9b, 0x41, 0x18, 0xd8, 0xae, 0x77, 0x7a, 0xda,
+	0x8f, 0xbd, 0x30, 0x90, 0x4e, 0x4a, 0x3a, 0x7a, 0x14, 0x84, 0x41, 0x25, 0xe9, 0x92, 0xdb, 0x7e,
+	0x0f, 0xd6, 0x04, 0xc6, 0x6e, 0x87, 0x5d, 0x7e, 0x55, 0xa5, 0xc7, 0x17, 0xd2, 0xb2, 0x10, 0xa2,
+	0x4f, 0x60, 0x33, 0xee, 0xc7, 0x6d, 0xd2, 0xa3, 0xde, 0x89, 0xe7, 0x7b, 0xf4, 0xc2, 0x8e, 0xdb,
+	0x61, 0x24, 0x5c, 0x7f, 0x0a, 0xdf, 0x1e, 0xef, 0x33, 0x59, 0x17, 0xfa, 0x08, 0x16, 0x7d, 0xe7,
+	0x84, 0xf8, 0xf9, 0x15, 0x7e, 0x5c, 0xdb, 0xb3, 0x1c, 0x24, 0xf1, 0xb1, 0x00, 0xa1, 0xdf, 0x65,
+	0x3c, 0x7a, 0x61, 0xc4, 0x6e, 0x96, 0x18, 0x06, 0x97, 0x0e, 0xcb, 0x25, 0x68, 0xde, 0x64, 0xca,
+	0xe4, 0xa3, 0x6c, 0xd7, 0x8b, 0x9d, 0x4e, 0x44, 0x08, 0xdf, 0x0a, 0x7b, 0x1c, 0x32, 0x78, 0x83,
+	0xf7, 0x54, 0x46, 0x3a, 0x18, 0xfc, 0x24, 0x22, 0xce, 0xab, 0x5e, 0xe8, 0x05, 0xd4, 0x7e, 0x4d,
+	0x22, 0xa6, 0x91, 0xfc, 0xaa, 0xd0, 0xfd, 0xb0, 0xe7, 0x99, 0xe8, 0x40, 0xf7, 0x21, 0xdd, 0xf5,
+	0xda, 0xf9, 0x5c, 0x41, 0x79, 0x90, 0xdd, 0xdf, 0x9c, 0x62, 0x54, 0x33, 0xca, 0x98, 0x01, 0xd0,
+	0x13, 0x50, 0xa3, 0xbe, 0x4f, 0xec, 0x91, 0x60, 0x41, 0x3e, 0x0f, 0xd3, 0x7e, 0x0b, 0xf7, 0x7d,
+	0x52, 0x1a, 0xe0, 0xf0, 0x7a, 0x34, 0xd6, 0x8e, 0xb5, 0x6f, 0x60, 0x6d, 0x1c, 0x82, 0xde, 0x82,
+	0x65, 0x3e, 0xfb, 0xe0, 0x8e, 0x2e, 0xb1, 0xa6, 0xe1, 0xa2, 0xb7, 0x21, 0xc3, 0x3b, 0x62, 0x42,
+	0xa5, 0x49, 0x72, 0xa0, 0x49, 0x28, 0x7b, 0x88, 0xbb, 0x24, 0x8e, 0xd9, 0x2b, 0x2d, 0xcc, 0x30,
+	0x69, 0xa2, 0x1d, 0xc8, 0x78, 0xc1, 0x29, 0x89, 0x22, 0xe2, 0x72, 0xa3, 0xcb, 0xe0, 0x41, 0x5b,
+	0xfb, 0x9b, 0x14, 0x6c, 0xcd, 0x7c, 0x39, 0xfe, 0x8f, 0xbc, 0xd6, 0x53, 0x50, 0x23, 0x12, 0x7b,
+	0x31, 0x75, 0x82, 0x36, 0x19, 0xf1, 0x0c, 0x6b, 0xfb, 0x85, 0x69, 0x3d, 0x0d, 0x80, 0x9c, 0x0a,
+	0x5e, 0x8f, 0xc6, 0x05, 0xcc, 0x05, 0x4a, 0xcf, 0xe7, 0x91, 0xd8, 0xa6, 0x24, 0xa6, 0x72, 0x47,
+	0x8b, 0x58, 0x1d, 0x76, 0x58, 0x5c, 0x8e, 0xbe, 0x84, 0x1d, 0x76, 0x41, 0x06, 0xf6, 0xea, 0x13,
+	0x7b, 0x88, 0xc9, 0x2f, 0xf2, 0x77, 0x3f, 0x1f, 0x84, 0x81, 0x39, 0x04, 0x94, 0x07, 0xfd, 0xda,
+	0xdf, 0x29, 0xb0, 0x59, 0x8e, 0x08, 0x53, 0x4b, 0xf2, 0x9a, 0xff, 0x71, 0x9f, 0xc4, 0x14, 0xed,
+	0xc3, 0xb2, 0xe4, 0xcb, 0x55, 0x92, 0xdd, 0xcf, 0xcf, 0x7b, 0x39, 0x71, 0x02, 0x64, 0x41, 0x8a,
+	0xe7, 0x92, 0x6e, 0x2f, 0xa4, 0x24, 0x68, 0x5f, 0xd8, 0xaf, 0xc8, 0x85, 0xd4, 0xd5, 0xda, 0x88,
+	0xf8, 0x29, 0xb9, 0x60, 0x40, 0xc7, 0xf7, 0xc3, 0x73, 0xdb, 0xed, 0xf7, 0x7c, 0x76, 0x10, 0xe2,
+	0x2c, 0x33, 0x78, 0x8d, 0x8b, 0x2b, 0x89, 0x54, 0x8b, 0x61, 0x6b, 0x82, 0x5d, 0xdc, 0x0b, 0x83,
+	0xf8, 0xca, 0xf0, 0xf4, 0x0b, 0xc8, 0x9c, 0x3b, 0x51, 0xe0, 0x05, 0x9d, 0x38, 0x9f, 0xba, 0x9e,
+	0xb9, 0x0e, 0x06, 0x68, 0xbf, 0x56, 0x60, 0xb3, 0xd5, 0x73, 0xa7, 0x75, 0x72, 0xc5, 0xa2, 0x77,
+	0x60, 0x45, 0xc4, 0x84, 0x43, 0x47, 0x9a, 0x11, 0x02, 0xc3, 0x1d, 0xd5, 0x67, 0xfa, 0xba, 0xfa,
+	0xdc, 0x86, 0xa5, 0x88, 0x38, 0x71, 0x18, 0x48, 0x1f, 0x2a, 0x5b, 0x9a, 0x05, 0x5b, 0x13, 0xfc,
+	0xa4, 0x56, 0x46, 0xb7, 0xad, 0xdc, 0x74, 0xdb, 0x35, 0xd8, 0xac, 0x10, 0x9f, 0xdc, 0x74, 0xd7,
+	0x43, 0x92, 0xa9, 0x31, 0x92, 0x7f, 0xa6, 0x40, 0xde, 0x8a, 0x9c, 0x20, 0xf6, 0xd8, 0x3a, 0x37,
+	0x9b, 0x73, 0x18, 0xb5, 0xa5, 0x6e, 0x14, 0xb5, 0x0d, 0xb9, 0xa4, 0xc7, 0xb8, 0x7c, 0x06, 0x79,
+	0x4c, 0x3c, 0x97, 0x04, 0xd4, 0x3b, 0xbd, 0x90, 0xe1, 0x55, 0x42, 0xe5, 0x1d, 0x58, 0xe9, 0xc5,
+	0xa4, 0xef, 0x86, 0xc1, 0x45, 0x37, 0x61, 0x32, 0x10, 0x68, 0x75, 0x58, 0x6f, 0x26, 0xb9, 0x04,
+	0x1b, 0x4e, 0x2f, 0x2e, 0x1f, 0x30, 0x91, 0x8e, 0xa4, 0x26, 0xd2, 0x11, 0xed, 0x0f, 0x21, 0x7b,
+	0xe8, 0x11, 0xdf, 0x2d, 0x9f, 0x39, 0x41, 0x87, 0xb0, 0xd8, 0xe0, 0x94, 0x35, 0xe5, 0x3c, 0xa2,
+	0xc1, 0x0c, 0x29, 0xf4, 0x5d, 0x5b, 0x44, 0x0d, 0xd2, 0x90, 0x42, 0xdf, 0x7d, 0xc6, 0xda, 0xac,
+	0x33, 0x20, 0xe7, 0xb2, 0x53, 0x6c, 0x33, 0x13, 0x90, 0x73, 0xde, 0xa9, 0xfd, 0x45, 0x1a, 0xd6,
+	0x07, 0xaa, 0x7e, 0xed, 0x71, 0x57, 0x7f, 0x85, 0xae, 0xdf, 0x87, 0xf5, 0x48, 0x42, 0xed, 0xa0,
+	0xdf, 0x3d, 0x21, 0x22, 0x1a, 0x59, 0xc4, 0x6b, 0x89, 0xb8, 0xce, 0xa5, 0xe8, 0x2b, 0x58, 0x09,
+	0x7b, 0x24, 0xe2, 0x66, 0x23, 0x7d, 0x9b, 0x36, 0xc3, 0xb7, 0x89, 0x31, 0x8d, 0x04, 0x89, 0x87,
+	0x83, 0x98, 0x57, 0x77, 0xda, 0xf2, 0x7e, 0x08, 0x8b, 0x5e, 0xe6, 0xed, 0x31, 0x2b, 0x5a, 0x1c,
+	0x3d, 0x39, 0xe6, 0x7c, 0xc7, 0xa3, 0xd2, 0x25, 0x1e, 0x95, 0xae, 0xd2, 0xd1, 0x2c, 0xe2, 0x27,
+	0xb0, 0xdc, 0xe6, 0xfa, 0x8c, 0xf3, 0xcb, 0xdc, 0xea, 0xdf, 0x99, 0xe2, 0x35, 0xa2, 0x74, 0x9c,
+	0x80, 0x47, 0xef, 0x64, 0xe6, 0xba, 0x77, 0xf2, 0x43, 0xd8, 0x88, 0x48, 0x4c, 0xc3, 0x88, 0xb8,
+	0x76, 0xa2, 0x20, 0x1e, 0x0f, 0x2c, 0x62, 0x35, 0xe9, 0x48, 0x94, 0xa0, 0xfd, 0x1c, 0xee, 0x54,
+	0xbd, 0x98, 0x4e, 0x9c, 0x48, 0x7c, 0xcd, 0x5b, 0xc0, 0x4d, 0xa9, 0x43, 0x6c, 0x1a, 0xbe, 0x22,
+	0x89, 0x0b, 0x58, 0x61, 0x12, 0x8b, 0x09, 0x98, 0x21, 0xf0, 0xee, 0xd8, 0xfb, 0x46, 0x18, 0xc2,
+	0x22, 0xce, 0x30, 0x81, 0xe9, 0x7d, 0x43, 0x9e, 0x2c, 0x64, 0x52, 0x6a, 0x5a, 0xfb, 0x5e, 0x01,
+	0x75, 0x72, 0x71, 0xf4, 0x53, 0x58, 0x49, 0x88, 0x27, 0x5e, 0xa2, 0x30, 0x77, 0xdf, 0x12, 0x88,
+	0x87, 0x43, 0x58, 0x62, 0x1e, 0x90, 0x37, 0xd4, 0x1e, 0xe1, 0x26, 0xcc, 0x30, 0xc7, 0xc4, 0xcd,
+	0x84, 0x9f, 0xa4, 0xd0, 0x86, 0xb7, 0x8f, 0xc8, 0xa4, 0x06, 0xae, 0xa9, 0x80, 0xeb, 0x9a, 0xa6,
+	0xf6, 0x27, 0x70, 0x17, 0x0b, 0xdd, 0xff, 0xff, 0x2e, 0x34, 0xd7, 0xc1, 0x7c, 0xaf, 0x00, 0x54,
+	0x1c, 0x4a, 0x0e, 0x3d, 0x9f, 0x05, 0xad, 0xbb, 0x70, 0x3b, 0xa6, 0x4e, 0x44, 0x27, 0x32, 0x2a,
+	0x85, 0xdb, 0xee, 0x06, 0xef, 0x1a, 0x4b, 0x83, 0x8b, 0xb0, 0x41, 0x82, 0xc9, 0xfc, 0x2b, 0xc5,
+	0xd1, 0xeb, 0x24, 0x18, 0xcb, 0xb8, 0x18, 0x85, 0x53, 0xbe, 0x8a, 0x7c, 0x32, 0x65, 0x4b, 0xfb,
+	0x6f, 0x05, 0x36, 0x46, 0x8c, 0x4d, 0x32, 0xf9, 0x12, 0xb2, 0xec, 0xa1, 0xb0, 0xe5, 0x10, 0xf1,
+	0x94, 0xcf, 0x48, 0x5a, 0x07, 0xdc, 0x31, 0xb8, 0xc3, 0x7d, 0x7c, 0x09, 0x59, 0xdf, 0x8b, 0xa9,
+	0x4d, 0x9d, 0xa8, 0x43, 0xe8, 0xdc, 0x2c, 0x85, 0x2d, 0x6b, 0x71, 0x08, 0x06, 0x7f, 0xf0, 0xcd,
+	0x94, 0x2e, 0x06, 0xda, 0x9e, 0xcb, 0xa2, 0x21, 0x16, 0x89, 0xac, 0x08, 0x89, 0xe1, 0xc6, 0xe8,
+	0x31, 0x64, 0x84, 0xdb, 0x26, 0x71, 0x7e, 0xa1, 0x90, 0xbe, 0x86, 0x9b, 0x1f, 0xe0, 0xd9, 0x66,
+	0x6f, 0x8f, 0x6c, 0x76, 0xf4, 0x46, 0x5d, 0xfb, 0xca, 0xa4, 0xc6, 0xaf, 0x0c, 0x7a, 0x3c, 0xa6,
+	0xd8, 0xec, 0x0c, 0xe7, 0x36, 0xa5, 0xde, 0x44, 0xf9, 0xe8, 0x63, 0x58, 0x88, 0xc3, 0x88, 0x72,
+	0xe7, 0xb5, 0x36, 0xc3, 0xfd, 0x24, 0xfb, 0x08, 0x23, 0x8a, 0x39, 0x92, 0x39, 0x36, 0x2f, 0x68,
+	0xfb, 0x7d, 0x97, 0x91, 0xa5, 0x8e, 0xcf, 0x1d, 0x5b, 0x06, 0xaf, 0x4a, 0xa1, 0xc5, 0x64, 0x4f,
+	0x16, 0x32, 0x8a, 0x9a, 0xd2, 0xfe, 0x4a, 0x81, 0x4c, 0xb2, 0x51, 0xf4, 0x08, 0x32, 0x72, 0xd2,
+	0xe4, 0xf2, 0xce, 0x77, 0x5a, 0x03, 0xe4, 0x75, 0xef, 0x2c, 0x0b, 0x86, 0x39, 0x1b, 0x51, 0x76,
+	0xe3, 0x0a, 0x4c, 0x63, 0xe0, 0x22, 0x5e, 0x72, 0x93, 0x97, 0x7a, 0x1f, 0x36, 0x46, 0x2f, 0xf5,
+	0x75, 0xee, 0x98, 0xf6, 0x5b, 0x05, 0x90, 0xd1, 0x65, 0x19, 0x0e, 0x96, 0x79, 0x98, 0xdf, 0xef,
+	0x06, 0xcc, 0x9c, 0xdb, 0xfc, 0x2b, 0xc9, 0x00, 0x44, 0x6b, 0x66, 0xda, 0x99, 0xba, 0x49, 0xda,
+	0x99, 0x9e, 0x97, 0x76, 0x4e, 0xd4, 0xeb, 0x16, 0x6e, 0x54, 0xaf, 0xd3, 0x7e, 0xbd, 0x00, 0x39,
+	0xb1, 0x8d, 0x9a, 0xd3, 0xeb, 0x89, 0x7a, 0xcc, 0x12, 0x7f, 0xb6, 0x93, 0xf3, 0x28, 0x4e, 0x97,
+	0xac, 0x46, 0xf1, 0xe2, 0x29, 0x8a, 0xf5, 0x80, 0x46, 0x17, 0x58, 0x8e, 0x44, 0xc7, 0x90, 0x71,
+	0xc9, 0xa9, 0xc3, 0xab, 0x6f, 0x22, 0x5e, 0xfd, 0xe8, 0x8a, 0x59, 0x2a, 0x12, 0x2e, 0xe6, 0x19,
+	0x8c, 0x46, 0x4f, 0x46, 0xb2, 0x5f, 0xa6, 0x48, 0x71, 0xf1, 0xb2, 0xfb, 0xf7, 0xe6, 0xcc, 0x37,
+	0x7a, 0x18, 0xc3, 0x14, 0x99, 0x8f, 0x44, 0x2f, 0x60, 0x6d, 0x2c, 0xf3, 0x11, 0xf7, 0x34, 0xbb,
+	0xff, 0xc9, 0x15, 0xdc, 0x9a, 0x23, 0x99, 0x91, 0x24, 0x98, 0x1b, 0xcd, 0x96, 0x62, 0x66, 0x67,
+	0xdc, 0x2d, 0xf9, 0xce, 0x45, 0xd8, 0xa7, 0xf2, 0xcd, 0xe7, 0x9e, 0xa7, 0xca, 0x25, 0x3b, 0x9f,
+	0xcb, 0x38, 0x49, 0x0c, 0x47, 0x2a, 0xa4, 0x59, 0x36, 0x21, 0x4c, 0x84, 0x7d, 0x0e, 0xab, 0x2a,
+	0xc2, 0x28, 0x44, 0xe3, 0x71, 0xea, 0x33, 0x65, 0xe7, 0x0b, 0xc8, 0x8d, 0x29, 0xe7, 0x46, 0x83,
+	0xbf, 0x02, 0x34, 0xcd, 0xfe, 0x26, 0x33, 0x68, 0xbf, 0x51, 0x12, 0x03, 0x69, 0x88, 0x42, 0x3b,
+	0xfa, 0x31, 0x2c, 0x9d, 0x86, 0x51, 0xd7, 0xa1, 0x79, 0x65, 0x4e, 0xa5, 0x4e, 0xe0, 0x0f, 0x39,
+	0x08, 0x4b, 0x30, 0xfa, 0x0c, 0x96, 0xbb, 0x42, 0xa1, 0x7c, 0x91, 0x59, 0x05, 0xd9, 0x31, 0xb5,
+	0xe3, 0x04, 0xce, 0x6e, 0xe2, 0x89, 0x43, 0xdb, 0x67, 0xa3, 0xa1, 0xc1, 0x0a, 0x97, 0x30, 0x47,
+	0xa7, 0xbd, 0x86, 0x2d, 0x31, 0x70, 0xd2, 0x7b, 0x3e, 0x86, 0x65, 0xf9, 0xe3, 0x40, 0x5e, 0xb9,
+	0x74, 0x45, 0xb9, 0xb3, 0xe3, 0x5b, 0x38, 0x19, 0x80, 0xb6, 0x61, 0xb1, 0x7d, 0xd6, 0x0f, 0x5e,
+	0x71, 0xae, 0xab, 0xc7, 0xb7, 0xb0, 0x68, 0x1e, 0xac, 0xc0, 0x72, 0xcf, 0xb9, 0xf0, 0x43, 0xc7,
+	0xd5, 0xbe, 0x84, 0x35, 0x69, 0x73, 0xe1, 0xb9, 0x1e, 0x45, 0x61, 0xc4, 0xf4, 0x1a, 0x85, 0xe7,
+	0xf2, 0x5d, 0x64, 0x9f, 0xa3, 0xd9, 0x7d, 0x6a, 0x2c, 0xbb, 0xd7, 0xfe, 0x45, 0x81, 0xed, 0x49,
+	0xda, 0x32, 0xed, 0xb9, 0x03, 0x2b, 0x51, 0x78, 0x1e, 0xdb, 0x11, 0x71, 0x5c, 0x39, 0x59, 0x86,
+	0x09, 0x30, 0x71, 0x78, 0xfa, 0xce, 0x3b, 0xbd, 0xae, 0xa8, 0xae, 0xc8, 0x77, 0x75, 0x95, 0x09,
+	0x0d, 0x29, 0x63, 0xf6, 0xc8, 0x41, 0xa7, 0x8e, 0xe7, 0x13, 0x51, 0xdf, 0x4a, 0x63, 0x60, 0xa2,
+	0x43, 0x2e, 0x41, 0xbf, 0x03, 0x4b, 0x84, 0x51, 0x4e, 0xae, 0xc0, 0x0f, 0xe7, 0x5d, 0x27, 0xb9,
+	0x35, 0x2c, 0xe1, 0xda, 0xcf, 0x61, 0xa3, 0x42, 0xba, 0x61, 0x27, 0x72, 0x7a, 0x67, 0x5e, 0x5b,
+	0xbe, 0xab, 0xd3, 0x3f, 0x4a, 0x28, 0xb3, 0x7e, 0x94, 0x28, 0xc0, 0x2a, 0xf3, 0xd7, 0x5d, 0x2f,
+	0xb0, 0x5d, 0xe7, 0x22, 0x96, 0xcc, 0xc1, 0xe9, 0x90, 0x9a, 0x17, 0x54, 0x9c, 0x8b, 0x78, 0x80,
+	0x70, 0xde, 0x08, 0x44, 0x7a, 0x88, 0x70, 0xde, 0x30, 0x84, 0xf6, 0x1f, 0x0a, 0x6c, 0xe9, 0x6f,
+	0x66, 0x9f, 0xf6, 0xd2, 0x58, 0x54, 0x70, 0x93, 0xf7, 0xee, 0x10, 0x56, 0xdd, 0xe1, 0xae, 0xe2,
+	0x7c, 0x6a, 0xce, 0x0c, 0x53, 0x5b, 0xc7, 0x63, 0xe3, 0x46, 0xae, 0x46, 0x7a, 0xce, 0xd5, 0xd0,
+	0xdf, 0x4c, 0x5f, 0x0d, 0xad, 0x02, 0x59, 0xb9, 0x27, 0x66, 0x63, 0xac, 0x1c, 0xea, 0x3a, 0xd4,
+	0xe1, 0xfb, 0x58, 0xc5, 0xfc, 0x1b, 0xbd, 0x0b, 0xab, 0xed, 0x30, 0xa0, 0x4c, 0xc5, 0xbc, 0x48,
+	0x2e, 0xac, 0x29, 0x2b, 0x65, 0xac, 0x08, 0x5e, 0xfc, 0x47, 0x16, 0xb4, 0x0d, 0x2b, 0xf4, 0x3b,
+	0xb0, 0x5d, 0xd1, 0x9b, 0x25, 0x6c, 0xd5, 0xf4, 0xba, 0x65, 0xb7, 0xea, 0x66, 0x53, 0x2f, 0x1b,
+	0x87, 0x86, 0x5e, 0x51, 0x6f, 0x21, 0x04, 0x6b, 0x46, 0xdd, 0xd2, 0xeb, 0xa6, 0xf1, 0x4c, 0xb7,
+	0xcb, 0x25, 0xac, 0xab, 0x0a, 0x5a, 0x85, 0x4c, 0x5d, 0x6f, 0xd4, 0x4b, 0x56, 0xa9, 0xaa, 0xa6,
+	0xd0, 0x3a, 0x64, 0x9b, 0x25, 0xbd, 0x62, 0x94, 0x2c, 0x6c, 0x94, 0x4d, 0x35, 0xcd, 0xba, 0xcd,
+	0x16, 0x3e, 0x32, 0xca, 0xa5, 0xaa, 0xba, 0x80, 0xb2, 0xb0, 0x5c, 0xd3, 0x2b, 0xbc, 0xb1, 0x88,
+	0x72, 0xb0, 0x52, 0x2b, 0x59, 0x3a, 0xae, 0x1b, 0xd6, 0x4b, 0x75, 0x89, 0x35, 0xf5, 0x9a, 0x8e,
+	0x8f, 0xf4, 0x7a, 0xf9, 0xa5, 0xba, 0x8c, 0xb6, 0x60, 0xa3, 0xd1, 0xb2, 0x9a, 0x25, 0xcb, 0x60,
+	0x3c, 0xca, 0x55, 0xa3, 0x6e, 0x94, 0xd5, 0x4c, 0xf1, 0x39, 0xac, 0x8d, 0xff, 0x30, 0x80, 0xde,
+	0x82, 0xdb, 0xa6, 0x6e, 0x59, 0x46, 0xfd, 0x68, 0x82, 0x6d, 0x0e, 0x56, 0x8c, 0xba, 0x9c, 0x40,
+	0x55, 0xd0, 0x1a, 0xc0, 0x70, 0x42, 0x35, 0xc5, 0xba, 0xcb, 0x8d, 0x5a, 0xad, 0xc5, 0x97, 0x4f,
+	0x17, 0xff, 0x55, 0x81, 0xd5, 0xd1, 0x9f, 0x0a, 0x50, 0x1e, 0x36, 0xf9, 0x6c, 0x35, 0xbd, 0x3e,
+	0x31, 0xf1, 0x0a, 0x2c, 0x1e, 0x54, 0x1b, 0x8d, 0x8a, 0xaa, 0xb0, 0xcf, 0x16, 0x36, 0xea, 0xba,
+	0x9a, 0x62, 0x3c, 0xca, 0x3a, 0xd6, 0x0f, 0x70, 0xc3, 0x6c, 0x1a, 0xf5, 0x52, 0xd5, 0x3e, 0xac,
+	0xb6, 0x8c, 0x8a, 0x9a, 0x46, 0x00, 0x4b, 0x66, 0xb3, 0x65, 0xb5, 0x6a, 0xea, 0x02, 0x23, 0xf1,
+	0xbc, 0xd1, 0xaa, 0x57, 0x6c, 0xf3, 0x79, 0xe9, 0x40, 0x5d, 0x44, 0xcb, 0x90, 0x6e, 0xb6, 0x4c,
+	0x75, 0x89, 0x4d, 0x64, 0x5a, 0x8d, 0x46, 0x55, 0x5d, 0x66, 0x78, 0xcb, 0x30, 0xcd, 0x96, 0xae,
+	0x66, 0x90, 0x0a, 0xab, 0x47, 0x7a, 0xdd, 0xb0, 0x4a, 0x55, 0x31, 0x62, 0x85, 0x9d, 0x41, 0xc3,
+	0x3a, 0xd6, 0xb1, 0x9d, 0x90, 0x53, 0xa1, 0xf8, 0x07, 0x90, 0x1b, 0x0b, 0x11, 0xd9, 0x6c, 0x87,
+	0x8c, 0x83, 0x7a, 0x8b, 0x1d, 0x00, 0xd6, 0xcb, 0xba, 0xf1, 0x4c, 0x67, 0x7c, 0x6f, 0xc3, 0xfa,
+	0x11, 0x6e, 0x3c, 0xb7, 0x8e, 0xed, 0x8a, 0x6e, 0xe9, 0x65, 0x4b, 0xaf, 0xc8, 0x43, 0xc3, 0x7a,
+	0xd5, 0xa8, 0x19, 0xf5, 0x12, 0x7e, 0xa9, 0xa6, 0xd9, 0x31, 0x95, 0x6a, 0x7a, 0xbd, 0xa2, 0x57,
+	0xd4, 0x85, 0x62, 0x03, 0x16, 0x45, 0x29, 0x76, 0x1d, 0xb2, 0x66, 0xcb, 0x2c, 0xeb, 0x4d, 0xcb,
+	0x38, 0xa8, 0xea, 0xea, 0x2d, 0xb4, 0x09, 0x6a, 0xa5, 0x61, 0xea, 0xf6, 0xa8, 0x34, 0xc5, 0x28,
+	0x33, 0x23, 0xc1, 0x35, 0x6e, 0x06, 0xba, 0x9a, 0x66, 0x9a, 0xc6, 0xba, 0x69, 0x98, 0x56, 0xa9,
+	0x6e, 0xa9, 0x0b, 0xc5, 0x5f, 0x28, 0x00, 0xc3, 0xb0, 0x02, 0xdd, 0x81, 0xb7, 0x2c, 0xdd, 0xb4,
+	0xec, 0x9a, 0x6e, 0x1d, 0x37, 0x2a, 0xd3, 0x16, 0x57, 0x31, 0xcc, 0xa7, 0x76, 0xc5, 0x38, 0x3c,
+	0x6c, 0x99, 0x46, 0xa3, 0xae, 0x2e, 0x32, 0x45, 0x1f, 0xe0, 0x86, 0x75, 0x6c, 0xb3, 0xf2, 0x6c,
+	0xa3, 0x62, 0x54, 0x5b, 0x16, 0xeb, 0x50, 0x18, 0xf8, 0x08, 0x97, 0x2a, 0xdc, 0x60, 0x4c, 0x0b,
+	0x1b, 0x4d, 0x35, 0xc5, 0x34, 0xf1, 0xcc, 0xb0, 0xf4, 0xa7, 0x62, 0x57, 0xcd, 0xe3, 0x86, 0x5e,
+	0x37, 0x5e, 0xa8, 0x0b, 0xc5, 0x57, 0x00, 0xc3, 0x5f, 0x15, 0x18, 0x4a, 0xff, 0xba, 0xc5, 0xf5,
+	0x95, 0x83, 0x95, 0xaa, 0x6e, 0x9a, 0xb6, 0x75, 0x5c, 0x62, 0x73, 0x6e, 0x03, 0x1a, 0x34, 0xed,
+	0x06, 0xb6, 0x05, 0x8c, 0xef, 0xf2, 0x08, 0xeb, 0xcc, 0x7c, 0x05, 0x32, 0x8d, 0xde, 0x86, 0xad,
+	0x51, 0xc9, 0x10, 0xbc, 0x50, 0x7c, 0x0a, 0xeb, 0x13, 0xd5, 0x4e, 0x36, 0xbe, 0x55, 0x2f, 0x57,
+	0x4b, 0xa6, 0x99, 0x6c, 0x35, 0x0b, 0xcb, 0xf5, 0x46, 0xdd, 0xae, 0x55, 0xb0, 0xaa, 0x30, 0xbb,
+	0x60, 0x1f, 0x29, 0xf6, 0xf1, 0xa2, 0x82, 0xd5, 0x34, 0xb7, 0x94, 0x0a, 0x56, 0x17, 0x8a, 0xc7,
+	0xb0, 0x31, 0x55, 0x5e, 0x60, 0x83, 0xcb, 0x7c, 0x71, 0x39, 0x53, 0xab, 0x59, 0xe1, 0x0d, 0x85,
+	0x35, 0x2a, 0x7a, 0x55, 0x17, 0x27, 0xcd, 0x8d, 0xc1, 0xb4, 0x1a, 0x58, 0xaf, 0xa8, 0xe9, 0xe2,
+	0x1f, 0x01, 0x0c, 0x73, 0x16, 0xb6, 0x40, 0xa9, 0xca, 0x34, 0x00, 0xb0, 0x54, 0x6e, 0xb4, 0xea,
+	0xd6, 0x4b, 0x71, 0x69, 0xcc, 0xd6, 0x81, 0x2d, 0xdb, 0x7c, 0x82, 0x63, 0x66, 0xdf, 0xec, 0xb6,
+	0x0b, 0x8d, 0xca, 0xfb, 0xb4, 0xc0, 0xb4, 0x5f, 0x2d, 0x1d, 0xd8, 0x96, 0x5e, 0x3e, 0xae, 0x1b,
+	0x65, 0xa3, 0x54, 0x57, 0x17, 0x8b, 0x25, 0xc8, 0x8e, 0xc4, 0xfc, 0xec, 0x4a, 0x61, 0xdd, 0x6c,
+	0x55, 0x2d, 0xd3, 0xae, 0xeb, 0xcf, 0xd9, 0xa1, 0x1f, 0x1a, 0xd8, 0xb4, 0xd4, 0x5b, 0xa3, 0x3d,
+	0x8d, 0x6a, 0x65, 0xd8, 0xa3, 0x14, 0xef, 0xc1, 0xea, 0x68, 0x5c, 0xc0, 0x68, 0x96, 0xcd, 0x67,
+	0x82, 0xe6, 0xf3, 0xe3, 0x46, 0x5d, 0x67, 0x20, 0x1d, 0x56, 0x47, 0x3d, 0x24, 0xa3, 0xad, 0xbf,
+	0x68, 0x36, 0xb0, 0x65, 0x0b, 0xec, 0x06, 0xe4, 0x64, 0xbb, 0x5e, 0x79, 0x62, 0x26, 0xc6, 0x22,
+	0x45, 0xcd, 0x12, 0xfe, 0xba, 0xa5, 0x5b, 0x6a, 0x6a, 0xff, 0xfb, 0x35, 0x00, 0xc9, 0xb7, 0xd4,
+	0x34, 0xd0, 0x9f, 0x2b, 0x90, 0x1b, 0xab, 0xbb, 0xa2, 0xf7, 0xa6, 0x93, 0x8c, 0x19, 0x55, 0xe3,
+	0x9d, 0xfb, 0x57, 0xc1, 0xc4, 0x8b, 0xad, 0x7d, 0xf8, 0x8b, 0xff, 0xfc, 0xaf, 0x5f, 0xa6, 0xde,
+	0xd3, 0x0a, 0xf2, 0xff, 0x16, 0xf8, 0x98, 0x3d, 0x39, 0x26, 0xde, 0x73, 0xda, 0xec, 0x6c, 0xf7,
+	0x1c, 0xd7, 0x7d, 0xac, 0x14, 0xd1, 0x5f, 0x2a, 0x90, 0x1b, 0xab, 0x77, 0xce, 0x60, 0x33, 0xab,
+	0x5e, 0xbb, 0x73, 0xff, 0x2a, 0x98, 0x64, 0xf3, 0x90, 0xb3, 0x79, 0x7f, 0x5f, 0x9b, 0xcd, 0xe6,
+	0xdb, 0x61, 0x5a, 0xf3, 0x1d, 0xe3, 0xf3, 0x0d, 0xe4, 0xc6, 0x0a, 0xa5, 0x33, 0xe8, 0xcc, 0x2a,
+	0xa4, 0xee, 0x6c, 0xef, 0x8a, 0xff, 0xd5, 0xd8, 0x4d, 0xfe, 0x91, 0x63, 0x57, 0x67, 0xff, 0xc8,
+	0xa1, 0x15, 0xf9, 0xf2, 0x3f, 0x2a, 0x5e, 0x63, 0x79, 0xf4, 0xb7, 0x0a, 0x6c, 0x4c, 0x55, 0x55,
+	0xd1, 0x07, 0xd3, 0xd9, 0xcb, 0x9c, 0xca, 0xeb, 0xce, 0xdc, 0x6c, 0x51, 0xfb, 0x3d, 0x4e, 0xe3,
+	0x73, 0xed, 0xd1, 0xd5, 0x34, 0x92, 0x03, 0xa2, 0x83, 0x55, 0x84, 0x5e, 0x56, 0x47, 0x73, 0x72,
+	0xf4, 0xa3, 0xcb, 0x02, 0x8a, 0x24, 0x0c, 0xd9, 0x79, 0x7b, 0x1e, 0xa1, 0x58, 0xfb, 0x80, 0x33,
+	0xba, 0x87, 0xde, 0xbd, 0xd4, 0x4a, 0x7c, 0x2f, 0xa6, 0xe8, 0x35, 0xc0, 0x30, 0x23, 0x45, 0xd3,
+	0x81, 0xc8, 0x54, 0xba, 0x7a, 0x89, 0x22, 0xe4, 0x79, 0xa0, 0xeb, 0x9c, 0xc7, 0x2f, 0x95, 0x24,
+	0xa8, 0x1d, 0x6c, 0xfb, 0xfe, 0x9c, 0xd0, 0x70, 0x72, 0xe3, 0xef, 0x5f, 0x89, 0x93, 0xe6, 0xb9,
+	0xcb, 0xf9, 0x3c, 0xd0, 0xee, 0x5d, 0xaa, 0x06, 0x11, 0xdf, 0x3e, 0x56, 0x8a, 0x0f, 0x14, 0xf4,
+	0xa7, 0x0a, 0xac, 0xe9, 0x6f, 0xae, 0x60, 0x35, 0x33, 0x2a, 0xdc, 0x79, 0x67, 0x1e, 0x8e, 0x45,
+	0x5a, 0xc9, 0xbd, 0x45, 0x97, 0x53, 0x21, 0x7c, 0xc4, 0xc7, 0x0a, 0xfa, 0x7b, 0x05, 0x36, 0xa6,
+	0x0a, 0xef, 0x33, 0xac, 0x75, 0x5e, 0x71, 0x7e, 0xa7, 0x30, 0xef, 0x9f, 0x23, 0x92, 0x6a, 0xbc,
+	0xf6, 0x05, 0x67, 0xf4, 0x63, 0xf4, 0xe9, 0x6c, 0x46, 0x32, 0xa0, 0x8e, 0xf7, 0xbe, 0x1d, 0x94,
+	0xe8, 0xbf, 0xdb, 0xf3, 0xe4, 0x60, 0xc6, 0x6f, 0x73, 0x56, 0x81, 0x16, 0x7d, 0x74, 0x99, 0xe9,
+	0x4e, 0xd6, 0x71, 0x77, 0xde, 0xbd, 0xaa, 0x7c, 0x1a, 0x6b, 0x8f, 0x38, 0xcd, 0x5d, 0xf4, 0xd1,
+	0x35, 0x2e, 0xd7, 0xb0, 0xd4, 0xfa, 0x0f, 0x0a, 0xa0, 0xe9, 0xea, 0x29, 0x2a, 0x5e, 0x6a, 0xde,
+	0x63, 0x95, 0xcf, 0x9d, 0x2b, 0x4b, 0xbb, 0xda, 0x21, 0xa7, 0xf6, 0x15, 0xfa, 0xe9, 0x4d, 0xa8,
+	0xed, 0x7d, 0x3b, 0x51, 0x30, 0xfd, 0x0e, 0xfd, 0xbb, 0x02, 0xdb, 0xb3, 0xab, 0xb0, 0x68, 0x77,
+	0xd6, 0x6f, 0xa0, 0xf3, 0xcb, 0xb5, 0x97, 0xdc, 0xcd, 0x17, 0x9c, 0x2c, 0xd6, 0x6a, 0xff, 0x3b,
+	0xb2, 0x89, 0xa5, 0xca, 0x8a, 0xfd, 0x63, 0xa5, 0x78, 0xf0, 0x6f, 0xa9, 0xbf, 0x2e, 0xfd, 0x53,
+	0x0a, 0xfd, 0x56, 0x19, 0xfc, 0x82, 0x52, 0x30, 0x49, 0xf4, 0xda, 0x6b, 0x13, 0xed, 0x25, 0xdc,
+	0x4d, 0x44, 0xa5, 0xa6, 0x51, 0x78, 0x58, 0x90, 0xeb, 0x16, 0x7a, 0x51, 0xf8, 0x33, 0xd2, 0xa6,
+	0xe8, 0xdd, 0x33, 0x4a, 0x7b, 0xf1, 0xe3, 0xbd, 0xbd, 0x8e, 0x47, 0xcf, 0xfa, 0x27, 0xbb, 0xed,
+	0xb0, 0xbb, 0xd7, 0xf1, 0xdc, 0x0b, 0xf6, 0x86, 0x09, 0xe8, 0xce, 0x56, 0xc7, 0x73, 0x49, 0x18,
+	0x9c, 0x39, 0x6d, 0x12, 0x7d, 0xd5, 0xe9, 0x3a, 0x9e, 0xcf, 0x50, 0xc5, 0xaf, 0x61, 0xf3, 0xc0,
+	0xac, 0x14, 0x3e, 0x7d, 0x58, 0xf6, 0x9d, 0x7e, 0x4c, 0x0a, 0x55, 0xaf, 0x4d, 0x58, 0x4a, 0xfb,
+	0xf9, 0x95, 0x33, 0xee, 0x9d, 0xf8, 0xe1, 0xc9, 0x5e, 0xd7, 0x89, 0x29, 0x89, 0xf6, 0xaa, 0x46,
+	0x59, 0xaf, 0x9b, 0xfa, 0x2e, 0x7d, 0x43, 0xf7, 0xd3, 0x9f, 0xec, 0x7e, 0x5c, 0x4c, 0x2b, 0xa9,
+	0x85, 0x7d, 0xd5, 0xe9, 0x89, 0x1f, 0x52, 0xd9, 0x56, 0x7f, 0x16, 0x87, 0xc1, 0xe3, 0x29, 0x09,
+	0xfe, 0x02, 0xd2, 0x8f, 0x3e, 0x7e, 0x84, 0x1e, 0x41, 0x11, 0x13, 0xda, 0x8f, 0x02, 0xe2, 0x16,
+	0xce, 0xcf, 0x48, 0x50, 0xa0, 0x67, 0xa4, 0x10, 0x11, 0xf1, 0x2f, 0x69, 0x05, 0x37, 0x24, 0x71,
+	0x21, 0x08, 0x69, 0x81, 0xbc, 0xf1, 0x62, 0xba, 0x8b, 0x96, 0x60, 0xe1, 0x57, 0x29, 0x65, 0xe9,
+	0xf7, 0x93, 0x5f, 0x45, 0x4e, 0x96, 0xf8, 0x93, 0xf6, 0xe9, 0xff, 0x0c, 0x00, 0x33, 0xae, 0x74,
+	0xd0, 0xcb, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1060,13 +3127,27 @@ type CultureAPIClient interface {
 	// Uploads a culture resource to be stored
 	CreateCulture(ctx context.Context, in *CreateCultureRequest, opts ...grpc.CallOption) (*CreateCultureResponse, error)
 	// Update an existing culture resource on the database
-	UpdateCulture(ctx context.Context, in *UpdateCultureRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	UpdateCulture(ctx context.Context, in *UpdateCultureRequest, opts ...grpc.CallOption) (*UpdateCultureResponse, error)
 	// Removes a culture resource on the database completely
 	DeleteCulture(ctx context.Context, in *DeleteCultureRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Moves a culture to another status
+	TransitionCulture(ctx context.Context, in *TransitionCultureRequest, opts ...grpc.CallOption) (*Culture, error)
 	// Retrieves a collection of culture resource
 	ListCultures(ctx context.Context, in *ListCulturesRequest, opts ...grpc.CallOption) (*Cultures, error)
 	// Retrieves a culture resource from the database
 	GetCulture(ctx context.Context, in *GetCultureRequest, opts ...grpc.CallOption) (*Culture, error)
+	// Imports cultures from a CSV or WHONET file streamed in chunks
+	ImportCultures(ctx context.Context, opts ...grpc.CallOption) (CultureAPI_ImportCulturesClient, error)
+	// Exports results of cultures as a CSV, NDJSON or Parquet file streamed in chunks
+	ExportCultures(ctx context.Context, in *ExportCulturesRequest, opts ...grpc.CallOption) (CultureAPI_ExportCulturesClient, error)
+	// Retrieves the patient id behind a pseudonym. Only data stewards may re-identify patients.
+	ReidentifyPatient(ctx context.Context, in *ReidentifyPatientRequest, opts ...grpc.CallOption) (*PatientIdentity, error)
+	// Retrieves revisions of a culture
+	ListCultureRevisions(ctx context.Context, in *ListCultureRevisionsRequest, opts ...grpc.CallOption) (*CultureRevisions, error)
+	// Retrieves a revision of a culture
+	GetCultureRevision(ctx context.Context, in *GetCultureRevisionRequest, opts ...grpc.CallOption) (*CultureRevision, error)
+	// Restores a culture to a previous revision
+	RestoreCultureRevision(ctx context.Context, in *RestoreCultureRevisionRequest, opts ...grpc.CallOption) (*Culture, error)
 }
 
 type cultureAPIClient struct {
@@ -1086,8 +3167,8 @@ func (c *cultureAPIClient) CreateCulture(ctx context.Context, in *CreateCultureR
 	return out, nil
 }
 
-func (c *cultureAPIClient) UpdateCulture(ctx context.Context, in *UpdateCultureRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
+func (c *cultureAPIClient) UpdateCulture(ctx context.Context, in *UpdateCultureRequest, opts ...grpc.CallOption) (*UpdateCultureResponse, error) {
+	out := new(UpdateCultureResponse)
 	err := c.cc.Invoke(ctx, "/antibug.culture.CultureAPI/UpdateCulture", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -1104,6 +3185,15 @@ func (c *cultureAPIClient) DeleteCulture(ctx context.Context, in *DeleteCultureR
 	return out, nil
 }
 
+func (c *cultureAPIClient) TransitionCulture(ctx context.Context, in *TransitionCultureRequest, opts ...grpc.CallOption) (*Culture, error) {
+	out := new(Culture)
+	err := c.cc.Invoke(ctx, "/antibug.culture.CultureAPI/TransitionCulture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cultureAPIClient) ListCultures(ctx context.Context, in *ListCulturesRequest, opts ...grpc.CallOption) (*Cultures, error) {
 	out := new(Cultures)
 	err := c.cc.Invoke(ctx, "/antibug.culture.CultureAPI/ListCultures", in, out, opts...)
@@ -1122,18 +3212,134 @@ func (c *cultureAPIClient) GetCulture(ctx context.Context, in *GetCultureRequest
 	return out, nil
 }
 
+func (c *cultureAPIClient) ImportCultures(ctx context.Context, opts ...grpc.CallOption) (CultureAPI_ImportCulturesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CultureAPI_serviceDesc.Streams[0], "/antibug.culture.CultureAPI/ImportCultures", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cultureAPIImportCulturesClient{stream}
+	return x, nil
+}
+
+type CultureAPI_ImportCulturesClient interface {
+	Send(*ImportCulturesRequest) error
+	CloseAndRecv() (*ImportCulturesResponse, error)
+	grpc.ClientStream
+}
+
+type cultureAPIImportCulturesClient struct {
+	grpc.ClientStream
+}
+
+func (x *cultureAPIImportCulturesClient) Send(m *ImportCulturesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cultureAPIImportCulturesClient) CloseAndRecv() (*ImportCulturesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportCulturesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cultureAPIClient) ExportCultures(ctx context.Context, in *ExportCulturesRequest, opts ...grpc.CallOption) (CultureAPI_ExportCulturesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CultureAPI_serviceDesc.Streams[1], "/antibug.culture.CultureAPI/ExportCultures", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cultureAPIExportCulturesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CultureAPI_ExportCulturesClient interface {
+	Recv() (*ExportChunk, error)
+	grpc.ClientStream
+}
+
+type cultureAPIExportCulturesClient struct {
+	grpc.ClientStream
+}
+
+func (x *cultureAPIExportCulturesClient) Recv() (*ExportChunk, error) {
+	m := new(ExportChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cultureAPIClient) ReidentifyPatient(ctx context.Context, in *ReidentifyPatientRequest, opts ...grpc.CallOption) (*PatientIdentity, error) {
+	out := new(PatientIdentity)
+	err := c.cc.Invoke(ctx, "/antibug.culture.CultureAPI/ReidentifyPatient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cultureAPIClient) ListCultureRevisions(ctx context.Context, in *ListCultureRevisionsRequest, opts ...grpc.CallOption) (*CultureRevisions, error) {
+	out := new(CultureRevisions)
+	err := c.cc.Invoke(ctx, "/antibug.culture.CultureAPI/ListCultureRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cultureAPIClient) GetCultureRevision(ctx context.Context, in *GetCultureRevisionRequest, opts ...grpc.CallOption) (*CultureRevision, error) {
+	out := new(CultureRevision)
+	err := c.cc.Invoke(ctx, "/antibug.culture.CultureAPI/GetCultureRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cultureAPIClient) RestoreCultureRevision(ctx context.Context, in *RestoreCultureRevisionRequest, opts ...grpc.CallOption) (*Culture, error) {
+	out := new(Culture)
+	err := c.cc.Invoke(ctx, "/antibug.culture.CultureAPI/RestoreCultureRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CultureAPIServer is the server API for CultureAPI service.
 type CultureAPIServer interface {
 	// Uploads a culture resource to be stored
 	CreateCulture(context.Context, *CreateCultureRequest) (*CreateCultureResponse, error)
 	// Update an existing culture resource on the database
-	UpdateCulture(context.Context, *UpdateCultureRequest) (*empty.Empty, error)
+	UpdateCulture(context.Context, *UpdateCultureRequest) (*UpdateCultureResponse, error)
 	// Removes a culture resource on the database completely
 	DeleteCulture(context.Context, *DeleteCultureRequest) (*empty.Empty, error)
+	// Moves a culture to another status
+	TransitionCulture(context.Context, *TransitionCultureRequest) (*Culture, error)
 	// Retrieves a collection of culture resource
 	ListCultures(context.Context, *ListCulturesRequest) (*Cultures, error)
 	// Retrieves a culture resource from the database
 	GetCulture(context.Context, *GetCultureRequest) (*Culture, error)
+	// Imports cultures from a CSV or WHONET file streamed in chunks
+	ImportCultures(CultureAPI_ImportCulturesServer) error
+	// Exports results of cultures as a CSV, NDJSON or Parquet file streamed in chunks
+	ExportCultures(*ExportCulturesRequest, CultureAPI_ExportCulturesServer) error
+	// Retrieves the patient id behind a pseudonym. Only data stewards may re-identify patients.
+	ReidentifyPatient(context.Context, *ReidentifyPatientRequest) (*PatientIdentity, error)
+	// Retrieves revisions of a culture
+	ListCultureRevisions(context.Context, *ListCultureRevisionsRequest) (*CultureRevisions, error)
+	// Retrieves a revision of a culture
+	GetCultureRevision(context.Context, *GetCultureRevisionRequest) (*CultureRevision, error)
+	// Restores a culture to a previous revision
+	RestoreCultureRevision(context.Context, *RestoreCultureRevisionRequest) (*Culture, error)
 }
 
 func RegisterCultureAPIServer(s *grpc.Server, srv CultureAPIServer) {
@@ -1194,6 +3400,24 @@ func _CultureAPI_DeleteCulture_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CultureAPI_TransitionCulture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionCultureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CultureAPIServer).TransitionCulture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.culture.CultureAPI/TransitionCulture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CultureAPIServer).TransitionCulture(ctx, req.(*TransitionCultureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CultureAPI_ListCultures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCulturesRequest)
 	if err := dec(in); err != nil {
@@ -1230,6 +3454,125 @@ func _CultureAPI_GetCulture_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CultureAPI_ImportCultures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CultureAPIServer).ImportCultures(&cultureAPIImportCulturesServer{stream})
+}
+
+type CultureAPI_ImportCulturesServer interface {
+	SendAndClose(*ImportCulturesResponse) error
+	Recv() (*ImportCulturesRequest, error)
+	grpc.ServerStream
+}
+
+type cultureAPIImportCulturesServer struct {
+	grpc.ServerStream
+}
+
+func (x *cultureAPIImportCulturesServer) SendAndClose(m *ImportCulturesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cultureAPIImportCulturesServer) Recv() (*ImportCulturesRequest, error) {
+	m := new(ImportCulturesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CultureAPI_ExportCultures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportCulturesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CultureAPIServer).ExportCultures(m, &cultureAPIExportCulturesServer{stream})
+}
+
+type CultureAPI_ExportCulturesServer interface {
+	Send(*ExportChunk) error
+	grpc.ServerStream
+}
+
+type cultureAPIExportCulturesServer struct {
+	grpc.ServerStream
+}
+
+func (x *cultureAPIExportCulturesServer) Send(m *ExportChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CultureAPI_ReidentifyPatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReidentifyPatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CultureAPIServer).ReidentifyPatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.culture.CultureAPI/ReidentifyPatient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CultureAPIServer).ReidentifyPatient(ctx, req.(*ReidentifyPatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CultureAPI_ListCultureRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCultureRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CultureAPIServer).ListCultureRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.culture.CultureAPI/ListCultureRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CultureAPIServer).ListCultureRevisions(ctx, req.(*ListCultureRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CultureAPI_GetCultureRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCultureRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CultureAPIServer).GetCultureRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.culture.CultureAPI/GetCultureRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CultureAPIServer).GetCultureRevision(ctx, req.(*GetCultureRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CultureAPI_RestoreCultureRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCultureRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CultureAPIServer).RestoreCultureRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/antibug.culture.CultureAPI/RestoreCultureRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CultureAPIServer).RestoreCultureRevision(ctx, req.(*RestoreCultureRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CultureAPI_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "antibug.culture.CultureAPI",
 	HandlerType: (*CultureAPIServer)(nil),
@@ -1246,6 +3589,10 @@ var _CultureAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCulture",
 			Handler:    _CultureAPI_DeleteCulture_Handler,
 		},
+		{
+			MethodName: "TransitionCulture",
+			Handler:    _CultureAPI_TransitionCulture_Handler,
+		},
 		{
 			MethodName: "ListCultures",
 			Handler:    _CultureAPI_ListCultures_Handler,
@@ -1254,7 +3601,34 @@ var _CultureAPI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCulture",
 			Handler:    _CultureAPI_GetCulture_Handler,
 		},
+		{
+			MethodName: "ReidentifyPatient",
+			Handler:    _CultureAPI_ReidentifyPatient_Handler,
+		},
+		{
+			MethodName: "ListCultureRevisions",
+			Handler:    _CultureAPI_ListCultureRevisions_Handler,
+		},
+		{
+			MethodName: "GetCultureRevision",
+			Handler:    _CultureAPI_GetCultureRevision_Handler,
+		},
+		{
+			MethodName: "RestoreCultureRevision",
+			Handler:    _CultureAPI_RestoreCultureRevision_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportCultures",
+			Handler:       _CultureAPI_ImportCultures_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportCultures",
+			Handler:       _CultureAPI_ExportCultures_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "culture.proto",
 }
@@ -25,6 +25,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// FacilitySort is the order of listed facilities
+type FacilitySort int32
+
+const (
+	// Order facilities were added
+	FacilitySort_SORT_BY_ID FacilitySort = 0
+	// Alphabetical order of facility names
+	FacilitySort_SORT_BY_NAME FacilitySort = 1
+)
+
+var FacilitySort_name = map[int32]string{
+	0: "SORT_BY_ID",
+	1: "SORT_BY_NAME",
+}
+
+var FacilitySort_value = map[string]int32{
+	"SORT_BY_ID":   0,
+	"SORT_BY_NAME": 1,
+}
+
+func (x FacilitySort) String() string {
+	return proto.EnumName(FacilitySort_name, int32(x))
+}
+
+func (FacilitySort) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e79ec3532db37ad6, []int{0}
+}
+
 // Facility is a place where like hospital or learning institution
 type Facility struct {
 	FacilityId           int64    `protobuf:"varint,1,opt,name=facility_id,json=facilityId,proto3" json:"facility_id,omitempty"`
@@ -363,8 +391,12 @@ func (m *GetFacilityRequest) GetFacilityId() string {
 
 // ListFacilitiesRequest is request to retrive a collection of facilitiese resource
 type ListFacilitiesRequest struct {
-	PageToken            int32    `protobuf:"varint,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token of next_page_token from the previous page. Empty for the first page.
+	PageToken string       `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32        `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Sort      FacilitySort `protobuf:"varint,4,opt,name=sort,proto3,enum=antibug.facility.FacilitySort" json:"sort,omitempty"`
+	// Count facilities in total_count
+	IncludeTotal         bool     `protobuf:"varint,5,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -395,11 +427,11 @@ func (m *ListFacilitiesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListFacilitiesRequest proto.InternalMessageInfo
 
-func (m *ListFacilitiesRequest) GetPageToken() int32 {
+func (m *ListFacilitiesRequest) GetPageToken() string {
 	if m != nil {
 		return m.PageToken
 	}
-	return 0
+	return ""
 }
 
 func (m *ListFacilitiesRequest) GetPageSize() int32 {
@@ -409,10 +441,24 @@ func (m *ListFacilitiesRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (m *ListFacilitiesRequest) GetSort() FacilitySort {
+	if m != nil {
+		return m.Sort
+	}
+	return FacilitySort_SORT_BY_ID
+}
+
+func (m *ListFacilitiesRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
 // SearchFacilitiesRequest is request to search for a facility
 type SearchFacilitiesRequest struct {
 	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	PageToken            int32    `protobuf:"varint,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageToken            string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	PageSize             int32    `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -451,11 +497,11 @@ func (m *SearchFacilitiesRequest) GetQuery() string {
 	return ""
 }
 
-func (m *SearchFacilitiesRequest) GetPageToken() int32 {
+func (m *SearchFacilitiesRequest) GetPageToken() string {
 	if m != nil {
 		return m.PageToken
 	}
-	return 0
+	return ""
 }
 
 func (m *SearchFacilitiesRequest) GetPageSize() int32 {
@@ -467,11 +513,13 @@ func (m *SearchFacilitiesRequest) GetPageSize() int32 {
 
 // Facilities is a colection of facility resource
 type Facilities struct {
-	Facilities           []*Facility `protobuf:"bytes,1,rep,name=facilities,proto3" json:"facilities,omitempty"`
-	NextPageToken        int32       `protobuf:"varint,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Facilities []*Facility `protobuf:"bytes,1,rep,name=facilities,proto3" json:"facilities,omitempty"`
+	// Empty on the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount           int64    `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Facilities) Reset()         { *m = Facilities{} }
@@ -506,10 +554,17 @@ func (m *Facilities) GetFacilities() []*Facility {
 	return nil
 }
 
-func (m *Facilities) GetNextPageToken() int32 {
+func (m *Facilities) GetNextPageToken() string {
 	if m != nil {
 		return m.NextPageToken
 	}
+	return ""
+}
+
+func (m *Facilities) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
 	return 0
 }
 
@@ -594,6 +649,7 @@ func (m *SubCounties) GetSubCounties() []*SubCounty {
 }
 
 func init() {
+	proto.RegisterEnum("antibug.facility.FacilitySort", FacilitySort_name, FacilitySort_value)
 	proto.RegisterType((*Facility)(nil), "antibug.facility.Facility")
 	proto.RegisterType((*SubCounty)(nil), "antibug.facility.SubCounty")
 	proto.RegisterType((*County)(nil), "antibug.facility.County")
@@ -611,67 +667,73 @@ func init() {
 func init() { proto.RegisterFile("facility.proto", fileDescriptor_e79ec3532db37ad6) }
 
 var fileDescriptor_e79ec3532db37ad6 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x72, 0xdb, 0x44,
-	0x14, 0x1e, 0xd9, 0x89, 0xb1, 0x8f, 0x9b, 0x34, 0xb3, 0x34, 0xc5, 0xe3, 0x34, 0x83, 0x50, 0x9b,
-	0x26, 0x35, 0xb5, 0x54, 0x5c, 0xd3, 0x81, 0x30, 0xd3, 0x69, 0x1a, 0x02, 0x93, 0x99, 0xd0, 0x29,
-	0x32, 0x57, 0xdc, 0x18, 0x59, 0x3a, 0x95, 0xb7, 0xb5, 0xb5, 0xaa, 0x76, 0xd5, 0x26, 0xe5, 0x67,
-	0x98, 0x0e, 0x57, 0x5c, 0xc2, 0x1d, 0xc3, 0x6b, 0xf0, 0x04, 0xdc, 0x72, 0xc1, 0x0c, 0xaf, 0xc0,
-	0x83, 0x30, 0x5a, 0x69, 0xe5, 0x1f, 0xd9, 0x35, 0x57, 0x96, 0xce, 0x9e, 0xef, 0xfb, 0xce, 0xee,
-	0xf9, 0xce, 0xca, 0xb0, 0xf9, 0xc4, 0x71, 0xe9, 0x88, 0x8a, 0x0b, 0x33, 0x8c, 0x98, 0x60, 0x64,
-	0xcb, 0x09, 0x04, 0x1d, 0xc4, 0xbe, 0xa9, 0xe2, 0xcd, 0x1d, 0x9f, 0x31, 0x7f, 0x84, 0x96, 0x5c,
-	0x1f, 0xc4, 0x4f, 0x2c, 0x1c, 0x87, 0x2a, 0xbd, 0x79, 0x2d, 0x5b, 0x74, 0x42, 0x6a, 0x39, 0x41,
-	0xc0, 0x84, 0x23, 0x28, 0x0b, 0x78, 0xb6, 0x7a, 0x5b, 0xfe, 0xb8, 0x6d, 0x1f, 0x83, 0x36, 0x7f,
-	0xe9, 0xf8, 0x3e, 0x46, 0x16, 0x0b, 0x65, 0x46, 0x31, 0xdb, 0xf8, 0x5b, 0x83, 0xea, 0x67, 0x99,
-	0x2a, 0x79, 0x17, 0xea, 0xaa, 0x82, 0x3e, 0xf5, 0x1a, 0x9a, 0xae, 0x1d, 0x94, 0x6d, 0x50, 0xa1,
-	0x53, 0x8f, 0x5c, 0x87, 0x8d, 0x3c, 0x21, 0x70, 0xc6, 0xd8, 0x28, 0xe9, 0xda, 0x41, 0xcd, 0xbe,
-	0xa4, 0x82, 0x8f, 0x9c, 0x31, 0x92, 0xab, 0x50, 0x71, 0x59, 0x1c, 0x88, 0x8b, 0x46, 0x59, 0xae,
-	0x66, 0x6f, 0x09, 0x7b, 0xfa, 0xd4, 0x77, 0x99, 0x87, 0x8d, 0x35, 0x5d, 0x3b, 0x58, 0xb7, 0x21,
-	0x0d, 0x1d, 0x33, 0x0f, 0xc9, 0x2e, 0x00, 0x8f, 0x07, 0xfd, 0x0c, 0xbc, 0x2e, 0xc1, 0x35, 0x1e,
-	0x0f, 0x8e, 0x53, 0xfc, 0x4d, 0xb8, 0x3c, 0x59, 0x4e, 0x39, 0x2a, 0x92, 0x63, 0x23, 0xcf, 0x49,
-	0x68, 0x8c, 0xfb, 0x50, 0xeb, 0xe5, 0xa0, 0x59, 0x4e, 0x6d, 0x9e, 0x93, 0xc0, 0x9a, 0x24, 0x2a,
-	0x49, 0x22, 0xf9, 0x6c, 0x74, 0xa1, 0x92, 0xad, 0x4e, 0x76, 0xa2, 0xcd, 0xec, 0x44, 0xa1, 0xca,
-	0x53, 0xa8, 0x33, 0x20, 0x47, 0x9e, 0xa7, 0x8e, 0xd2, 0xc6, 0xe7, 0x31, 0x72, 0x41, 0xee, 0x41,
-	0x55, 0x9d, 0x8d, 0xd4, 0xa8, 0x77, 0x9a, 0xe6, 0x7c, 0xb3, 0xcd, 0x1c, 0x94, 0xe7, 0x1a, 0xf7,
-	0xe0, 0xed, 0x19, 0x36, 0x1e, 0xb2, 0x80, 0xe3, 0xa2, 0x06, 0xd5, 0xa6, 0x1b, 0x64, 0x7c, 0x04,
-	0xdb, 0x36, 0x8e, 0xd9, 0x0b, 0x9c, 0x2f, 0x64, 0x0e, 0x59, 0x2a, 0x20, 0x3f, 0x04, 0xf2, 0x39,
-	0x8a, 0x15, 0xb0, 0xa2, 0x60, 0x0f, 0xb6, 0xcf, 0x28, 0x57, 0x38, 0x8a, 0x5c, 0x21, 0x77, 0x01,
-	0x42, 0xc7, 0xc7, 0xbe, 0x60, 0xcf, 0x30, 0x90, 0xc0, 0x75, 0xbb, 0x96, 0x44, 0xbe, 0x4a, 0x02,
-	0x64, 0x07, 0xe4, 0x4b, 0x9f, 0xd3, 0x57, 0xea, 0xf4, 0xab, 0x49, 0xa0, 0x47, 0x5f, 0xa1, 0xf1,
-	0x0c, 0xde, 0xe9, 0xa1, 0x13, 0xb9, 0xc3, 0x22, 0xed, 0x15, 0x58, 0x7f, 0x1e, 0x63, 0xa4, 0x3a,
-	0x92, 0xbe, 0xcc, 0x89, 0x95, 0xde, 0x28, 0x56, 0x9e, 0x13, 0x0b, 0x01, 0x26, 0x32, 0xe4, 0x10,
-	0xd4, 0xee, 0x28, 0xf2, 0x86, 0xa6, 0x97, 0x57, 0xb4, 0x6c, 0x2a, 0x3b, 0x31, 0x68, 0x80, 0xe7,
-	0xa2, 0x5f, 0x28, 0x65, 0x23, 0x09, 0x3f, 0x56, 0xe5, 0x18, 0x0f, 0xa0, 0x2a, 0x0d, 0x96, 0x60,
-	0xba, 0x50, 0x75, 0xb3, 0xe7, 0x4c, 0xad, 0x51, 0x54, 0x4b, 0xed, 0x68, 0xe7, 0x99, 0xc6, 0x17,
-	0x50, 0x57, 0x16, 0x4f, 0x48, 0xee, 0xc3, 0xa5, 0xdc, 0xe4, 0x13, 0xa2, 0x9d, 0x22, 0x51, 0x3e,
-	0x17, 0x76, 0x9d, 0x4f, 0xf0, 0x9d, 0xdf, 0xdf, 0x82, 0xba, 0xda, 0xd1, 0xd1, 0xe3, 0x53, 0xf2,
-	0x5a, 0x83, 0xfa, 0x94, 0xfd, 0xc8, 0x8d, 0x22, 0x53, 0xd1, 0xeb, 0xcd, 0xbd, 0x15, 0x59, 0xa9,
-	0x87, 0x8d, 0x9b, 0xaf, 0xff, 0xf9, 0xf7, 0xd7, 0x92, 0x6e, 0xec, 0x64, 0xf7, 0x97, 0x84, 0x58,
-	0x93, 0x63, 0xb4, 0x1c, 0xcf, 0x3b, 0xd4, 0x5a, 0xe4, 0x47, 0x0d, 0x36, 0x67, 0xbd, 0x4c, 0xf6,
-	0x8b, 0x0a, 0x0b, 0xdd, 0xde, 0xbc, 0x6a, 0xa6, 0x57, 0xa4, 0xa9, 0xee, 0x4f, 0xf3, 0x24, 0xb9,
-	0x3f, 0x8d, 0xb6, 0xd4, 0xde, 0x6f, 0xed, 0x2d, 0xd3, 0xfe, 0x76, 0xca, 0xed, 0xdf, 0x93, 0x1f,
-	0xa0, 0x3e, 0x35, 0x13, 0x8b, 0x8e, 0xa1, 0x38, 0x32, 0xcd, 0x37, 0xb8, 0x45, 0xe9, 0x93, 0xff,
-	0xa9, 0xff, 0x93, 0x06, 0x9b, 0xb3, 0xd3, 0xb5, 0xe8, 0x08, 0x16, 0xce, 0x5f, 0xf3, 0xda, 0xd2,
-	0x32, 0x12, 0x03, 0xbd, 0x2f, 0x0b, 0xd9, 0x23, 0xd7, 0x97, 0x36, 0xc1, 0x4d, 0xbe, 0x12, 0xd6,
-	0x88, 0x72, 0x41, 0x7e, 0xd6, 0x60, 0x6b, 0x7e, 0x1e, 0xc9, 0xad, 0x05, 0xee, 0x5a, 0x3c, 0xb3,
-	0x2b, 0x4a, 0x59, 0x79, 0x26, 0x59, 0x29, 0x5c, 0xb2, 0x13, 0x0e, 0x97, 0x92, 0x0d, 0xe7, 0xde,
-	0x5f, 0xd2, 0xea, 0x45, 0x6d, 0x50, 0x18, 0xc3, 0x92, 0x92, 0xb7, 0xc8, 0xfe, 0x0a, 0x49, 0x35,
-	0x50, 0xe4, 0x3b, 0xb8, 0x9c, 0x88, 0x4e, 0xcf, 0xdc, 0x32, 0xdd, 0xdd, 0xe5, 0x53, 0x97, 0x48,
-	0x77, 0xa4, 0xf4, 0x6d, 0xd2, 0x5a, 0xb5, 0xdb, 0x78, 0xa0, 0xd4, 0x1f, 0xfe, 0x59, 0xfa, 0xe5,
-	0xe8, 0x8f, 0x12, 0xf9, 0x4b, 0x83, 0x2d, 0xe5, 0x24, 0xbd, 0x87, 0xd1, 0x0b, 0xea, 0xa2, 0xf1,
-	0x0d, 0xdc, 0x98, 0x9c, 0xa5, 0xce, 0xd3, 0xa8, 0xde, 0xd6, 0x33, 0x66, 0x3d, 0x8c, 0xd8, 0x53,
-	0x74, 0x05, 0x79, 0x6f, 0x28, 0x44, 0xc8, 0x0f, 0x2d, 0xcb, 0xa7, 0x62, 0x18, 0x0f, 0x4c, 0x97,
-	0x8d, 0x2d, 0x9f, 0x7a, 0x17, 0x2c, 0x50, 0x45, 0x34, 0xb7, 0x7d, 0xea, 0x21, 0x0b, 0x86, 0x8e,
-	0x8b, 0xd1, 0x03, 0x7f, 0xec, 0xd0, 0x51, 0x92, 0xd5, 0xfa, 0x12, 0xae, 0x3c, 0xec, 0x7d, 0xaa,
-	0xdf, 0x6d, 0x1f, 0x8f, 0x9c, 0x98, 0xa3, 0x7e, 0x46, 0x5d, 0x4c, 0xbe, 0x45, 0x1f, 0xaf, 0x64,
-	0xb4, 0x06, 0x23, 0x36, 0xb0, 0xc6, 0x0e, 0x17, 0x18, 0x59, 0x67, 0xa7, 0xc7, 0x27, 0x8f, 0x7a,
-	0x27, 0xa6, 0x38, 0x17, 0x9d, 0xf2, 0x07, 0xe6, 0x9d, 0x56, 0x59, 0x2b, 0xad, 0x75, 0xb6, 0x9c,
-	0x30, 0x1c, 0x51, 0x57, 0xfe, 0x25, 0xb1, 0x9e, 0x72, 0x16, 0x1c, 0x16, 0x22, 0xf6, 0x27, 0x50,
-	0xee, 0xde, 0xe9, 0x92, 0x2e, 0xb4, 0x6c, 0x14, 0x71, 0x14, 0xa0, 0xa7, 0xbf, 0x1c, 0x62, 0xa0,
-	0x8b, 0x21, 0xea, 0x11, 0x72, 0x16, 0x47, 0x2e, 0xea, 0x1e, 0x43, 0xae, 0x07, 0x4c, 0xe8, 0x78,
-	0x4e, 0xb9, 0x30, 0x49, 0x05, 0xd6, 0x7e, 0x2b, 0x69, 0x95, 0xaf, 0xf3, 0x4f, 0xea, 0xa0, 0x22,
-	0x1b, 0x75, 0xf7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xd0, 0x4e, 0x31, 0x7d, 0x09, 0x00,
-	0x00,
+	// 1045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x66, 0x6d, 0xc7, 0x38, 0xc7, 0x4e, 0x6a, 0x0d, 0x4d, 0xb1, 0x9c, 0x86, 0x2e, 0xdb, 0xa6,
+	0x49, 0x4d, 0xb3, 0x1b, 0xdc, 0x50, 0x41, 0x90, 0xaa, 0x26, 0x69, 0x40, 0xa9, 0xd2, 0x50, 0xd6,
+	0xb9, 0x81, 0x1b, 0xb3, 0xde, 0x9d, 0xae, 0xa7, 0xd8, 0x3b, 0xce, 0xce, 0x6c, 0x9b, 0x94, 0x1f,
+	0xa1, 0x8a, 0x2b, 0x2e, 0xe1, 0x02, 0x09, 0xf1, 0x14, 0x48, 0x3c, 0x01, 0xb7, 0x5c, 0x20, 0xf1,
+	0x0a, 0x3c, 0x08, 0x9a, 0xd9, 0x9d, 0xf5, 0xcf, 0xda, 0x75, 0xaf, 0x3c, 0x73, 0xe6, 0x9c, 0xef,
+	0x3b, 0x73, 0xce, 0xf9, 0xc6, 0x0b, 0xcb, 0x4f, 0x1c, 0x97, 0xf4, 0x08, 0xbf, 0x30, 0x07, 0x21,
+	0xe5, 0x14, 0x55, 0x9d, 0x80, 0x93, 0x4e, 0xe4, 0x9b, 0xca, 0x5e, 0x5f, 0xf5, 0x29, 0xf5, 0x7b,
+	0xd8, 0x92, 0xe7, 0x9d, 0xe8, 0x89, 0x85, 0xfb, 0x03, 0xe5, 0x5e, 0xbf, 0x9a, 0x1c, 0x3a, 0x03,
+	0x62, 0x39, 0x41, 0x40, 0xb9, 0xc3, 0x09, 0x0d, 0x58, 0x72, 0x7a, 0x5b, 0xfe, 0xb8, 0x5b, 0x3e,
+	0x0e, 0xb6, 0xd8, 0x73, 0xc7, 0xf7, 0x71, 0x68, 0xd1, 0x81, 0xf4, 0xc8, 0x7a, 0x1b, 0xff, 0x68,
+	0x50, 0xfa, 0x24, 0x61, 0x45, 0xd7, 0xa0, 0xac, 0x32, 0x68, 0x13, 0xaf, 0xa6, 0xe9, 0xda, 0x66,
+	0xde, 0x06, 0x65, 0x3a, 0xf2, 0xd0, 0x75, 0x58, 0x4a, 0x1d, 0x02, 0xa7, 0x8f, 0x6b, 0x39, 0x5d,
+	0xdb, 0x5c, 0xb4, 0x2b, 0xca, 0x78, 0xe2, 0xf4, 0x31, 0xba, 0x02, 0x45, 0x97, 0x46, 0x01, 0xbf,
+	0xa8, 0xe5, 0xe5, 0x69, 0xb2, 0x13, 0xe8, 0xf1, 0xaa, 0xed, 0x52, 0x0f, 0xd7, 0x0a, 0xba, 0xb6,
+	0xb9, 0x60, 0x43, 0x6c, 0x3a, 0xa0, 0x1e, 0x46, 0x6b, 0x00, 0x2c, 0xea, 0xb4, 0x93, 0xe0, 0x05,
+	0x19, 0xbc, 0xc8, 0xa2, 0xce, 0x41, 0x1c, 0x7f, 0x13, 0x2e, 0x0d, 0x8f, 0x63, 0x8c, 0xa2, 0xc4,
+	0x58, 0x4a, 0x7d, 0x04, 0x8c, 0x71, 0x0f, 0x16, 0x5b, 0x69, 0xd0, 0x38, 0xa6, 0x36, 0x89, 0x89,
+	0xa0, 0x20, 0x81, 0x72, 0x12, 0x48, 0xae, 0x8d, 0x1d, 0x28, 0x26, 0xa7, 0xc3, 0x9b, 0x68, 0x63,
+	0x37, 0x51, 0x51, 0xf9, 0x91, 0xa8, 0x63, 0x40, 0x7b, 0x9e, 0xa7, 0x4a, 0x69, 0xe3, 0xb3, 0x08,
+	0x33, 0x8e, 0xee, 0x42, 0x49, 0xd5, 0x46, 0x72, 0x94, 0x9b, 0x75, 0x73, 0xb2, 0xd9, 0x66, 0x1a,
+	0x94, 0xfa, 0x1a, 0x77, 0xe1, 0xad, 0x31, 0x34, 0x36, 0xa0, 0x01, 0xc3, 0xd3, 0x1a, 0xb4, 0x38,
+	0xda, 0x20, 0xe3, 0x43, 0x58, 0xb1, 0x71, 0x9f, 0x3e, 0xc3, 0x93, 0x89, 0x4c, 0x44, 0xe6, 0x32,
+	0x91, 0x1f, 0x00, 0xfa, 0x14, 0xf3, 0x39, 0x61, 0x59, 0xc2, 0x3f, 0x34, 0x58, 0x39, 0x26, 0x4c,
+	0x05, 0x12, 0xcc, 0x54, 0xe8, 0x1a, 0xc0, 0xc0, 0xf1, 0x71, 0x9b, 0xd3, 0xaf, 0x71, 0x90, 0x8c,
+	0xc2, 0xa2, 0xb0, 0x9c, 0x0a, 0x03, 0x5a, 0x05, 0xb9, 0x69, 0x33, 0xf2, 0x42, 0x95, 0xbf, 0x24,
+	0x0c, 0x2d, 0xf2, 0x02, 0xa3, 0x26, 0x14, 0x18, 0x0d, 0xb9, 0x9c, 0x91, 0xe5, 0xe6, 0x3b, 0xb3,
+	0x4b, 0xd6, 0xa2, 0x21, 0xb7, 0xa5, 0xaf, 0x98, 0x4d, 0x12, 0xb8, 0xbd, 0xc8, 0x13, 0x94, 0xdc,
+	0xe9, 0xc9, 0x01, 0x2a, 0xd9, 0x95, 0xc4, 0x78, 0x2a, 0x6c, 0x0f, 0x0b, 0x25, 0xad, 0x9a, 0x33,
+	0xce, 0xe0, 0xed, 0x16, 0x76, 0x42, 0xb7, 0x9b, 0xcd, 0xfa, 0x32, 0x2c, 0x9c, 0x45, 0x38, 0x54,
+	0x1d, 0x8f, 0x37, 0x13, 0x77, 0x29, 0xbc, 0xf2, 0x2e, 0xf9, 0xf1, 0xbb, 0x3c, 0x2c, 0x94, 0x72,
+	0xd5, 0xbc, 0xf1, 0xab, 0x06, 0x30, 0x64, 0x43, 0xbb, 0xa0, 0x8a, 0x48, 0x30, 0xab, 0x69, 0x7a,
+	0x7e, 0xce, 0x64, 0x8c, 0x78, 0x0b, 0x1d, 0x04, 0xf8, 0x9c, 0xb7, 0x33, 0xd5, 0x5d, 0x12, 0xe6,
+	0xc7, 0x69, 0x56, 0xd7, 0xa0, 0x2c, 0x0b, 0x11, 0x0f, 0xbf, 0xcc, 0x3a, 0x6f, 0x83, 0x34, 0xc9,
+	0xf9, 0x4e, 0x32, 0xbb, 0x0f, 0x25, 0xb9, 0x15, 0xd0, 0x3b, 0x50, 0x72, 0x93, 0x75, 0x92, 0x54,
+	0x2d, 0x9b, 0x54, 0x2c, 0x0e, 0x3b, 0xf5, 0x34, 0x1e, 0x41, 0x59, 0x09, 0x4e, 0x80, 0xdc, 0x83,
+	0x4a, 0x2a, 0xb9, 0x21, 0xd0, 0x6a, 0x16, 0x28, 0x55, 0xa9, 0x5d, 0x66, 0xc3, 0xf8, 0xc6, 0x36,
+	0x54, 0x46, 0xdb, 0x8b, 0x96, 0x01, 0x5a, 0x9f, 0xd9, 0xa7, 0xed, 0xfd, 0x2f, 0xda, 0x47, 0x0f,
+	0xaa, 0x6f, 0xa0, 0x2a, 0x54, 0xd4, 0xfe, 0x64, 0xef, 0xd1, 0x61, 0x55, 0x6b, 0xfe, 0xfe, 0x26,
+	0x94, 0x55, 0xc8, 0xde, 0xe3, 0x23, 0xf4, 0x52, 0x83, 0xf2, 0x88, 0x7c, 0xd0, 0x8d, 0x2c, 0x77,
+	0x56, 0xab, 0xf5, 0xf5, 0x39, 0x5e, 0xb1, 0x06, 0x8d, 0x9b, 0x2f, 0xff, 0xfd, 0xef, 0x97, 0x9c,
+	0x6e, 0xac, 0x26, 0xef, 0xaf, 0x0c, 0xb1, 0x86, 0xfd, 0xb1, 0x1c, 0xcf, 0xdb, 0xd5, 0x1a, 0xe8,
+	0x07, 0x0d, 0x96, 0xc7, 0xb5, 0x88, 0x36, 0xb2, 0x0c, 0x53, 0xd5, 0x5a, 0xbf, 0x62, 0xc6, 0x4f,
+	0xbc, 0xa9, 0xde, 0x7f, 0xf3, 0x50, 0xbc, 0xff, 0xc6, 0x96, 0xe4, 0xde, 0x68, 0xac, 0xcf, 0xe2,
+	0xfe, 0x66, 0x44, 0xad, 0xdf, 0xa1, 0xef, 0xa1, 0x3c, 0xa2, 0xe9, 0x69, 0x65, 0xc8, 0x4a, 0xbe,
+	0xfe, 0x8a, 0x31, 0x54, 0xfc, 0xe8, 0x35, 0xf9, 0x7f, 0xd4, 0x60, 0x79, 0xfc, 0x71, 0x98, 0x56,
+	0x82, 0xa9, 0xcf, 0x47, 0xfd, 0xea, 0xcc, 0x34, 0xc4, 0xc8, 0xbd, 0x27, 0x13, 0x59, 0x47, 0xd7,
+	0x67, 0x36, 0xc1, 0x15, 0xff, 0x72, 0x56, 0x8f, 0x30, 0x8e, 0x7e, 0xd2, 0xa0, 0x3a, 0xa9, 0x77,
+	0x74, 0x6b, 0xca, 0x3c, 0x4e, 0x7f, 0x13, 0xe6, 0xa4, 0x32, 0xb7, 0x26, 0x49, 0x2a, 0x4c, 0xa2,
+	0x23, 0x06, 0x15, 0x71, 0xe1, 0x54, 0x2d, 0x33, 0x5a, 0x3d, 0xad, 0x0d, 0x2a, 0xc6, 0xb0, 0x24,
+	0xe5, 0x2d, 0xb4, 0x31, 0x87, 0x52, 0x49, 0x10, 0x7d, 0x0b, 0x97, 0x04, 0xe9, 0xa8, 0x4a, 0x67,
+	0xf1, 0xae, 0xcd, 0xd6, 0xa9, 0xa0, 0x6e, 0x4a, 0xea, 0xdb, 0xa8, 0x31, 0xef, 0xb6, 0x51, 0x47,
+	0xb1, 0xef, 0xff, 0x95, 0xfb, 0x79, 0xef, 0xcf, 0x1c, 0xfa, 0x5b, 0x83, 0xaa, 0x9a, 0x24, 0xbd,
+	0x85, 0xc3, 0x67, 0xc4, 0xc5, 0xc6, 0x57, 0x70, 0x63, 0x58, 0x4b, 0x9d, 0xc5, 0x56, 0x7d, 0x4b,
+	0x4f, 0x90, 0xf5, 0x41, 0x48, 0x9f, 0x62, 0x97, 0xa3, 0x77, 0xbb, 0x9c, 0x0f, 0xd8, 0xae, 0x65,
+	0xf9, 0x84, 0x77, 0xa3, 0x8e, 0xe9, 0xd2, 0xbe, 0xe5, 0x13, 0xef, 0x82, 0x06, 0x2a, 0x89, 0xfa,
+	0x8a, 0x4f, 0x3c, 0x4c, 0x83, 0xae, 0xe3, 0xe2, 0xf0, 0xbe, 0xdf, 0x77, 0x48, 0x4f, 0x78, 0x35,
+	0x3e, 0x87, 0xcb, 0xfb, 0xad, 0x07, 0xfa, 0x9d, 0xad, 0x83, 0x9e, 0x13, 0x31, 0xac, 0x1f, 0x13,
+	0x17, 0x8b, 0xff, 0xd2, 0x8f, 0xe6, 0x22, 0x5a, 0x9d, 0x1e, 0xed, 0x58, 0x7d, 0x87, 0x71, 0x1c,
+	0x5a, 0xc7, 0x47, 0x07, 0x87, 0x27, 0xad, 0x43, 0x93, 0x9f, 0xf3, 0x66, 0xfe, 0x7d, 0x73, 0xbb,
+	0x91, 0xd7, 0x72, 0x85, 0x66, 0xd5, 0x19, 0x0c, 0x7a, 0xc4, 0x95, 0x9f, 0x54, 0xd6, 0x53, 0x46,
+	0x83, 0xdd, 0x8c, 0xc5, 0xfe, 0x18, 0xf2, 0x3b, 0xdb, 0x3b, 0x68, 0x07, 0x1a, 0x36, 0xe6, 0x51,
+	0x18, 0x60, 0x4f, 0x7f, 0xde, 0xc5, 0x81, 0xce, 0xbb, 0x58, 0x0f, 0x31, 0xa3, 0x51, 0xe8, 0x62,
+	0xdd, 0xa3, 0x98, 0xe9, 0x01, 0xe5, 0x3a, 0x3e, 0x27, 0x8c, 0x9b, 0xa8, 0x08, 0x85, 0xdf, 0x72,
+	0x5a, 0xf1, 0xcb, 0xf4, 0x93, 0xa0, 0x53, 0x94, 0x8d, 0xba, 0xf3, 0xff, 0x00, 0xc3, 0xb4, 0x56,
+	0xcf, 0x3d, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -53,6 +53,34 @@ func (PathogenView) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_97ef4f1c47953891, []int{0}
 }
 
+// PathogenSort is the order of listed pathogens
+type PathogenSort int32
+
+const (
+	// Order pathogens were added
+	PathogenSort_SORT_BY_ID PathogenSort = 0
+	// Alphabetical order of pathogen names
+	PathogenSort_SORT_BY_NAME PathogenSort = 1
+)
+
+var PathogenSort_name = map[int32]string{
+	0: "SORT_BY_ID",
+	1: "SORT_BY_NAME",
+}
+
+var PathogenSort_value = map[string]int32{
+	"SORT_BY_ID":   0,
+	"SORT_BY_NAME": 1,
+}
+
+func (x PathogenSort) String() string {
+	return proto.EnumName(PathogenSort_name, int32(x))
+}
+
+func (PathogenSort) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_97ef4f1c47953891, []int{1}
+}
+
 // RepeatedString is repeated filed values
 type RepeatedString struct {
 	Values               []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
@@ -466,12 +494,16 @@ func (m *DeletePathogenRequest) GetPathogenId() string {
 
 // ListPathogensRequest is request to retrieve a collection of pathogens
 type ListPathogensRequest struct {
-	View                 PathogenView `protobuf:"varint,1,opt,name=view,proto3,enum=antibug.pathogen.PathogenView" json:"view,omitempty"`
-	PageToken            int32        `protobuf:"varint,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize             int32        `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	View PathogenView `protobuf:"varint,1,opt,name=view,proto3,enum=antibug.pathogen.PathogenView" json:"view,omitempty"`
+	// Opaque token of next_page_token from the previous page. Empty for the first page.
+	PageToken string       `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32        `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Sort      PathogenSort `protobuf:"varint,5,opt,name=sort,proto3,enum=antibug.pathogen.PathogenSort" json:"sort,omitempty"`
+	// Count pathogens in total_count
+	IncludeTotal         bool     `protobuf:"varint,6,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListPathogensRequest) Reset()         { *m = ListPathogensRequest{} }
@@ -506,11 +538,11 @@ func (m *ListPathogensRequest) GetView() PathogenView {
 	return PathogenView_FULL
 }
 
-func (m *ListPathogensRequest) GetPageToken() int32 {
+func (m *ListPathogensRequest) GetPageToken() string {
 	if m != nil {
 		return m.PageToken
 	}
-	return 0
+	return ""
 }
 
 func (m *ListPathogensRequest) GetPageSize() int32 {
@@ -520,13 +552,29 @@ func (m *ListPathogensRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (m *ListPathogensRequest) GetSort() PathogenSort {
+	if m != nil {
+		return m.Sort
+	}
+	return PathogenSort_SORT_BY_ID
+}
+
+func (m *ListPathogensRequest) GetIncludeTotal() bool {
+	if m != nil {
+		return m.IncludeTotal
+	}
+	return false
+}
+
 // Pathogens is response containing a collection of pathogens from ListPathogensRequest call
 type Pathogens struct {
-	Pathogens            []*Pathogen `protobuf:"bytes,1,rep,name=pathogens,proto3" json:"pathogens,omitempty"`
-	NextPageToken        int32       `protobuf:"varint,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Pathogens []*Pathogen `protobuf:"bytes,1,rep,name=pathogens,proto3" json:"pathogens,omitempty"`
+	// Empty on the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount           int64    `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Pathogens) Reset()         { *m = Pathogens{} }
@@ -561,17 +609,24 @@ func (m *Pathogens) GetPathogens() []*Pathogen {
 	return nil
 }
 
-func (m *Pathogens) GetNextPageToken() int32 {
+func (m *Pathogens) GetNextPageToken() string {
 	if m != nil {
 		return m.NextPageToken
 	}
+	return ""
+}
+
+func (m *Pathogens) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
 	return 0
 }
 
 // SearchPathogensRequest is request to search for a pathogen
 type SearchPathogensRequest struct {
 	Query                string       `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	PageToken            int32        `protobuf:"varint,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageToken            string       `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	PageSize             int32        `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	View                 PathogenView `protobuf:"varint,4,opt,name=view,proto3,enum=antibug.pathogen.PathogenView" json:"view,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -611,11 +666,11 @@ func (m *SearchPathogensRequest) GetQuery() string {
 	return ""
 }
 
-func (m *SearchPathogensRequest) GetPageToken() int32 {
+func (m *SearchPathogensRequest) GetPageToken() string {
 	if m != nil {
 		return m.PageToken
 	}
-	return 0
+	return ""
 }
 
 func (m *SearchPathogensRequest) GetPageSize() int32 {
@@ -682,6 +737,7 @@ func (m *GetPathogenRequest) GetView() PathogenView {
 
 func init() {
 	proto.RegisterEnum("antibug.pathogen.PathogenView", PathogenView_name, PathogenView_value)
+	proto.RegisterEnum("antibug.pathogen.PathogenSort", PathogenSort_name, PathogenSort_value)
 	proto.RegisterType((*RepeatedString)(nil), "antibug.pathogen.RepeatedString")
 	proto.RegisterType((*Pathogen)(nil), "antibug.pathogen.Pathogen")
 	proto.RegisterType((*Susceptibility)(nil), "antibug.pathogen.Susceptibility")
@@ -699,78 +755,84 @@ func init() {
 func init() { proto.RegisterFile("pathogen.proto", fileDescriptor_97ef4f1c47953891) }
 
 var fileDescriptor_97ef4f1c47953891 = []byte{
-	// 1127 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xc6, 0x49, 0x76, 0x9b, 0xbc, 0x74, 0xd3, 0x68, 0xd8, 0x5d, 0xac, 0x2c, 0xa5, 0xc6, 0xac,
-	0x4a, 0x88, 0xba, 0x71, 0x49, 0x2b, 0xd4, 0x2e, 0x1c, 0x68, 0xb7, 0x05, 0x22, 0x45, 0x6d, 0x71,
-	0x5a, 0x0e, 0x95, 0x20, 0x38, 0xf6, 0xab, 0x33, 0xc5, 0xf6, 0xb8, 0x9e, 0x49, 0xb7, 0x29, 0xaa,
-	0x80, 0x1e, 0xfa, 0x03, 0xca, 0x0d, 0x24, 0x0e, 0xdc, 0x39, 0xf1, 0x0b, 0xb8, 0x72, 0xe5, 0x2f,
-	0xf0, 0x43, 0x90, 0x27, 0x76, 0x36, 0x89, 0x93, 0xdd, 0xac, 0x38, 0x25, 0xf3, 0xe6, 0xbd, 0xef,
-	0x7d, 0xef, 0xcd, 0xfb, 0xc6, 0x03, 0x95, 0xd0, 0x12, 0x03, 0xe6, 0x62, 0xd0, 0x0c, 0x23, 0x26,
-	0x18, 0xa9, 0x5a, 0x81, 0xa0, 0xfd, 0xa1, 0xdb, 0x4c, 0xed, 0xb5, 0xb7, 0x5d, 0xc6, 0x5c, 0x0f,
-	0x0d, 0x2b, 0xa4, 0x86, 0x15, 0x04, 0x4c, 0x58, 0x82, 0xb2, 0x80, 0x8f, 0xfd, 0x6b, 0x3b, 0xc9,
-	0xae, 0x5c, 0xf5, 0x87, 0x8f, 0x0c, 0xf4, 0x43, 0x31, 0x4a, 0x36, 0x2f, 0xc9, 0x1f, 0x7b, 0xcf,
-	0xc5, 0x60, 0x8f, 0x1f, 0x5a, 0xae, 0x8b, 0x91, 0xc1, 0x42, 0x19, 0x9e, 0x85, 0xd2, 0xeb, 0x50,
-	0x31, 0x31, 0x44, 0x4b, 0xa0, 0xd3, 0x15, 0x11, 0x0d, 0x5c, 0xb2, 0x0d, 0xeb, 0x4f, 0x2d, 0x6f,
-	0x88, 0x5c, 0x55, 0xb4, 0x7c, 0xbd, 0x64, 0x26, 0x2b, 0xfd, 0x8f, 0x02, 0x14, 0xef, 0x25, 0xfc,
-	0xc8, 0x05, 0x28, 0xa7, 0x5c, 0x7b, 0xd4, 0x51, 0x15, 0x4d, 0xa9, 0xe7, 0x4d, 0x48, 0x4d, 0x6d,
-	0x87, 0xbc, 0x07, 0x1b, 0x13, 0x87, 0xc0, 0xf2, 0x51, 0xcd, 0x69, 0x4a, 0xbd, 0x64, 0x9e, 0x4d,
-	0x8d, 0x77, 0x2c, 0x1f, 0x89, 0x01, 0x6f, 0xba, 0x18, 0x60, 0x64, 0x79, 0x3d, 0x1a, 0x3c, 0x62,
-	0x91, 0x2f, 0xa9, 0xa9, 0x79, 0xe9, 0x4a, 0x92, 0xad, 0xf6, 0xd1, 0x0e, 0xa9, 0x41, 0xd1, 0xb6,
-	0x04, 0xba, 0x2c, 0x1a, 0xa9, 0x05, 0xe9, 0x35, 0x59, 0x93, 0x9b, 0x50, 0xc6, 0x90, 0x3a, 0xe8,
-	0x33, 0x8f, 0xb9, 0x23, 0x75, 0x4d, 0x53, 0xea, 0xe5, 0x96, 0xd6, 0x9c, 0x6f, 0x6d, 0x73, 0xb6,
-	0x5c, 0x73, 0x3a, 0x88, 0x7c, 0x02, 0x45, 0x3e, 0xf2, 0x43, 0xc1, 0x7c, 0xae, 0xae, 0xaf, 0x08,
-	0x30, 0x89, 0x20, 0x6d, 0x38, 0x67, 0x39, 0x0e, 0x8d, 0x99, 0x26, 0x15, 0xa9, 0x67, 0x56, 0x04,
-	0xa9, 0x1c, 0x05, 0xc6, 0xf5, 0x92, 0xaf, 0x41, 0x4d, 0x3b, 0xc3, 0x87, 0xdc, 0xc6, 0x50, 0xd0,
-	0x3e, 0xf5, 0xa8, 0xa0, 0xc8, 0xd5, 0xa2, 0xc4, 0xd4, 0xb3, 0x98, 0xdd, 0x39, 0x4f, 0xf3, 0xad,
-	0x04, 0x63, 0x7e, 0x83, 0x5c, 0x84, 0x73, 0xc3, 0xd0, 0xb1, 0x04, 0xf6, 0x04, 0xf5, 0xb1, 0xc7,
-	0xd1, 0x56, 0x4b, 0xf2, 0x08, 0x37, 0xc6, 0xe6, 0xfb, 0xd4, 0xc7, 0x2e, 0xda, 0x64, 0x1f, 0xce,
-	0xa0, 0x43, 0x05, 0x8b, 0xb8, 0x0a, 0x2b, 0x56, 0x92, 0x06, 0xe8, 0x5f, 0x40, 0x65, 0x26, 0xef,
-	0x88, 0x6c, 0xc2, 0x9a, 0xa0, 0xc2, 0x43, 0x39, 0x2e, 0x25, 0x73, 0xbc, 0x20, 0x1a, 0x94, 0x25,
-	0x26, 0x65, 0x82, 0xda, 0x5c, 0xcd, 0xc9, 0xa1, 0x9b, 0x36, 0xe9, 0xdf, 0x42, 0x35, 0x53, 0x41,
-	0x07, 0xaa, 0x99, 0xc6, 0xc4, 0xf3, 0xba, 0x90, 0xe2, 0x2c, 0x0f, 0x33, 0x13, 0xa9, 0xdf, 0x85,
-	0xad, 0x83, 0x28, 0xae, 0x22, 0x1d, 0x70, 0x13, 0x9f, 0x0c, 0x91, 0x0b, 0xf2, 0x11, 0x14, 0x53,
-	0x14, 0xc9, 0xba, 0xdc, 0xaa, 0x65, 0xe1, 0x27, 0x41, 0x13, 0x5f, 0xfd, 0x3a, 0x6c, 0xcf, 0x03,
-	0xf2, 0x90, 0x05, 0x1c, 0x17, 0x29, 0xa7, 0x34, 0xad, 0x1c, 0x3d, 0x84, 0xad, 0x07, 0xf2, 0x10,
-	0xe6, 0xb9, 0x9c, 0x14, 0x39, 0x43, 0x36, 0x77, 0x0a, 0xb2, 0xd7, 0x60, 0xeb, 0x16, 0x7a, 0x78,
-	0xfa, 0x8c, 0xfa, 0x2b, 0x05, 0x36, 0x3b, 0x94, 0x8b, 0x34, 0x90, 0xa7, 0x91, 0x2d, 0x28, 0x3c,
-	0xa5, 0x78, 0x28, 0x43, 0x2a, 0xad, 0x77, 0x96, 0xd3, 0xf8, 0x8a, 0xe2, 0xa1, 0x29, 0x7d, 0xc9,
-	0x79, 0x80, 0xd0, 0x72, 0xb1, 0x27, 0xd8, 0x77, 0x49, 0x01, 0x6b, 0x66, 0x29, 0xb6, 0xdc, 0x8f,
-	0x0d, 0x64, 0x07, 0xe4, 0xa2, 0xc7, 0xe9, 0x73, 0x94, 0x57, 0xc4, 0x5a, 0x5c, 0x82, 0x8b, 0x5d,
-	0xfa, 0x1c, 0x75, 0x1f, 0x4a, 0x13, 0x0e, 0xe4, 0x5a, 0xec, 0x99, 0x2c, 0x92, 0xa1, 0x38, 0xae,
-	0x11, 0x47, 0xce, 0xb1, 0x2e, 0x02, 0x7c, 0x26, 0x7a, 0x19, 0x1e, 0x1b, 0xb1, 0xf9, 0x5e, 0xca,
-	0x45, 0xff, 0x4d, 0x81, 0xed, 0x2e, 0x5a, 0x91, 0x3d, 0xc8, 0x54, 0xbe, 0x09, 0x6b, 0x4f, 0x86,
-	0x18, 0x8d, 0xd2, 0x21, 0x97, 0x8b, 0xff, 0x53, 0xdb, 0xa4, 0x97, 0x85, 0xd5, 0x7b, 0xa9, 0x53,
-	0x20, 0x9f, 0xa3, 0x38, 0xf5, 0x04, 0xa5, 0xa9, 0x72, 0xab, 0xa7, 0x6a, 0xe8, 0x70, 0x76, 0xda,
-	0x4a, 0x8a, 0x50, 0xf8, 0xec, 0x41, 0xa7, 0x53, 0x7d, 0x23, 0xfe, 0xd7, 0x69, 0x77, 0xef, 0x57,
-	0x95, 0xd6, 0xef, 0x67, 0xa0, 0x9c, 0x3a, 0xdd, 0xb8, 0xd7, 0x26, 0x3f, 0xe5, 0xa0, 0x32, 0xab,
-	0x0f, 0xf2, 0x7e, 0x36, 0xd9, 0x42, 0x49, 0xd6, 0xea, 0x27, 0x3b, 0x8e, 0xa5, 0xa6, 0xff, 0xaa,
-	0xbc, 0xbe, 0x71, 0xb7, 0xb6, 0x33, 0xde, 0xe5, 0x9a, 0xa5, 0xa5, 0x01, 0x5a, 0x84, 0x9c, 0x0d,
-	0x23, 0x1b, 0xf5, 0xcb, 0x50, 0xee, 0xca, 0x7f, 0x5a, 0x84, 0x21, 0x23, 0xef, 0x0e, 0x84, 0x08,
-	0xf9, 0xbe, 0x61, 0xb8, 0x54, 0x0c, 0x86, 0xfd, 0xa6, 0xcd, 0x7c, 0xc3, 0xa5, 0xce, 0x88, 0x05,
-	0x46, 0x92, 0xf4, 0xe5, 0x3f, 0xff, 0xfe, 0x9c, 0x3b, 0xd0, 0xcf, 0x27, 0x5f, 0x66, 0x69, 0x33,
-	0x26, 0x03, 0x64, 0xd8, 0x32, 0xd7, 0xbe, 0xd2, 0x78, 0x78, 0x41, 0xaf, 0x2d, 0xf1, 0xb1, 0x1c,
-	0x67, 0x5f, 0x69, 0x90, 0x97, 0x0a, 0x54, 0x66, 0x85, 0xbe, 0xa8, 0x07, 0x0b, 0xaf, 0x82, 0xda,
-	0x76, 0x73, 0xfc, 0x02, 0x68, 0xa6, 0x2f, 0x80, 0xe6, 0xed, 0xf8, 0x05, 0xa0, 0x1b, 0x92, 0xde,
-	0x07, 0xad, 0xdd, 0x25, 0xa9, 0xbf, 0x9f, 0x3a, 0xfe, 0x17, 0x31, 0x89, 0x1f, 0xa0, 0x32, 0x2b,
-	0xfd, 0x45, 0x1c, 0x16, 0x5e, 0x0e, 0x4b, 0x39, 0x5c, 0x92, 0x1c, 0x2e, 0x36, 0x56, 0xe2, 0x40,
-	0x7e, 0x54, 0x60, 0x63, 0xe6, 0x06, 0x21, 0x17, 0xb3, 0x04, 0x16, 0x5d, 0x31, 0xb5, 0x9d, 0xe5,
-	0xd3, 0xc9, 0xf5, 0x86, 0x24, 0xb1, 0x4b, 0xf4, 0x65, 0x67, 0x60, 0xc7, 0x1f, 0x5b, 0xc3, 0xa3,
-	0x5c, 0x90, 0x57, 0x0a, 0x9c, 0x9b, 0x13, 0x33, 0x59, 0x30, 0x64, 0x8b, 0xf5, 0x7e, 0x3c, 0x8d,
-	0xa4, 0x17, 0x64, 0xf7, 0x78, 0x1a, 0x5c, 0x42, 0x93, 0x17, 0x50, 0x9e, 0x12, 0x2d, 0xd9, 0xcd,
-	0x22, 0x67, 0x35, 0x5d, 0x3b, 0xe6, 0x66, 0x3b, 0x31, 0xfd, 0xcc, 0x51, 0xdc, 0xfc, 0x2b, 0xf7,
-	0xfa, 0xc6, 0x9f, 0x39, 0xf2, 0xb7, 0x02, 0xd5, 0x14, 0x41, 0xe3, 0x18, 0x3d, 0xa5, 0x36, 0xea,
-	0xdf, 0x80, 0x3e, 0x6f, 0xd3, 0xf6, 0xb4, 0x04, 0x51, 0x0b, 0x23, 0xf6, 0x18, 0x6d, 0xb1, 0x82,
-	0x7c, 0x6a, 0x5b, 0x2e, 0x75, 0x90, 0x05, 0x03, 0xcb, 0xc6, 0xe8, 0x53, 0xd7, 0xb7, 0xa8, 0x17,
-	0x7b, 0x35, 0xbe, 0x84, 0xcd, 0x9b, 0xdd, 0x5b, 0xda, 0x95, 0xbd, 0x03, 0xcf, 0x1a, 0x72, 0xd4,
-	0x3a, 0xd4, 0xc6, 0xf8, 0x6b, 0x79, 0xfd, 0x44, 0x44, 0xa3, 0xef, 0xb1, 0xbe, 0xe1, 0x5b, 0x5c,
-	0x60, 0x64, 0x74, 0xda, 0x07, 0xb7, 0xef, 0x74, 0x6f, 0x37, 0xc5, 0x33, 0xd1, 0xca, 0x7f, 0xd8,
-	0xbc, 0xdc, 0xc8, 0x2b, 0xb9, 0x42, 0xab, 0x6a, 0x85, 0xa1, 0x47, 0x6d, 0xf9, 0x84, 0x34, 0x1e,
-	0x73, 0x16, 0xec, 0x67, 0x2c, 0xe6, 0xc7, 0x90, 0xbf, 0x7a, 0xf9, 0x2a, 0xb9, 0x0a, 0x0d, 0x13,
-	0xc5, 0x30, 0x0a, 0xd0, 0xd1, 0x0e, 0x07, 0x18, 0x68, 0x62, 0x80, 0x93, 0xdb, 0x42, 0x73, 0x18,
-	0x72, 0x2d, 0x60, 0x42, 0xc3, 0x67, 0x94, 0x8b, 0x26, 0x59, 0x87, 0xc2, 0x2f, 0x39, 0x65, 0xfd,
-	0xe1, 0xe4, 0x53, 0xda, 0x5f, 0x97, 0x62, 0xb8, 0xf2, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d,
-	0x5e, 0x5f, 0x7e, 0xe2, 0x0b, 0x00, 0x00,
+	// 1222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x73, 0xdb, 0x44,
+	0x14, 0xaf, 0x6c, 0x27, 0xb5, 0x9f, 0x13, 0xd7, 0xb3, 0x24, 0x41, 0xe3, 0x50, 0x2a, 0x44, 0xa6,
+	0x04, 0x4f, 0x63, 0x05, 0xb7, 0xc3, 0xb4, 0x81, 0x03, 0x49, 0x1a, 0xc0, 0x8c, 0x69, 0x8b, 0x9c,
+	0x32, 0x43, 0x67, 0xc0, 0xc8, 0xd2, 0xab, 0xbc, 0x45, 0xd6, 0xaa, 0xda, 0x55, 0x53, 0x97, 0xe9,
+	0x00, 0x3d, 0xf0, 0x01, 0x0a, 0x27, 0xb8, 0x31, 0x5c, 0x39, 0xf1, 0x09, 0xb8, 0x72, 0xe5, 0x2b,
+	0xc0, 0xf7, 0x60, 0xb4, 0x96, 0x1c, 0xff, 0x4b, 0xe2, 0x9c, 0xac, 0xf7, 0xf6, 0xfd, 0xf9, 0xbd,
+	0xb7, 0xef, 0xfd, 0xbc, 0x50, 0x0a, 0x2c, 0xd1, 0x65, 0x2e, 0xfa, 0xb5, 0x20, 0x64, 0x82, 0x91,
+	0xb2, 0xe5, 0x0b, 0xda, 0x89, 0xdc, 0x5a, 0xaa, 0xaf, 0xbc, 0xe6, 0x32, 0xe6, 0x7a, 0x68, 0x58,
+	0x01, 0x35, 0x2c, 0xdf, 0x67, 0xc2, 0x12, 0x94, 0xf9, 0x7c, 0x60, 0x5f, 0x59, 0x4f, 0x4e, 0xa5,
+	0xd4, 0x89, 0x1e, 0x1a, 0xd8, 0x0b, 0x44, 0x3f, 0x39, 0xbc, 0x26, 0x7f, 0xec, 0x2d, 0x17, 0xfd,
+	0x2d, 0x7e, 0x64, 0xb9, 0x2e, 0x86, 0x06, 0x0b, 0xa4, 0xfb, 0x74, 0x28, 0x7d, 0x13, 0x4a, 0x26,
+	0x06, 0x68, 0x09, 0x74, 0x5a, 0x22, 0xa4, 0xbe, 0x4b, 0xd6, 0x60, 0xf1, 0x89, 0xe5, 0x45, 0xc8,
+	0x55, 0x45, 0xcb, 0x6e, 0x16, 0xcc, 0x44, 0xd2, 0xff, 0xc8, 0x41, 0xfe, 0x5e, 0x82, 0x8f, 0x5c,
+	0x81, 0x62, 0x8a, 0xb5, 0x4d, 0x1d, 0x55, 0xd1, 0x94, 0xcd, 0xac, 0x09, 0xa9, 0xaa, 0xe1, 0x90,
+	0x37, 0x61, 0x79, 0x68, 0xe0, 0x5b, 0x3d, 0x54, 0x33, 0x9a, 0xb2, 0x59, 0x30, 0x97, 0x52, 0xe5,
+	0x1d, 0xab, 0x87, 0xc4, 0x80, 0x57, 0x5c, 0xf4, 0x31, 0xb4, 0xbc, 0x36, 0xf5, 0x1f, 0xb2, 0xb0,
+	0x27, 0xa1, 0xa9, 0x59, 0x69, 0x4a, 0x92, 0xa3, 0xc6, 0xf1, 0x09, 0xa9, 0x40, 0xde, 0xb6, 0x04,
+	0xba, 0x2c, 0xec, 0xab, 0x39, 0x69, 0x35, 0x94, 0xc9, 0x1e, 0x14, 0x31, 0xa0, 0x0e, 0xf6, 0x98,
+	0xc7, 0xdc, 0xbe, 0xba, 0xa0, 0x29, 0x9b, 0xc5, 0xba, 0x56, 0x9b, 0x6c, 0x6d, 0x6d, 0xbc, 0x5c,
+	0x73, 0xd4, 0x89, 0xbc, 0x0f, 0x79, 0xde, 0xef, 0x05, 0x82, 0xf5, 0xb8, 0xba, 0x38, 0x67, 0x80,
+	0xa1, 0x07, 0x69, 0xc0, 0x25, 0xcb, 0x71, 0x68, 0x8c, 0x34, 0xa9, 0x48, 0xbd, 0x38, 0x67, 0x90,
+	0xd2, 0xb1, 0x63, 0x5c, 0x2f, 0xf9, 0x12, 0xd4, 0xb4, 0x33, 0x3c, 0xe2, 0x36, 0x06, 0x82, 0x76,
+	0xa8, 0x47, 0x05, 0x45, 0xae, 0xe6, 0x65, 0x4c, 0x7d, 0x3a, 0x66, 0x6b, 0xc2, 0xd2, 0x7c, 0x35,
+	0x89, 0x31, 0x79, 0x40, 0xae, 0xc2, 0xa5, 0x28, 0x70, 0x2c, 0x81, 0x6d, 0x41, 0x7b, 0xd8, 0xe6,
+	0x68, 0xab, 0x05, 0x79, 0x85, 0xcb, 0x03, 0xf5, 0x21, 0xed, 0x61, 0x0b, 0x6d, 0xb2, 0x03, 0x17,
+	0xd1, 0xa1, 0x82, 0x85, 0x5c, 0x85, 0x39, 0x2b, 0x49, 0x1d, 0xf4, 0x8f, 0xa1, 0x34, 0x96, 0xb7,
+	0x4f, 0x56, 0x60, 0x41, 0x50, 0xe1, 0xa1, 0x1c, 0x97, 0x82, 0x39, 0x10, 0x88, 0x06, 0x45, 0x19,
+	0x93, 0x32, 0x41, 0x6d, 0xae, 0x66, 0xe4, 0xd0, 0x8d, 0xaa, 0xf4, 0xaf, 0xa1, 0x3c, 0x55, 0x41,
+	0x13, 0xca, 0x53, 0x8d, 0x89, 0xe7, 0x75, 0x26, 0xc4, 0x71, 0x1c, 0xe6, 0x94, 0xa7, 0x7e, 0x17,
+	0x56, 0xf7, 0xc3, 0xb8, 0x8a, 0x74, 0xc0, 0x4d, 0x7c, 0x1c, 0x21, 0x17, 0xe4, 0x5d, 0xc8, 0xa7,
+	0x51, 0x24, 0xea, 0x62, 0xbd, 0x32, 0x1d, 0x7e, 0xe8, 0x34, 0xb4, 0xd5, 0x6f, 0xc1, 0xda, 0x64,
+	0x40, 0x1e, 0x30, 0x9f, 0xe3, 0xac, 0xcd, 0x29, 0x8c, 0x6e, 0x8e, 0x1e, 0xc0, 0xea, 0xfd, 0xc0,
+	0x19, 0x73, 0x1d, 0x60, 0x39, 0xcb, 0x73, 0x0c, 0x6c, 0xe6, 0x1c, 0x60, 0x6f, 0xc2, 0xea, 0x6d,
+	0xf4, 0xf0, 0xfc, 0x19, 0xf5, 0xff, 0x14, 0x58, 0x69, 0x52, 0x2e, 0x52, 0x47, 0x9e, 0x7a, 0xd6,
+	0x21, 0xf7, 0x84, 0xe2, 0x91, 0x74, 0x29, 0xd5, 0x5f, 0x3f, 0x19, 0xc6, 0xe7, 0x14, 0x8f, 0x4c,
+	0x69, 0x4b, 0x2e, 0x03, 0x04, 0x96, 0x8b, 0x6d, 0xc1, 0xbe, 0x41, 0x3f, 0x59, 0xef, 0x42, 0xac,
+	0x39, 0x8c, 0x15, 0x64, 0x1d, 0xa4, 0xd0, 0xe6, 0xf4, 0x19, 0x4a, 0x8a, 0x58, 0x88, 0x4b, 0x70,
+	0xb1, 0x45, 0x9f, 0x61, 0x9c, 0x8f, 0xb3, 0x50, 0xa8, 0x0b, 0x67, 0xe5, 0x6b, 0xb1, 0x50, 0x98,
+	0xd2, 0x36, 0xa6, 0x28, 0xea, 0xdb, 0x5e, 0xe4, 0xc4, 0x29, 0x85, 0xe5, 0xc9, 0x8d, 0xcf, 0x9b,
+	0x4b, 0x89, 0xf2, 0x30, 0xd6, 0x7d, 0x92, 0xcb, 0x67, 0xca, 0x59, 0xfd, 0x67, 0x05, 0x0a, 0xc3,
+	0x1a, 0xc9, 0xcd, 0x18, 0x49, 0x22, 0x24, 0x43, 0x77, 0x5a, 0xa3, 0x8f, 0x8d, 0xe3, 0xbd, 0xf3,
+	0xf1, 0xa9, 0x68, 0x8f, 0xd4, 0x39, 0x20, 0xbb, 0xe5, 0x58, 0x7d, 0x6f, 0x58, 0xeb, 0x15, 0x28,
+	0x4a, 0x48, 0x6d, 0x9b, 0x45, 0xbe, 0x90, 0xbd, 0xc8, 0x9a, 0x20, 0x55, 0xfb, 0xb1, 0x26, 0x81,
+	0xf5, 0xbb, 0x02, 0x6b, 0x2d, 0xb4, 0x42, 0xbb, 0x3b, 0x75, 0x01, 0x2b, 0xb0, 0xf0, 0x38, 0xc2,
+	0xb0, 0x9f, 0xee, 0x9a, 0x14, 0x26, 0x5a, 0xbc, 0x70, 0xde, 0x16, 0xcb, 0x2b, 0xcd, 0xcd, 0x7f,
+	0xa5, 0x09, 0x4c, 0x0a, 0xe4, 0x23, 0x14, 0xe7, 0x1e, 0xe7, 0x34, 0x61, 0x66, 0xfe, 0x84, 0x55,
+	0x1d, 0x96, 0x46, 0xb5, 0x24, 0x0f, 0xb9, 0x0f, 0xef, 0x37, 0x9b, 0xe5, 0x0b, 0xf1, 0x57, 0xb3,
+	0xd1, 0x3a, 0x2c, 0x2b, 0xd5, 0x6d, 0x58, 0x1a, 0x9d, 0x06, 0x52, 0x02, 0x68, 0xdd, 0x35, 0x0f,
+	0xdb, 0x7b, 0x5f, 0xb4, 0x1b, 0xb7, 0xcb, 0x17, 0x48, 0x19, 0x96, 0x52, 0xf9, 0xce, 0xee, 0xa7,
+	0x07, 0x65, 0xa5, 0xfe, 0xdb, 0x45, 0x28, 0xa6, 0x2e, 0xbb, 0xf7, 0x1a, 0xe4, 0x87, 0x0c, 0x94,
+	0xc6, 0xd7, 0x9b, 0xbc, 0x35, 0x0d, 0x6f, 0x26, 0xa3, 0x54, 0x36, 0xcf, 0x36, 0x1c, 0x30, 0x85,
+	0xfe, 0xab, 0xf2, 0x72, 0xf7, 0x6e, 0x65, 0x7d, 0x70, 0xca, 0x35, 0x4b, 0x4b, 0x1d, 0xb4, 0x10,
+	0x39, 0x8b, 0x42, 0x1b, 0xf5, 0x6d, 0x28, 0xb6, 0xe4, 0x97, 0x16, 0x62, 0xc0, 0xc8, 0x1b, 0x5d,
+	0x21, 0x02, 0xbe, 0x63, 0x18, 0x2e, 0x15, 0xdd, 0xa8, 0x53, 0xb3, 0x59, 0xcf, 0x70, 0xa9, 0xd3,
+	0x67, 0xbe, 0x91, 0x24, 0x7d, 0xf1, 0xcf, 0xbf, 0x3f, 0x65, 0xf6, 0xf5, 0xcb, 0xc9, 0xc3, 0x42,
+	0xea, 0x8c, 0xe1, 0x7c, 0x1a, 0xb6, 0xcc, 0xb5, 0xa3, 0x54, 0x1f, 0x5c, 0xd1, 0x2b, 0x27, 0xd8,
+	0x58, 0x8e, 0xb3, 0xa3, 0x54, 0xc9, 0x0b, 0x05, 0x4a, 0xe3, 0x3c, 0x35, 0xab, 0x07, 0x33, 0x99,
+	0xac, 0xb2, 0x56, 0x1b, 0x3c, 0x60, 0x6a, 0xe9, 0x03, 0xa6, 0x76, 0x10, 0x3f, 0x60, 0x74, 0x43,
+	0xc2, 0x7b, 0xbb, 0xbe, 0x71, 0x42, 0xea, 0x6f, 0x47, 0x06, 0xe6, 0x79, 0x0c, 0xe2, 0x3b, 0x28,
+	0x8d, 0x33, 0xd7, 0x2c, 0x0c, 0x33, 0xb9, 0xed, 0x44, 0x0c, 0xd7, 0x24, 0x86, 0xab, 0xd5, 0xb9,
+	0x30, 0x90, 0xef, 0x15, 0x58, 0x1e, 0x23, 0x40, 0x72, 0x75, 0x1a, 0xc0, 0x2c, 0x86, 0xac, 0xac,
+	0x9f, 0x3c, 0xcf, 0x5c, 0xaf, 0x4a, 0x10, 0x1b, 0x44, 0x3f, 0xe9, 0x0e, 0x6c, 0x41, 0x99, 0x6f,
+	0x78, 0x94, 0x0b, 0xf2, 0xa3, 0x02, 0x97, 0x26, 0x48, 0x80, 0xcc, 0x18, 0xb2, 0xd9, 0x3c, 0x71,
+	0x3a, 0x8c, 0xa4, 0x17, 0x64, 0xe3, 0x74, 0x18, 0x5c, 0x86, 0x26, 0xcf, 0xa1, 0x38, 0xb2, 0xe6,
+	0x64, 0x63, 0x3a, 0xf2, 0x34, 0x0b, 0x54, 0x4e, 0x21, 0xce, 0x33, 0xd3, 0x8f, 0x5d, 0xc5, 0xde,
+	0x5f, 0x99, 0x97, 0xbb, 0x7f, 0x66, 0xc8, 0xdf, 0x0a, 0x94, 0xd3, 0x08, 0x1a, 0xc7, 0xf0, 0x09,
+	0xb5, 0x51, 0xff, 0x0a, 0xf4, 0x49, 0x9d, 0xb6, 0xa5, 0x25, 0x11, 0xb5, 0x20, 0x64, 0x8f, 0xd0,
+	0x16, 0x73, 0xac, 0x4f, 0x65, 0xd5, 0xa5, 0x0e, 0x32, 0xbf, 0x6b, 0xd9, 0x18, 0x7e, 0xe0, 0xf6,
+	0x2c, 0xea, 0xc5, 0x56, 0xd5, 0xcf, 0x60, 0x65, 0xaf, 0x75, 0x5b, 0xbb, 0xbe, 0xb5, 0xef, 0x59,
+	0x11, 0x47, 0xad, 0x49, 0x6d, 0x8c, 0xff, 0xec, 0x6f, 0x9d, 0x19, 0xd1, 0xe8, 0x78, 0xac, 0x63,
+	0xf4, 0x2c, 0x2e, 0x30, 0x34, 0x9a, 0x8d, 0xfd, 0x83, 0x3b, 0xad, 0x83, 0x9a, 0x78, 0x2a, 0xea,
+	0xd9, 0x77, 0x6a, 0xdb, 0xd5, 0xac, 0x92, 0xc9, 0xd5, 0xcb, 0x56, 0x10, 0x78, 0xd4, 0x96, 0x2f,
+	0x60, 0xe3, 0x11, 0x67, 0xfe, 0xce, 0x94, 0xc6, 0x7c, 0x0f, 0xb2, 0x37, 0xb6, 0x6f, 0x90, 0x1b,
+	0x50, 0x35, 0x51, 0x44, 0xa1, 0x8f, 0x8e, 0x76, 0xd4, 0x45, 0x5f, 0x13, 0x5d, 0x1c, 0xb2, 0x85,
+	0xe6, 0x30, 0xe4, 0x9a, 0xcf, 0x84, 0x86, 0x4f, 0x29, 0x17, 0x35, 0xb2, 0x08, 0xb9, 0x5f, 0x32,
+	0xca, 0xe2, 0x83, 0xe1, 0x4b, 0xa0, 0xb3, 0x28, 0x97, 0xe1, 0xfa, 0xff, 0x03, 0x00, 0x1b, 0x05,
+	0xba, 0x18, 0xa1, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.